@@ -50,6 +50,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/go-logr/zapr"
@@ -61,15 +62,27 @@ import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"go.uber.org/zap"
 
+	"k8c.io/dashboard/v2/pkg/audit"
+	"k8c.io/dashboard/v2/pkg/credentials"
 	"k8c.io/dashboard/v2/pkg/handler"
 	"k8c.io/dashboard/v2/pkg/handler/auth"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	v2 "k8c.io/dashboard/v2/pkg/handler/v2"
+	upgradeworkflow "k8c.io/dashboard/v2/pkg/handler/v2/upgrade_workflow"
+	"k8c.io/dashboard/v2/pkg/healthhistory"
+	"k8c.io/dashboard/v2/pkg/notification"
+	"k8c.io/dashboard/v2/pkg/presethealth"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	auth2 "k8c.io/dashboard/v2/pkg/provider/auth"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	"k8c.io/dashboard/v2/pkg/ratelimit"
+	"k8c.io/dashboard/v2/pkg/recording"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
+	"k8c.io/dashboard/v2/pkg/tracing"
 	kuberneteswatcher "k8c.io/dashboard/v2/pkg/watcher/kubernetes"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/cluster/client"
@@ -85,11 +98,14 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/klog/v2"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
@@ -145,10 +161,25 @@ func main() {
 		log.Fatalw("failed to register scheme", zap.Stringer("api", kubeovnv1.SchemeGroupVersion), zap.Error(err))
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx, options.tracing)
+	if err != nil {
+		log.Fatalw("failed to set up tracing", zap.Error(err))
+	}
+	// flush the pending spans once the shutdown was signaled
+	go func() {
+		<-ctx.Done()
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Errorw("failed to flush traces", zap.Error(err))
+		}
+	}()
+
 	masterCfg, err := ctrlruntime.GetConfig()
 	if err != nil {
 		log.Fatalw("unable to build client configuration from kubeconfig", zap.Error(err))
 	}
+	if options.tracing.Enabled() {
+		masterCfg = tracing.WrapConfig(masterCfg)
+	}
 
 	// We use the manager only to get a lister-backed ctrlruntimeclient.Client. We can not use it for most
 	// other actions, because it doesn't support impersonation (and can't be changed to do that as that would mean it has to replicate the apiservers RBAC for the lister)
@@ -186,11 +217,11 @@ func main() {
 		log.Fatalw("failed to create and initialize providers", zap.Error(err))
 	}
 
-	tokenVerifiers, tokenExtractors, err := createAuthClients(options, providers)
+	tokenVerifiers, tokenExtractors, err := createAuthClients(ctx, options, providers, log)
 	if err != nil {
 		log.Fatalw("failed to create auth clients", zap.Error(err))
 	}
-	apiHandler, err := createAPIHandler(options, providers, tokenVerifiers, tokenExtractors, mgr, log)
+	apiHandler, err := createAPIHandler(ctx, options, providers, tokenVerifiers, tokenExtractors, mgr, log)
 	if err != nil {
 		log.Fatalw("failed to create API Handler", zap.Error(err))
 	}
@@ -223,7 +254,8 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	kubeMasterClient := kubernetes.NewForConfigOrDie(masterCfg)
 	kubeMasterInformerFactory := informers.NewSharedInformerFactory(kubeMasterClient, 30*time.Minute)
 
-	client := mgr.GetClient()
+	// the changes of the privileged and the impersonated clients are recorded in the audit log
+	client := audit.NewRecordingClient(mgr.GetClient())
 
 	defaultImpersonationClient := kubernetesprovider.NewImpersonationClient(masterCfg, mgr.GetRESTMapper())
 
@@ -235,6 +267,9 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	if err != nil {
 		return providers{}, err
 	}
+	if options.tracing.Enabled() {
+		seedKubeconfigGetter = tracedSeedKubeconfigGetter(seedKubeconfigGetter)
+	}
 
 	var configGetter provider.KubermaticConfigurationGetter
 	if options.kubermaticConfiguration != nil {
@@ -262,10 +297,17 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		return providers{}, errors.New("failed to sync mgr cache")
 	}
 
-	seedClientGetter := kubernetesprovider.SeedClientGetterFactory(seedKubeconfigGetter)
+	seedClientGetter := recordingSeedClientGetter(kubernetesprovider.SeedClientGetterFactory(seedKubeconfigGetter))
 	clusterProviderGetter := clusterProviderFactory(mgr.GetRESTMapper(), seedKubeconfigGetter, seedClientGetter, client, options)
 
-	presetProvider, err := kubernetesprovider.NewPresetProvider(client)
+	credentialResolver, err := credentials.New(options.presetCredentials, mgr.GetAPIReader())
+	if err != nil {
+		return providers{}, fmt.Errorf("invalid preset credentials configuration: %w", err)
+	}
+	if credentialResolver != nil {
+		credentialResolver.Start(ctx, log)
+	}
+	presetProvider, err := kubernetesprovider.NewPresetProvider(client, credentialResolver)
 	if err != nil {
 		return providers{}, err
 	}
@@ -291,6 +333,7 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	}
 	userProvider := kubernetesprovider.NewUserProvider(client)
 	settingsProvider := kubernetesprovider.NewSettingsProvider(client)
+	chargebackSchemaProvider := kubernetesprovider.NewChargebackSchemaProvider(client, options.namespace)
 	addonConfigProvider := kubernetesprovider.NewAddonConfigProvider(client)
 	adminProvider := kubernetesprovider.NewAdminProvider(client)
 	resourceQuotaProvider := resourceQuotaProviderFactory(defaultImpersonationClient.CreateImpersonatedClient, client)
@@ -318,17 +361,17 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		return providers{}, fmt.Errorf("failed to create user info getter: %w", err)
 	}
 
-	externalClusterProvider, err := kubernetesprovider.NewExternalClusterProvider(defaultImpersonationClient.CreateImpersonatedClient, mgr.GetClient())
+	externalClusterProvider, err := kubernetesprovider.NewExternalClusterProvider(defaultImpersonationClient.CreateImpersonatedClient, client)
 	if err != nil {
 		return providers{}, fmt.Errorf("failed to create external cluster provider: %w", err)
 	}
 
-	defaultConstraintProvider, err := kubernetesprovider.NewDefaultConstraintProvider(defaultImpersonationClient.CreateImpersonatedClient, mgr.GetClient(), options.namespace)
+	defaultConstraintProvider, err := kubernetesprovider.NewDefaultConstraintProvider(defaultImpersonationClient.CreateImpersonatedClient, client, options.namespace)
 	if err != nil {
 		return providers{}, fmt.Errorf("failed to create default constraint provider: %w", err)
 	}
 
-	constraintTemplateProvider, err := kubernetesprovider.NewConstraintTemplateProvider(defaultImpersonationClient.CreateImpersonatedClient, mgr.GetClient())
+	constraintTemplateProvider, err := kubernetesprovider.NewConstraintTemplateProvider(defaultImpersonationClient.CreateImpersonatedClient, client)
 	if err != nil {
 		return providers{}, fmt.Errorf("failed to create constraint template provider: %w", err)
 	}
@@ -338,7 +381,7 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		return providers{}, fmt.Errorf("failed to create cluster template provider: %w", err)
 	}
 
-	privilegedAllowedRegistryProvider, err := kubernetesprovider.NewAllowedRegistryPrivilegedProvider(mgr.GetClient())
+	privilegedAllowedRegistryProvider, err := kubernetesprovider.NewAllowedRegistryPrivilegedProvider(client)
 	if err != nil {
 		return providers{}, fmt.Errorf("failed to create allowed registry provider: %w", err)
 	}
@@ -372,7 +415,7 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 
 	privilegedIPAMPoolProviderGetter := kubernetesprovider.PrivilegedIPAMPoolProviderFactory(mgr.GetRESTMapper(), seedKubeconfigGetter)
 
-	seedProvider := kubernetesprovider.NewSeedProvider(mgr.GetClient())
+	seedProvider := kubernetesprovider.NewSeedProvider(client)
 
 	applicationDefinitionProvider := kubernetesprovider.NewApplicationDefinitionProvider(client)
 
@@ -419,6 +462,52 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		return providers{}, fmt.Errorf("failed to setup event handler for settings informer: %w", err)
 	}
 
+	projectWatcher, err := kuberneteswatcher.NewProjectWatcher(
+		ctx,
+		log,
+		handlercommon.ProjectResourceListerFactory(privilegedProjectProvider, seedsGetter, clusterProviderGetter),
+		options.projectWatchSyncInterval,
+		kuberneteswatcher.DefaultProjectEventBufferSize,
+		kuberneteswatcher.DefaultProjectRetention,
+	)
+	if err != nil {
+		return providers{}, fmt.Errorf("failed to setup project-watcher: %w", err)
+	}
+
+	notifier, err := notification.New(options.notification)
+	if err != nil {
+		return providers{}, fmt.Errorf("invalid notification configuration: %w", err)
+	}
+	if notifier != nil {
+		if err := startResourceQuotaAlerts(ctx, mgr, notifier, log); err != nil {
+			return providers{}, fmt.Errorf("failed to setup resource quota alerts: %w", err)
+		}
+	}
+
+	// the periodic checks are run by the leader, see createAPIHandler
+	presetHealthChecker := presethealth.NewChecker(presetProvider, seedsGetter, options.caBundle.CertPool())
+
+	auditLogger, err := createAuditLogger(ctx, options, log)
+	if err != nil {
+		return providers{}, fmt.Errorf("failed to create audit logger: %w", err)
+	}
+
+	var healthHistory *healthhistory.History
+	if options.healthHistory.Enabled() {
+		healthHistory = healthhistory.New(options.healthHistory)
+		healthhistory.NewSampler(options.healthHistory, healthHistory, seedsGetter, seedClientGetter, log).Start(ctx)
+	}
+
+	var priceCatalog pricing.Source
+	if options.priceCatalogFile != "" {
+		fileSource, err := pricing.NewFileSource(options.priceCatalogFile, log)
+		if err != nil {
+			return providers{}, fmt.Errorf("failed to load the price catalog: %w", err)
+		}
+		fileSource.Start(ctx, options.priceCatalogRefreshInterval)
+		priceCatalog = fileSource
+	}
+
 	featureGatesProvider := kubernetesprovider.NewFeatureGatesProvider(options.featureGates)
 
 	backupStorageProvider := backupStorageProviderFactory(defaultImpersonationClient.CreateImpersonatedClient, client)
@@ -450,12 +539,19 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		addonConfigProvider:                            addonConfigProvider,
 		userInfoGetter:                                 userInfoGetter,
 		settingsProvider:                               settingsProvider,
+		chargebackSchemaProvider:                       chargebackSchemaProvider,
 		adminProvider:                                  adminProvider,
 		presetProvider:                                 presetProvider,
+		credentialResolver:                             credentialResolver,
 		admissionPluginProvider:                        admissionPluginProvider,
 		settingsWatcher:                                settingsWatcher,
 		featureGatesProvider:                           featureGatesProvider,
 		userWatcher:                                    userWatcher,
+		projectWatcher:                                 projectWatcher,
+		auditLogger:                                    auditLogger,
+		healthHistory:                                  healthHistory,
+		presetHealthChecker:                            presetHealthChecker,
+		priceCatalog:                                   priceCatalog,
 		externalClusterProvider:                        externalClusterProvider,
 		privilegedExternalClusterProvider:              externalClusterProvider,
 		constraintTemplateProvider:                     constraintTemplateProvider,
@@ -497,7 +593,7 @@ func createOIDCClients(oidcIssuerConfiguration *authtypes.OIDCConfiguration, oid
 	)
 }
 
-func createAuthClients(options serverRunOptions, prov providers) (authtypes.TokenVerifier, authtypes.TokenExtractor, error) {
+func createAuthClients(ctx context.Context, options serverRunOptions, prov providers, log *zap.SugaredLogger) (authtypes.TokenVerifier, authtypes.TokenExtractor, error) {
 	oidcExtractorVerifier, err := auth.NewOpenIDClient(
 		options.oidcAuthenticatorConfiguration,
 		"",
@@ -514,18 +610,129 @@ func createAuthClients(options serverRunOptions, prov providers) (authtypes.Toke
 		return nil, nil, fmt.Errorf("failed to create OIDC Authenticator: %w", err)
 	}
 
+	serviceAccountTokenAuth, err := createServiceAccountTokenAuthenticator(options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create service account token authenticator: %w", err)
+	}
+
 	jwtExtractorVerifier := auth.NewServiceAccountAuthClient(
 		auth.NewHeaderBearerTokenExtractor("Authorization"),
-		serviceaccount.JWTTokenAuthenticator([]byte(options.serviceAccountSigningKey)),
+		serviceAccountTokenAuth,
 		prov.privilegedServiceAccountTokenProvider,
 	)
 
-	tokenVerifiers := auth.NewTokenVerifierPlugins([]authtypes.TokenVerifier{oidcExtractorVerifier, jwtExtractorVerifier})
+	// the issuers trusted in addition to --oidc-url are reloaded from the KubermaticConfiguration, so that they can be changed without a restart
+	federatedVerifier := auth.NewFederatedVerifier(prov.configGetter, options.caBundle.CertPool(), options.oidcPrimaryEmailDomains)
+	federatedVerifier.Start(ctx, options.oidcIssuersReloadInterval, log)
+
+	tokenVerifiers := auth.NewTokenVerifierPlugins([]authtypes.TokenVerifier{oidcExtractorVerifier, federatedVerifier, jwtExtractorVerifier})
 	tokenExtractors := auth.NewTokenExtractorPlugins([]authtypes.TokenExtractor{oidcExtractorVerifier, jwtExtractorVerifier})
 	return tokenVerifiers, tokenExtractors, nil
 }
 
+func createServiceAccountTokenGenerator(options serverRunOptions) (serviceaccount.TokenGenerator, error) {
+	if options.serviceAccountPrivateKey != nil {
+		return serviceaccount.AsymmetricJWTTokenGenerator(options.serviceAccountPrivateKey)
+	}
+	return serviceaccount.JWTTokenGenerator([]byte(options.serviceAccountSigningKey))
+}
+
+func createServiceAccountTokenAuthenticator(options serverRunOptions) (serviceaccount.TokenAuthenticator, error) {
+	return serviceaccount.JWTTokenAuthenticatorWithKeys([]byte(options.serviceAccountSigningKey), options.serviceAccountVerificationKeys)
+}
+
+func createAuditLogger(ctx context.Context, options serverRunOptions, log *zap.SugaredLogger) (*audit.Logger, error) {
+	var sinks []audit.Sink
+
+	if options.auditLogStdout {
+		sinks = append(sinks, audit.NewWriterSink(os.Stdout))
+	}
+	if options.auditLogFile != "" {
+		fileSink, err := audit.NewFileSink(options.auditLogFile)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if options.auditWebhookURL != "" {
+		sinks = append(sinks, audit.NewWebhookSink(ctx, log, options.auditWebhookURL, audit.DefaultWebhookTimeout, audit.DefaultWebhookQueueSize))
+	}
+
+	return audit.NewLogger(log, audit.NewRedactionPolicy(options.auditRedactFields...), options.auditRetainedEntries, sinks...)
+}
+
+func createRecordingStore(options serverRunOptions, prov providers) (recording.Store, error) {
+	switch options.terminalRecording.Store {
+	case recording.StoreLocal:
+		return recording.NewLocalStore(options.terminalRecording.Dir)
+	case recording.StoreS3:
+		return recording.NewS3Store(prov.seedsGetter, prov.seedClientGetter, options.terminalRecording.Seed, options.terminalRecording.Destination, options.caBundle.String()), nil
+	default:
+		return nil, nil
+	}
+}
+
+// createLeaderLock returns the lease of the replica which runs the tasks of the API server which only one replica
+// may run, like the cluster upgrade workflows and the periodic preset health checks.
+func createLeaderLock(options serverRunOptions, mgr manager.Manager) (resourcelock.Interface, error) {
+	kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: options.namespace,
+			Name:      "kubermatic-api-leader",
+		},
+		Client: kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			// the replicas of a deployment have unique host names
+			Identity: hostname,
+		},
+	}, nil
+}
+
+// runAsLeader runs the tasks while the replica holds the lock, their context is cancelled when it loses the lock.
+// The replica campaigns again after it lost the lock, until the server shuts down.
+func runAsLeader(ctx context.Context, lock resourcelock.Interface, log *zap.SugaredLogger, tasks ...func(context.Context)) {
+	config := leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   30 * time.Second,
+		RenewDeadline:   20 * time.Second,
+		RetryPeriod:     5 * time.Second,
+		ReleaseOnCancel: true,
+		Name:            "kubermatic-api",
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infow("Started leading", "identity", lock.Identity())
+				var wg sync.WaitGroup
+				for _, task := range tasks {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						task(ctx)
+					}()
+				}
+				wg.Wait()
+			},
+			OnStoppedLeading: func() {
+				log.Infow("Stopped leading", "identity", lock.Identity())
+			},
+		},
+	}
+
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		leaderelection.RunOrDie(ctx, config)
+	}, config.RetryPeriod)
+}
+
 func createAPIHandler(
+	ctx context.Context,
 	options serverRunOptions, prov providers,
 	tokenVerifiers authtypes.TokenVerifier,
 	tokenExtractors authtypes.TokenExtractor,
@@ -542,15 +749,19 @@ func createAPIHandler(
 		}
 	}
 
-	serviceAccountTokenGenerator, err := serviceaccount.JWTTokenGenerator([]byte(options.serviceAccountSigningKey))
+	serviceAccountTokenGenerator, err := createServiceAccountTokenGenerator(options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create service account token generator: %w", err)
 	}
-	serviceAccountTokenAuth := serviceaccount.JWTTokenAuthenticator([]byte(options.serviceAccountSigningKey))
+	serviceAccountTokenAuth, err := createServiceAccountTokenAuthenticator(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service account token authenticator: %w", err)
+	}
 
 	routingParams := handler.RoutingParams{
 		Log:                                            kubermaticlog.New(options.log.Debug, options.log.Format).Sugar(),
 		PresetProvider:                                 prov.presetProvider,
+		CredentialResolver:                             prov.credentialResolver,
 		SeedsGetter:                                    prov.seedsGetter,
 		SeedsClientGetter:                              prov.seedClientGetter,
 		KubermaticConfigurationGetter:                  prov.configGetter,
@@ -579,10 +790,16 @@ func createAPIHandler(
 		ExposeStrategy:                                 options.exposeStrategy,
 		UserInfoGetter:                                 prov.userInfoGetter,
 		SettingsProvider:                               prov.settingsProvider,
+		ChargebackSchemaProvider:                       prov.chargebackSchemaProvider,
 		AdminProvider:                                  prov.adminProvider,
 		AdmissionPluginProvider:                        prov.admissionPluginProvider,
 		SettingsWatcher:                                prov.settingsWatcher,
 		UserWatcher:                                    prov.userWatcher,
+		ProjectWatcher:                                 prov.projectWatcher,
+		AuditLogger:                                    prov.auditLogger,
+		HealthHistory:                                  prov.healthHistory,
+		PresetHealthChecker:                            prov.presetHealthChecker,
+		PriceCatalog:                                   prov.priceCatalog,
 		ExternalClusterProvider:                        prov.externalClusterProvider,
 		PrivilegedExternalClusterProvider:              prov.privilegedExternalClusterProvider,
 		FeatureGatesProvider:                           prov.featureGatesProvider,
@@ -613,11 +830,34 @@ func createAPIHandler(
 		Versions:                                       options.versions,
 		CABundle:                                       options.caBundle.CertPool(),
 		Features:                                       options.featureGates,
+		UpgradeWorkflows:                               upgradeworkflow.NewWorkflows(mgr.GetClient(), options.namespace),
+	}
+
+	if options.rateLimit.Enabled() {
+		routingParams.RateLimiter = ratelimit.New(options.rateLimit)
+	}
+
+	routingParams.ProviderCache = providercache.New(options.providerCache)
+
+	if routingParams.RecordingStore, err = createRecordingStore(options, prov); err != nil {
+		return nil, fmt.Errorf("failed to create terminal recording store: %w", err)
 	}
 
 	r := handler.NewRouting(routingParams, mgr.GetClient())
 	rv2 := v2.NewV2Routing(routingParams)
 
+	leaderLock, err := createLeaderLock(options, mgr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the leader lock: %w", err)
+	}
+	leaderTasks := []func(context.Context){rv2.RunUpgradeWorkflows}
+	if options.presetHealthCheckInterval > 0 {
+		leaderTasks = append(leaderTasks, func(ctx context.Context) {
+			prov.presetHealthChecker.Run(ctx, options.presetHealthCheckInterval, log)
+		})
+	}
+	runAsLeader(ctx, leaderLock, log, leaderTasks...)
+
 	registerMetrics()
 
 	mainRouter := mux.NewRouter()
@@ -667,7 +907,28 @@ func createAPIHandler(
 		return name
 	}
 
-	return instrumentHandler(mainRouter, lookupRoute), nil
+	return instrumentHandler(tracing.NewHandler(mainRouter, lookupRoute), lookupRoute), nil
+}
+
+// tracedSeedKubeconfigGetter returns configs whose clients trace all requests to the seed clusters.
+func tracedSeedKubeconfigGetter(getter provider.SeedKubeconfigGetter) provider.SeedKubeconfigGetter {
+	return func(seed *kubermaticv1.Seed) (*rest.Config, error) {
+		cfg, err := getter(seed)
+		if err != nil {
+			return nil, err
+		}
+		return tracing.WrapConfig(cfg), nil
+	}
+}
+
+func recordingSeedClientGetter(getter provider.SeedClientGetter) provider.SeedClientGetter {
+	return func(seed *kubermaticv1.Seed) (ctrlruntimeclient.Client, error) {
+		client, err := getter(seed)
+		if err != nil {
+			return nil, err
+		}
+		return audit.NewRecordingClient(client), nil
+	}
 }
 
 func setSecureHeaders(next http.Handler) http.Handler {
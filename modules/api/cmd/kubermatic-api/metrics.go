@@ -24,6 +24,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	"k8c.io/dashboard/v2/pkg/ratelimit"
 )
 
 var metrics = common.ServerMetrics{
@@ -53,6 +55,8 @@ func registerMetrics() {
 	prometheus.MustRegister(metrics.HTTPRequestsTotal)
 	prometheus.MustRegister(metrics.HTTPRequestsDuration)
 	prometheus.MustRegister(metrics.InitNodeDeploymentFailures)
+	ratelimit.RegisterMetrics()
+	providercache.RegisterMetrics()
 }
 
 // RouteLookupFunc is a delegate for getting a unique identifier for the route which matches the passed request.
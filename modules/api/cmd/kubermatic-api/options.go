@@ -17,19 +17,34 @@ limitations under the License.
 package main
 
 import (
+	"crypto"
 	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/gorilla/securecookie"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 
+	"k8c.io/dashboard/v2/pkg/audit"
+	"k8c.io/dashboard/v2/pkg/credentials"
+	"k8c.io/dashboard/v2/pkg/handler/auth"
+	"k8c.io/dashboard/v2/pkg/healthhistory"
+	"k8c.io/dashboard/v2/pkg/notification"
+	"k8c.io/dashboard/v2/pkg/presethealth"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	"k8c.io/dashboard/v2/pkg/ratelimit"
+	"k8c.io/dashboard/v2/pkg/recording"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
+	"k8c.io/dashboard/v2/pkg/tracing"
 	"k8c.io/dashboard/v2/pkg/watcher"
+	kuberneteswatcher "k8c.io/dashboard/v2/pkg/watcher/kubernetes"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/features"
@@ -70,6 +85,52 @@ type serverRunOptions struct {
 
 	// service account configuration
 	serviceAccountSigningKey string
+	// serviceAccountPrivateKey signs the tokens asymmetrically instead of the HMAC serviceAccountSigningKey
+	serviceAccountPrivateKey crypto.Signer
+	// serviceAccountVerificationKeys are the public keys accepted for asymmetrically signed tokens
+	serviceAccountVerificationKeys []crypto.PublicKey
+
+	// interval in which the resources of watched projects are synchronized
+	projectWatchSyncInterval time.Duration
+
+	// audit log configuration
+	auditLogFile         string
+	auditLogStdout       bool
+	auditWebhookURL      string
+	auditRedactFields    []string
+	auditRetainedEntries int
+
+	tracing tracing.Options
+
+	// rate limits of the API calls per route class
+	rateLimit ratelimit.Config
+
+	// TTLs of the cached responses of the provider discovery endpoints
+	providerCache providercache.Config
+
+	// store of the web terminal session recordings
+	terminalRecording recording.Config
+
+	// sampling of the cluster health for the health history
+	healthHistory healthhistory.Config
+
+	// price catalog for the cost estimation of clusters
+	priceCatalogFile            string
+	priceCatalogRefreshInterval time.Duration
+
+	// channels of the notifications, e.g. about resource quota alerts
+	notification notification.Config
+
+	// external stores of the credentials referenced by presets
+	presetCredentials credentials.Config
+
+	// interval of the periodic checks of the preset credentials, 0 disables them
+	presetHealthCheckInterval time.Duration
+
+	// interval in which the trusted OIDC issuers are reloaded from the KubermaticConfiguration
+	oidcIssuersReloadInterval time.Duration
+	// email domains of the users of --oidc-url, the additional issuers must not authenticate them
+	oidcPrimaryEmailDomains []string
 
 	featureGates features.FeatureGate
 	versions     kubermatic.Versions
@@ -81,6 +142,16 @@ func newServerRunOptions() (serverRunOptions, error) {
 		rawExposeStrategy string
 		caBundleFile      string
 		configFile        string
+		auditRedactFields string
+		providerCacheTTLs string
+
+		serviceAccountPrivateKeyFile       string
+		serviceAccountVerificationKeyFiles string
+
+		notificationSMTPTo string
+
+		presetCredentialsSecretNamespaces string
+		oidcPrimaryEmailDomains           string
 	)
 
 	s.log = kubermaticlog.NewDefaultOptions()
@@ -105,6 +176,50 @@ func newServerRunOptions() (serverRunOptions, error) {
 	flag.Var(&s.featureGates, "feature-gates", "A set of key=value pairs that describe feature gates for various features.")
 	flag.StringVar(&s.domain, "domain", "localhost", "A domain name on which the server is deployed")
 	flag.StringVar(&s.serviceAccountSigningKey, "service-account-signing-key", "", "Signing key authenticates the service account's token value using HMAC. It is recommended to use a key with 32 bytes or longer.")
+	flag.StringVar(&serviceAccountPrivateKeyFile, "service-account-private-key-file", "", "Path to a PEM encoded RSA or Ed25519 private key. If set, service account tokens are signed with RS256 or EdDSA instead of the service-account-signing-key and the public key is published in the JWKS.")
+	flag.StringVar(&serviceAccountVerificationKeyFiles, "service-account-verification-key-files", "", "Comma separated list of PEM files with additional public keys accepted for service account tokens, e.g. the key used before a rotation")
+	flag.DurationVar(&s.projectWatchSyncInterval, "project-watch-sync-interval", kuberneteswatcher.DefaultProjectSyncInterval, "The interval in which the clusters, machine deployments, nodes and events of projects watched via websocket are synchronized")
+	flag.StringVar(&s.auditLogFile, "audit-log-file", "", "The file to which the audit log of mutating API calls is appended as JSON lines")
+	flag.BoolVar(&s.auditLogStdout, "audit-log-stdout", false, "Write the audit log of mutating API calls as JSON lines to stdout")
+	flag.StringVar(&s.auditWebhookURL, "audit-webhook-url", "", "The URL to which every audit log entry is sent in a POST request")
+	flag.StringVar(&auditRedactFields, "audit-redact-fields", "", "Comma separated list of additional fields of the changed objects redacted in the audit log, credentials of presets and cloud specs are always redacted")
+	flag.IntVar(&s.auditRetainedEntries, "audit-retained-entries", audit.DefaultRetainedEntries, "The number of recent audit log entries kept in memory of each API replica for the admin audit endpoint. The endpoint only returns the entries of the replica serving the request and they are lost on restart, the file, stdout and webhook sinks hold the complete audit log")
+	flag.StringVar(&s.tracing.OTLPEndpoint, "tracing-otlp-endpoint", "", "The URL of the OTLP/HTTP collector to which the traces of the API requests are exported, e.g. http://otel-collector:4318. Tracing is disabled if empty")
+	flag.Float64Var(&s.tracing.SampleRatio, "tracing-sample-ratio", 1, "The fraction of requests without a sampled trace context which are traced, between 0 and 1")
+	s.rateLimit = ratelimit.Config{Principal: map[ratelimit.Class]*ratelimit.Policy{}, Project: map[ratelimit.Class]*ratelimit.Policy{}}
+	for _, class := range ratelimit.Classes {
+		s.rateLimit.Principal[class] = &ratelimit.Policy{}
+		s.rateLimit.Project[class] = &ratelimit.Policy{}
+		flag.Var(s.rateLimit.Principal[class], "rate-limit-"+string(class), fmt.Sprintf("The limit of %s API calls per user or service account token in the format <qps>/<burst>[/<max in-flight>], e.g. 5/10/2. Unlimited if empty", class))
+		flag.Var(s.rateLimit.Project[class], "project-rate-limit-"+string(class), fmt.Sprintf("The limit of %s API calls of all members of a project together in the format <qps>/<burst>[/<max in-flight>]. Unlimited if empty", class))
+	}
+	flag.DurationVar(&s.providerCache.DefaultTTL, "provider-cache-ttl", providercache.DefaultTTL, "The time the responses of the cloud provider discovery endpoints, e.g. sizes and networks, are cached for. 0 disables the cache")
+	flag.StringVar(&providerCacheTTLs, "provider-cache-ttls", "", "Comma separated list of cache TTLs overriding -provider-cache-ttl for single providers, e.g. gcp=10m,openstack=1m")
+	flag.StringVar(&s.terminalRecording.Store, "terminal-recording-store", "", fmt.Sprintf("The store web terminal sessions are recorded to in the asciinema v2 format, either %q or %q. Sessions are not recorded if empty", recording.StoreLocal, recording.StoreS3))
+	flag.StringVar(&s.terminalRecording.Dir, "terminal-recording-dir", "", "The directory of the local terminal recording store, e.g. a mounted volume")
+	flag.StringVar(&s.terminalRecording.Seed, "terminal-recording-seed", "", "The seed whose etcd backup destination is used by the S3 terminal recording store. Sessions are spooled to the temporary directory and uploaded when they end")
+	flag.DurationVar(&s.healthHistory.Interval, "health-history-interval", healthhistory.DefaultInterval, "The interval the control plane health of all clusters is sampled in for the cluster health history. The samples are kept in memory of each API replica only, so the history is best-effort: it is lost on restart and may differ between replicas. 0 disables the health history")
+	flag.DurationVar(&s.healthHistory.Retention, "health-history-retention", healthhistory.DefaultRetention, "The time the health samples are kept for, which is the longest window the cluster health history can report")
+	flag.StringVar(&s.priceCatalogFile, "price-catalog-file", "", "The YAML file with the prices of the cloud providers the cost of clusters is estimated with, e.g. a mounted ConfigMap. The cost estimation is disabled if empty")
+	flag.DurationVar(&s.priceCatalogRefreshInterval, "price-catalog-refresh-interval", pricing.DefaultRefreshInterval, "The interval in which the price catalog file is reloaded if it changed")
+	flag.StringVar(&s.terminalRecording.Destination, "terminal-recording-backup-destination", "", "The etcd backup destination of the seed whose bucket and credentials are used by the S3 terminal recording store")
+	flag.StringVar(&s.notification.WebhookURL, "notification-webhook-url", "", "The URL to which notifications, like resource quota alerts, are sent as JSON in a POST request")
+	flag.StringVar(&s.notification.SlackWebhookURL, "notification-slack-webhook-url", "", "The URL of a Slack-compatible incoming webhook to which notifications, like resource quota alerts, are sent")
+	flag.StringVar(&s.notification.SMTPAddress, "notification-smtp-address", "", "The host:port of an SMTP relay, e.g. a local relay handling the authentication, through which notifications are mailed")
+	flag.StringVar(&s.notification.SMTPFrom, "notification-smtp-from", "", "The sender of the notification mails")
+	flag.StringVar(&notificationSMTPTo, "notification-smtp-to", "", "Comma separated list of the recipients of the notification mails")
+	flag.StringVar(&s.presetCredentials.Vault.Address, "preset-credentials-vault-address", "", "The URL of the HashiCorp Vault which preset credentials can reference with ref+vault://<path>#<key>")
+	flag.StringVar(&s.presetCredentials.Vault.Namespace, "preset-credentials-vault-namespace", "", "The Vault Enterprise namespace of the referenced secrets")
+	flag.StringVar(&s.presetCredentials.Vault.TokenFile, "preset-credentials-vault-token-file", "", "The file with the Vault token, e.g. written by the Vault agent. If empty, the API logs in with its service account through the Kubernetes auth method")
+	flag.StringVar(&s.presetCredentials.Vault.KubernetesRole, "preset-credentials-vault-kubernetes-role", "", "The role of the Kubernetes auth method of Vault the API logs in with")
+	flag.StringVar(&s.presetCredentials.Vault.KubernetesAuthMount, "preset-credentials-vault-kubernetes-auth-mount", credentials.DefaultVaultKubernetesAuthMount, "The mount path of the Kubernetes auth method of Vault")
+	flag.StringVar(&s.presetCredentials.FileRoot, "preset-credentials-file-root", "", "The directory, e.g. a volume of the Secrets Store CSI driver, whose files preset credentials can reference with ref+file://<path>#<key>")
+	flag.StringVar(&presetCredentialsSecretNamespaces, "preset-credentials-secret-namespaces", "", "Comma separated list of namespaces whose secrets preset credentials can reference with ref+secret://<namespace>/<name>#<key>, the API requires the permission to read them")
+	flag.DurationVar(&s.presetCredentials.CacheTTL, "preset-credentials-cache-ttl", credentials.DefaultCacheTTL, "The time for which the referenced preset credentials are cached, dynamic secrets are cached while they are used within this time and their lease is renewed")
+	flag.IntVar(&s.presetCredentials.CacheSize, "preset-credentials-cache-size", credentials.DefaultCacheSize, "The maximum number of cached secrets of the referenced preset credentials, the leases of evicted dynamic secrets are revoked")
+	flag.DurationVar(&s.presetHealthCheckInterval, "preset-health-check-interval", 0, "The interval in which the credentials of all presets are checked with an authenticated call to their providers, the results are shown in the status of the presets. The checks are run by the leader of the API replicas only. 0 disables the periodic checks")
+	flag.DurationVar(&s.oidcIssuersReloadInterval, "oidc-issuers-reload-interval", 30*time.Second, fmt.Sprintf("The interval in which the additional trusted OIDC issuers are reloaded from the %q annotation of the KubermaticConfiguration", auth.FederatedIssuersAnnotation))
+	flag.StringVar(&oidcPrimaryEmailDomains, "oidc-primary-email-domains", "", "Comma separated list of the email domains of the users of the OIDC issuer, the additional trusted OIDC issuers cannot authenticate users of these domains or their subdomains. Required to trust additional issuers")
 	flag.StringVar(&rawExposeStrategy, "expose-strategy", "NodePort", "The strategy to expose the controlplane with, either \"NodePort\" which creates NodePorts with a \"nodeport-proxy.k8s.io/expose: true\" annotation or \"LoadBalancer\", which creates a LoadBalancer")
 	flag.StringVar(&s.namespace, "namespace", "kubermatic", "The namespace kubermatic runs in, uses to determine where to look for datacenter custom resources")
 	flag.StringVar(&configFile, "kubermatic-configuration-file", "", "(for development only) path to a KubermaticConfiguration YAML file")
@@ -124,6 +239,89 @@ func newServerRunOptions() (serverRunOptions, error) {
 		}
 	}
 
+	for _, field := range strings.Split(auditRedactFields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			s.auditRedactFields = append(s.auditRedactFields, field)
+		}
+	}
+
+	for _, recipient := range strings.Split(notificationSMTPTo, ",") {
+		if recipient = strings.TrimSpace(recipient); recipient != "" {
+			s.notification.SMTPTo = append(s.notification.SMTPTo, recipient)
+		}
+	}
+
+	for _, namespace := range strings.Split(presetCredentialsSecretNamespaces, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			s.presetCredentials.SecretNamespaces = append(s.presetCredentials.SecretNamespaces, namespace)
+		}
+	}
+
+	for _, domain := range strings.Split(oidcPrimaryEmailDomains, ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			s.oidcPrimaryEmailDomains = append(s.oidcPrimaryEmailDomains, domain)
+		}
+	}
+
+	providerCacheTTLMap, err := providercache.ParseTTLs(providerCacheTTLs)
+	if err != nil {
+		return s, fmt.Errorf("invalid -provider-cache-ttls: %w", err)
+	}
+	s.providerCache.TTLs = providerCacheTTLMap
+
+	if err := s.terminalRecording.Validate(); err != nil {
+		return s, fmt.Errorf("invalid terminal recording configuration: %w", err)
+	}
+
+	if err := s.healthHistory.Validate(); err != nil {
+		return s, fmt.Errorf("invalid health history configuration: %w", err)
+	}
+
+	if s.presetHealthCheckInterval < 0 {
+		return s, errors.New("-preset-health-check-interval must not be negative")
+	}
+
+	if s.presetCredentials.CacheTTL <= 0 {
+		return s, errors.New("-preset-credentials-cache-ttl must be positive")
+	}
+	if s.presetCredentials.CacheSize <= 0 {
+		return s, errors.New("-preset-credentials-cache-size must be positive")
+	}
+
+	if s.oidcIssuersReloadInterval <= 0 {
+		return s, errors.New("-oidc-issuers-reload-interval must be positive")
+	}
+
+	if s.priceCatalogFile != "" && s.priceCatalogRefreshInterval <= 0 {
+		return s, errors.New("-price-catalog-refresh-interval must be positive")
+	}
+
+	if serviceAccountPrivateKeyFile != "" {
+		data, err := os.ReadFile(serviceAccountPrivateKeyFile)
+		if err != nil {
+			return s, fmt.Errorf("failed to read service account private key: %w", err)
+		}
+		if s.serviceAccountPrivateKey, err = serviceaccount.ParsePrivateKey(data); err != nil {
+			return s, fmt.Errorf("invalid service account private key: %w", err)
+		}
+		s.serviceAccountVerificationKeys = append(s.serviceAccountVerificationKeys, s.serviceAccountPrivateKey.Public())
+	}
+
+	for _, file := range strings.Split(serviceAccountVerificationKeyFiles, ",") {
+		if file = strings.TrimSpace(file); file == "" {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return s, fmt.Errorf("failed to read service account verification key: %w", err)
+		}
+		keys, err := serviceaccount.ParsePublicKeys(data)
+		if err != nil {
+			return s, fmt.Errorf("invalid service account verification key in %s: %w", file, err)
+		}
+		s.serviceAccountVerificationKeys = append(s.serviceAccountVerificationKeys, keys...)
+	}
+
 	if len(caBundleFile) == 0 {
 		return s, errors.New("no -ca-bundle configured")
 	}
@@ -135,6 +333,7 @@ func newServerRunOptions() (serverRunOptions, error) {
 
 	s.caBundle = cabundle
 	s.versions = kubermatic.GetVersions()
+	s.tracing.ServiceName = "kubermatic-api"
 
 	s.oidcIssuerConfiguration = &authtypes.OIDCConfiguration{
 		URL:                  s.oidcURL,
@@ -156,8 +355,11 @@ func newServerRunOptions() (serverRunOptions, error) {
 }
 
 func (o serverRunOptions) validate() error {
-	if err := serviceaccount.ValidateKey([]byte(o.serviceAccountSigningKey)); err != nil {
-		return fmt.Errorf("the service-account-signing-key is incorrect: %w", err)
+	// with an asymmetric key the HMAC key is only needed to verify previously issued tokens
+	if o.serviceAccountPrivateKey == nil || o.serviceAccountSigningKey != "" {
+		if err := serviceaccount.ValidateKey([]byte(o.serviceAccountSigningKey)); err != nil {
+			return fmt.Errorf("the service-account-signing-key is incorrect: %w", err)
+		}
 	}
 
 	return nil
@@ -186,11 +388,18 @@ type providers struct {
 	addonConfigProvider                            provider.AddonConfigProvider
 	userInfoGetter                                 provider.UserInfoGetter
 	settingsProvider                               provider.SettingsProvider
+	chargebackSchemaProvider                       provider.ChargebackSchemaProvider
 	adminProvider                                  provider.AdminProvider
 	presetProvider                                 provider.PresetProvider
+	credentialResolver                             *credentials.Resolver
 	admissionPluginProvider                        provider.AdmissionPluginsProvider
 	settingsWatcher                                watcher.SettingsWatcher
 	userWatcher                                    watcher.UserWatcher
+	projectWatcher                                 watcher.ProjectWatcher
+	auditLogger                                    *audit.Logger
+	healthHistory                                  *healthhistory.History
+	presetHealthChecker                            *presethealth.Checker
+	priceCatalog                                   pricing.Source
 	externalClusterProvider                        provider.ExternalClusterProvider
 	privilegedExternalClusterProvider              provider.PrivilegedExternalClusterProvider
 	featureGatesProvider                           provider.FeatureGatesProvider
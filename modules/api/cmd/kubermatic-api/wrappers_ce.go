@@ -22,10 +22,14 @@ import (
 	"context"
 	"flag"
 
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/notification"
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
 
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 func addFlags(fs *flag.FlagSet) {
@@ -55,3 +59,7 @@ func backupStorageProviderFactory(_ kubernetes.ImpersonationClient, _ ctrlruntim
 func policyTemplateProviderFactory(_ ctrlruntimeclient.Client) provider.PolicyTemplateProvider {
 	return nil
 }
+
+func startResourceQuotaAlerts(_ context.Context, _ manager.Manager, _ notification.Notifier, _ *zap.SugaredLogger) error {
+	return nil
+}
@@ -22,11 +22,15 @@ import (
 	"context"
 	"flag"
 
+	"go.uber.org/zap"
+
 	eeapi "k8c.io/dashboard/v2/pkg/ee/cmd/kubermatic-api"
+	"k8c.io/dashboard/v2/pkg/notification"
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
 
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 func addFlags(fs *flag.FlagSet) {
@@ -56,3 +60,7 @@ func backupStorageProviderFactory(createMasterImpersonatedClient kubernetes.Impe
 func policyTemplateProviderFactory(privilegedClient ctrlruntimeclient.Client) provider.PolicyTemplateProvider {
 	return eeapi.PolicyTemplateProviderFactory(privilegedClient)
 }
+
+func startResourceQuotaAlerts(ctx context.Context, mgr manager.Manager, notifier notification.Notifier, log *zap.SugaredLogger) error {
+	return eeapi.StartResourceQuotaAlerts(ctx, mgr.GetCache(), mgr.GetClient(), notifier, log)
+}
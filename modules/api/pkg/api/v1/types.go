@@ -680,6 +680,74 @@ type Admin struct {
 	GrantedByGroup string `json:"grantedByGroup,omitempty"`
 }
 
+// AuditEntry represents a recorded mutating API call
+// swagger:model AuditEntry
+type AuditEntry struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	// User is the email of the user or service account which made the call
+	User string `json:"user"`
+	// Groups of the user taken from the token
+	Groups []string `json:"groups,omitempty"`
+	// ImpersonatedGroups are the project groups used on behalf of the user
+	ImpersonatedGroups []string `json:"impersonatedGroups,omitempty"`
+	// Admin indicates that the call was made by an admin user
+	Admin     bool   `json:"admin,omitempty"`
+	ProjectID string `json:"projectID,omitempty"`
+	ClusterID string `json:"clusterID,omitempty"`
+	Method    string `json:"method"`
+	// Route is the path template of the called endpoint
+	Route      string `json:"route"`
+	Path       string `json:"path"`
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	UserAgent  string `json:"userAgent,omitempty"`
+	// Changes are the objects created, updated or deleted by the call
+	Changes []AuditChange `json:"changes,omitempty"`
+	// Code is the HTTP status code of the call, successful calls are recorded with 200
+	Code int `json:"code"`
+	// Error is the error message of failed calls
+	Error string `json:"error,omitempty"`
+	// DurationMs is the time the call took in milliseconds
+	DurationMs int64 `json:"durationMs"`
+}
+
+// AuditChange is an object changed by an audited API call, with the credentials redacted
+// swagger:model AuditChange
+type AuditChange struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Before is the object before the call, empty if it was created
+	Before json.RawMessage `json:"before,omitempty"`
+	// After is the object after the call, empty if it was deleted
+	After json.RawMessage `json:"after,omitempty"`
+}
+
+// ProviderCacheInvalidation is the result of clearing the cached provider discovery responses
+// swagger:model ProviderCacheInvalidation
+type ProviderCacheInvalidation struct {
+	// Removed is the number of removed responses
+	Removed int `json:"removed"`
+}
+
+// TerminalRecording is a recorded web terminal session
+// swagger:model TerminalRecording
+type TerminalRecording struct {
+	// Name is the name of the recording, it is unique per cluster
+	Name      string `json:"name"`
+	ClusterID string `json:"clusterID"`
+	// Size is the size of the recording in bytes
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// TerminalRecordingContent is a recorded web terminal session in the asciinema v2 format
+// swagger:response TerminalRecordingContent
+type TerminalRecordingContent struct {
+	// in: body
+	Content []byte
+}
+
 // ProjectGroup is a helper data structure that
 // stores the information about a project and a group prefix that a user belongs to.
 type ProjectGroup struct {
@@ -718,6 +786,17 @@ type PublicServiceAccountToken struct {
 	Expiry Time `json:"expiry,omitempty"`
 	// Invalidated indicates if the token must be regenerated
 	Invalidated bool `json:"invalidated,omitempty"`
+	// Scope limits what the token can be used for, a token without scope has all permissions of the service account
+	Scope *ServiceAccountTokenScope `json:"scope,omitempty"`
+}
+
+// ServiceAccountTokenScope limits what a service account token can be used for
+// swagger:model ServiceAccountTokenScope
+type ServiceAccountTokenScope struct {
+	// ReadOnly allows only GET, HEAD and OPTIONS requests, without the web terminal, pod exec and the admin kubeconfig
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// ClusterIDs allows only requests for the given clusters
+	ClusterIDs []string `json:"clusterIDs,omitempty"`
 }
 
 // ServiceAccountToken represent an API service account token
@@ -726,6 +805,31 @@ type ServiceAccountToken struct {
 	PublicServiceAccountToken
 	// Token the JWT token
 	Token string `json:"token,omitempty"`
+	// TTL is the lifetime of the token set at creation, e.g. 720h. Defaults to and is limited by 3 years.
+	TTL string `json:"ttl,omitempty"`
+}
+
+// JSONWebKeySet is the set of public keys used to verify service account tokens
+// swagger:model JSONWebKeySet
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JSONWebKey is a public key in the JWK format as defined in RFC 7517
+// swagger:model JSONWebKey
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use,omitempty"`
+	// N is the modulus of an RSA key
+	N string `json:"n,omitempty"`
+	// E is the exponent of an RSA key
+	E string `json:"e,omitempty"`
+	// Curve is the curve of an OKP key, e.g. Ed25519
+	Curve string `json:"crv,omitempty"`
+	// X is the public key of an OKP key
+	X string `json:"x,omitempty"`
 }
 
 // Project is a top-level container for a set of resources
@@ -1508,6 +1612,79 @@ type ClusterHealth struct {
 	Kyverno                      *kubermaticv1.HealthStatus `json:"kyverno,omitempty"`
 }
 
+// ClusterHealthHistory is the uptime of the control plane components of a cluster in time windows ending now.
+// It is sampled by the API replica serving the request and starts over when the replica restarts.
+// swagger:model ClusterHealthHistory
+type ClusterHealthHistory struct {
+	// SampleInterval is the time between two samples of the cluster health.
+	SampleInterval string `json:"sampleInterval"`
+	// Windows are the requested time windows.
+	Windows []ClusterHealthWindow `json:"windows"`
+}
+
+// ClusterHealthWindow is the uptime of the control plane components of a cluster in a time window.
+// swagger:model ClusterHealthWindow
+type ClusterHealthWindow struct {
+	// Window is the duration of the window, e.g. 24h0m0s.
+	Window string `json:"window"`
+	// Samples is the number of health samples in the window. It is lower than expected if the cluster
+	// was sampled for a shorter time than the window, e.g. after the replica restarted.
+	Samples int `json:"samples"`
+	// Uptime is the percentage of the samples in which a component was up, by component. Components which
+	// are not deployed or still provisioning are not counted.
+	Uptime map[string]float64 `json:"uptime"`
+}
+
+const (
+	// UpgradePlanSafe means all preflight checks passed.
+	UpgradePlanSafe = "Safe"
+	// UpgradePlanWarning means the upgrade is possible, but some checks need attention first.
+	UpgradePlanWarning = "Warning"
+	// UpgradePlanBlocked means the upgrade would be rejected or break the cluster.
+	UpgradePlanBlocked = "Blocked"
+
+	UpgradePreflightCheckPassed  = "Passed"
+	UpgradePreflightCheckWarning = "Warning"
+	UpgradePreflightCheckFailed  = "Failed"
+
+	UpgradePlanStepControlPlane      = "ControlPlane"
+	UpgradePlanStepMachineDeployment = "MachineDeployment"
+)
+
+// ClusterUpgradePlan is the result of a dry-run of a cluster upgrade to a target version.
+// swagger:model ClusterUpgradePlan
+type ClusterUpgradePlan struct {
+	CurrentVersion string `json:"currentVersion"`
+	TargetVersion  string `json:"targetVersion"`
+	// Verdict is Safe, Warning or Blocked, depending on the worst result of the checks.
+	Verdict string                  `json:"verdict"`
+	Checks  []UpgradePreflightCheck `json:"checks"`
+	// Steps are the upgrades to perform in order.
+	Steps []UpgradePlanStep `json:"steps"`
+}
+
+// UpgradePreflightCheck is the result of a check run before a cluster upgrade.
+// swagger:model UpgradePreflightCheck
+type UpgradePreflightCheck struct {
+	Name string `json:"name"`
+	// Status is Passed, Warning or Failed.
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Details are the objects which caused a warning or failure.
+	Details []string `json:"details,omitempty"`
+}
+
+// UpgradePlanStep is a single upgrade of a cluster upgrade plan.
+// swagger:model UpgradePlanStep
+type UpgradePlanStep struct {
+	Order int `json:"order"`
+	// Kind is ControlPlane or MachineDeployment.
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
 // AccessibleAddons represents an array of addons that can be configured in the user clusters.
 // swagger:model AccessibleAddons
 type AccessibleAddons []string
@@ -3188,6 +3365,34 @@ type MeteringReportConfiguration struct {
 // swagger:model MeteringReportURL
 type ReportURL string
 
+// MeteringReportAggregation holds the rows of metering reports aggregated by their dimensions
+// swagger:model MeteringReportAggregation
+type MeteringReportAggregation struct {
+	// From is the first day of the aggregated reports
+	From string `json:"from,omitempty"`
+	// To is the last day of the aggregated reports
+	To string `json:"to,omitempty"`
+	// Type is the type of the aggregated reports, cluster or namespace
+	Type string `json:"type"`
+	// GroupBy are the dimensions the rows are aggregated by
+	GroupBy []string `json:"groupBy"`
+	// Reports are the names of the aggregated reports
+	Reports []string               `json:"reports"`
+	Records []MeteringReportRecord `json:"records"`
+}
+
+// MeteringReportRecord holds the aggregated values of the report rows with the same dimensions
+// swagger:model MeteringReportRecord
+type MeteringReportRecord struct {
+	// Dimensions are the values of the dimensions, e.g. the project ID and name or the period
+	Dimensions map[string]string `json:"dimensions"`
+	// Rows is the number of aggregated report rows
+	Rows int `json:"rows"`
+	// Values are the aggregated values by column. Averages are averaged, maximums and minimums keep their
+	// extreme and all other values are summed up.
+	Values map[string]float64 `json:"values"`
+}
+
 // JoiningScript represent an encoded joining script for machines
 // swagger:model JoiningScript
 type JoiningScript string
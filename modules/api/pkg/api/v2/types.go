@@ -196,6 +196,79 @@ type PresetLinkages struct {
 	ClusterTemplates []ClusterTemplateAssociation `json:"clusterTemplates"`
 }
 
+// PresetHealth represents the results of the last checks of the credentials of the providers of a preset.
+// swagger:model PresetHealth
+type PresetHealth struct {
+	PresetName string                 `json:"presetName"`
+	Providers  []PresetProviderHealth `json:"providers"`
+}
+
+type PresetCredentialStatus string
+
+const (
+	// ValidPresetCredentialStatus indicates that an authenticated call to the provider succeeded.
+	ValidPresetCredentialStatus PresetCredentialStatus = "Valid"
+
+	// InvalidPresetCredentialStatus indicates that the provider rejected the credentials or they could not be resolved.
+	InvalidPresetCredentialStatus PresetCredentialStatus = "Invalid"
+
+	// UnknownPresetCredentialStatus indicates that the credentials cannot be checked, e.g. because there is no
+	// datacenter of the provider or the credentials are taken from the token of the user.
+	UnknownPresetCredentialStatus PresetCredentialStatus = "Unknown"
+)
+
+// PresetProviderHealth represents the result of the last check of the credentials of a provider of a preset.
+// swagger:model PresetProviderHealth
+type PresetProviderHealth struct {
+	Provider kubermaticv1.ProviderType `json:"provider"`
+	Status   PresetCredentialStatus    `json:"status"`
+	// Message describes why the credentials are invalid or cannot be checked.
+	Message string `json:"message,omitempty"`
+	// Datacenter is the datacenter the credentials were checked against, for providers whose endpoint is configured
+	// in the datacenter.
+	Datacenter  string     `json:"datacenter,omitempty"`
+	LastChecked apiv1.Time `json:"lastChecked"`
+}
+
+// PresetCredentialRotation contains the new credentials of a provider of a preset.
+// swagger:model PresetCredentialRotation
+type PresetCredentialRotation struct {
+	// Spec contains the new configuration of the provider, e.g. spec.aws, which replaces the current one like an
+	// update of the preset.
+	Spec kubermaticv1.PresetSpec `json:"spec"`
+	// RollbackOnFailure restores the previous credentials of the preset and of the updated clusters if the
+	// credentials of a cluster cannot be updated.
+	RollbackOnFailure bool `json:"rollbackOnFailure,omitempty"`
+}
+
+// PresetCredentialRotationReport is the result of rotating the credentials of a provider of a preset.
+// swagger:model PresetCredentialRotationReport
+type PresetCredentialRotationReport struct {
+	PresetName string                    `json:"presetName"`
+	Provider   kubermaticv1.ProviderType `json:"provider"`
+	// DryRun indicates that the changes were only planned
+	DryRun bool `json:"dryRun,omitempty"`
+	// Validation is the result of the check of the new credentials
+	Validation PresetProviderHealth `json:"validation"`
+	// RolledBack indicates that the previous credentials were restored, either because the credentials of a cluster
+	// could not be updated or on request after the rotation
+	RolledBack bool                             `json:"rolledBack,omitempty"`
+	Clusters   []PresetCredentialRotationResult `json:"clusters"`
+}
+
+// PresetCredentialRotationResult is the planned or applied update of the credentials of a cluster using a preset.
+// swagger:model PresetCredentialRotationResult
+type PresetCredentialRotationResult struct {
+	ClusterID   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	ProjectID   string `json:"projectId"`
+	Seed        string `json:"seed"`
+	// Status is one of planned, updated, failed or rolledBack
+	Status string `json:"status"`
+	// Message explains why the update of the credentials failed
+	Message string `json:"message,omitempty"`
+}
+
 // ClusterAssociation shows cluster details using a preset
 // swagger:model ClusterAssociation
 type ClusterAssociation struct {
@@ -1910,6 +1983,30 @@ type ResourceQuota struct {
 	AcceleratorAccountingEnabled bool                `json:"acceleratorAccountingEnabled"`
 	Quota                        Quota               `json:"quota"`
 	Status                       ResourceQuotaStatus `json:"status"`
+	// AlertThresholds are the soft thresholds of the quota in percent. Crossing one of them raises an alert
+	// and sends a notification.
+	AlertThresholds []int `json:"alertThresholds"`
+	// Alerts are the resources whose global usage crossed one of the alert thresholds.
+	Alerts []ResourceQuotaAlert `json:"alerts,omitempty"`
+}
+
+// ResourceQuotaAlert is raised when the usage of a resource crossed a soft threshold of its quota.
+// swagger:model ResourceQuotaAlert
+type ResourceQuotaAlert struct {
+	// Resource is the resource whose usage crossed the threshold, cpu, memory or storage.
+	Resource string `json:"resource"`
+	// Threshold is the highest threshold the usage crossed, in percent of the quota.
+	Threshold int `json:"threshold"`
+	// Usage is the global usage of the resource in percent of the quota.
+	Usage float64 `json:"usage"`
+}
+
+// ResourceQuotaAlertThresholds are the soft thresholds of a resource quota.
+// swagger:model ResourceQuotaAlertThresholds
+type ResourceQuotaAlertThresholds struct {
+	// Thresholds are the percentages of the quota between 1 and 100 at which alerts are raised, e.g. 80 and 95.
+	// No thresholds disable the alerts.
+	Thresholds []int `json:"thresholds"`
 }
 
 // swagger:model ResourceQuotaStatus
@@ -2007,6 +2104,101 @@ type GroupProjectBinding struct {
 	Role      string `json:"role"`
 }
 
+// ProjectBundle declares the resources of a project which are applied together. Resources are matched with the
+// existing ones by their names, the members by their email and the group bindings by their group.
+// swagger:model ProjectBundle
+type ProjectBundle struct {
+	SSHKeys       []ProjectBundleSSHKey       `json:"sshKeys,omitempty"`
+	Members       []ProjectBundleMember       `json:"members,omitempty"`
+	GroupBindings []ProjectBundleGroupBinding `json:"groupBindings,omitempty"`
+	Clusters      []ProjectBundleCluster      `json:"clusters,omitempty"`
+	// ClusterTemplates are matched by their names, only templates with the project scope are applied
+	ClusterTemplates []ClusterTemplate `json:"clusterTemplates,omitempty"`
+
+	// Prune deletes the existing resources which are not declared in the bundle. Machine deployments, addons
+	// and applications are only pruned in the declared clusters, default addons are never pruned.
+	Prune bool `json:"prune,omitempty"`
+}
+
+// ProjectBundleSSHKey declares an SSH key of the project.
+// swagger:model ProjectBundleSSHKey
+type ProjectBundleSSHKey struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"publicKey"`
+}
+
+// ProjectBundleMember declares a member of the project.
+// swagger:model ProjectBundleMember
+type ProjectBundleMember struct {
+	Email string `json:"email"`
+	// Group is the group of the member, e.g. owners, editors, viewers or projectmanagers
+	Group string `json:"group"`
+}
+
+// ProjectBundleGroupBinding declares the role of a group of users in the project.
+// swagger:model ProjectBundleGroupBinding
+type ProjectBundleGroupBinding struct {
+	Group string `json:"group"`
+	Role  string `json:"role"`
+}
+
+// ProjectBundleCluster declares a cluster together with its machine deployments, addons, applications,
+// constraints, rule groups, alertmanager config and etcd backup configs.
+// The credentials and the cloud spec of existing clusters are not compared, as they cannot be changed.
+// swagger:model ProjectBundleCluster
+type ProjectBundleCluster struct {
+	Cluster            apiv1.Cluster                 `json:"cluster"`
+	MachineDeployments []apiv1.NodeDeployment        `json:"machineDeployments,omitempty"`
+	Addons             []apiv1.Addon                 `json:"addons,omitempty"`
+	Applications       []ApplicationInstallationBody `json:"applications,omitempty"`
+	Constraints        []Constraint                  `json:"constraints,omitempty"`
+	RuleGroups         []RuleGroup                   `json:"ruleGroups,omitempty"`
+	Alertmanager       *Alertmanager                 `json:"alertmanager,omitempty"`
+	EtcdBackupConfigs  []EtcdBackupConfig            `json:"etcdBackupConfigs,omitempty"`
+}
+
+// ProjectExport is a project together with its resources. Credentials are not exported, clusters and cluster
+// templates created from presets reference them by name.
+// swagger:model ProjectExport
+type ProjectExport struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Spec   apiv1.ProjectSpec `json:"spec"`
+	Bundle ProjectBundle     `json:"bundle"`
+}
+
+// ProjectImportReport is the result of importing a project.
+// swagger:model ProjectImportReport
+type ProjectImportReport struct {
+	// Project is the created project, it is not set for dry runs
+	Project *apiv1.Project     `json:"project,omitempty"`
+	Report  ProjectApplyReport `json:"report"`
+}
+
+// ProjectApplyReport is the result of applying a project bundle.
+// swagger:model ProjectApplyReport
+type ProjectApplyReport struct {
+	// DryRun indicates that the changes were only planned
+	DryRun  bool                 `json:"dryRun,omitempty"`
+	Results []ProjectApplyResult `json:"results"`
+}
+
+// ProjectApplyResult is the planned or applied change of a single resource.
+// swagger:model ProjectApplyResult
+type ProjectApplyResult struct {
+	// Kind is the kind of the resource, e.g. Cluster or MachineDeployment
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Cluster is the name of the cluster of machine deployments, addons and applications
+	Cluster string `json:"cluster,omitempty"`
+	// Action is one of create, update, delete or none
+	Action string `json:"action"`
+	// Status is one of planned, applied, unchanged, skipped or failed
+	Status string `json:"status"`
+	// Message explains why a change failed or was skipped
+	Message string `json:"message,omitempty"`
+}
+
 // ApplicationInstallation is the object representing an ApplicationInstallation.
 // swagger:model ApplicationInstallation
 type ApplicationInstallation struct {
@@ -2486,3 +2678,190 @@ type BackupStorageLocationBucketObject struct {
 // BackupStorageLocationBucketObjectList represents an array of Backup Storage Location Bucket Objects.
 // swagger:model BackupStorageLocationBucketObjectList
 type BackupStorageLocationBucketObjectList []BackupStorageLocationBucketObject
+
+const (
+	UpgradeWorkflowRunning   = "Running"
+	UpgradeWorkflowPaused    = "Paused"
+	UpgradeWorkflowSucceeded = "Succeeded"
+	UpgradeWorkflowFailed    = "Failed"
+	UpgradeWorkflowAborted   = "Aborted"
+
+	UpgradeWorkflowStagePending   = "Pending"
+	UpgradeWorkflowStageRunning   = "Running"
+	UpgradeWorkflowStageSucceeded = "Succeeded"
+	UpgradeWorkflowStageFailed    = "Failed"
+	UpgradeWorkflowStageAborted   = "Aborted"
+
+	UpgradeWorkflowStageControlPlane       = "ControlPlane"
+	UpgradeWorkflowStageMachineDeployments = "MachineDeployments"
+
+	UpgradeWorkflowEventNormal  = "Normal"
+	UpgradeWorkflowEventWarning = "Warning"
+)
+
+// ClusterUpgradeWorkflowBody is the request to start the upgrade of a cluster.
+// swagger:model ClusterUpgradeWorkflowBody
+type ClusterUpgradeWorkflowBody struct {
+	// Version is the Kubernetes version to upgrade the control plane and the machine deployments to.
+	// required: true
+	Version string `json:"version"`
+	// BatchSize is the number of machine deployments upgraded at the same time, defaults to 1.
+	BatchSize int `json:"batchSize,omitempty"`
+	// StageTimeout is the time a stage may take until the cluster is healthy again, e.g. 45m. Defaults to 30m.
+	StageTimeout string `json:"stageTimeout,omitempty"`
+}
+
+// ClusterUpgradeWorkflow is an upgrade of the control plane and then the machine deployments of a cluster which
+// is run by the API server. A stage starts when the cluster became healthy after the previous one.
+// swagger:model ClusterUpgradeWorkflow
+type ClusterUpgradeWorkflow struct {
+	ID            string `json:"id"`
+	TargetVersion string `json:"targetVersion"`
+	BatchSize     int    `json:"batchSize"`
+	StageTimeout  string `json:"stageTimeout"`
+	// Phase is Running, Paused, Succeeded, Failed or Aborted.
+	Phase               string      `json:"phase"`
+	CreatedBy           string      `json:"createdBy"`
+	CreationTimestamp   apiv1.Time  `json:"creationTimestamp"`
+	CompletionTimestamp *apiv1.Time `json:"completionTimestamp,omitempty"`
+	// CompletedStages is the number of stages which succeeded.
+	CompletedStages int                           `json:"completedStages"`
+	Stages          []ClusterUpgradeWorkflowStage `json:"stages"`
+	// Events are the latest events of the workflow, the oldest first.
+	Events []ClusterUpgradeWorkflowEvent `json:"events"`
+}
+
+// ClusterUpgradeWorkflowStage is the upgrade of the control plane or of a batch of machine deployments.
+// swagger:model ClusterUpgradeWorkflowStage
+type ClusterUpgradeWorkflowStage struct {
+	Name string `json:"name"`
+	// Kind is ControlPlane or MachineDeployments.
+	Kind string `json:"kind"`
+	// MachineDeployments are the names of the upgraded machine deployments.
+	MachineDeployments []string `json:"machineDeployments,omitempty"`
+	Version            string   `json:"version"`
+	// Phase is Pending, Running, Succeeded, Failed or Aborted.
+	Phase               string      `json:"phase"`
+	Message             string      `json:"message,omitempty"`
+	StartTimestamp      *apiv1.Time `json:"startTimestamp,omitempty"`
+	CompletionTimestamp *apiv1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// ClusterUpgradeWorkflowEvent is an event of a cluster upgrade workflow.
+// swagger:model ClusterUpgradeWorkflowEvent
+type ClusterUpgradeWorkflowEvent struct {
+	Timestamp apiv1.Time `json:"timestamp"`
+	// Type is Normal or Warning.
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// CostEstimationBody is a cluster whose monthly cost is estimated before it is created.
+// swagger:model CostEstimationBody
+type CostEstimationBody struct {
+	// Datacenter is the name of the datacenter the cluster is created in.
+	// required: true
+	Datacenter string `json:"datacenter"`
+	// MachineDeployments are the machine deployments the cluster is created with.
+	MachineDeployments []apiv1.NodeDeployment `json:"machineDeployments"`
+}
+
+// ProjectCostEstimate is the estimated monthly cost of the clusters of a project.
+// swagger:model ProjectCostEstimate
+type ProjectCostEstimate struct {
+	ProjectID string `json:"projectID"`
+	Currency  string `json:"currency"`
+	// Monthly is the cost per month with the current replicas of the machine deployments.
+	Monthly float64 `json:"monthly"`
+	// MinMonthly and MaxMonthly are the cost per month if the machine deployments are scaled down or up to
+	// the limits of their autoscaling.
+	MinMonthly float64               `json:"minMonthly"`
+	MaxMonthly float64               `json:"maxMonthly"`
+	Clusters   []ClusterCostEstimate `json:"clusters"`
+	// Warnings tell why the estimate misses the cost of some nodes or clusters.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ClusterCostEstimate is the estimated monthly cost of a cluster.
+// swagger:model ClusterCostEstimate
+type ClusterCostEstimate struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Provider   string `json:"provider"`
+	Datacenter string `json:"datacenter"`
+	Currency   string `json:"currency"`
+	// ControlPlane is the cost of the control plane per month.
+	ControlPlane float64 `json:"controlPlane"`
+	// Monthly is the cost per month with the current replicas of the machine deployments.
+	Monthly float64 `json:"monthly"`
+	// MinMonthly and MaxMonthly are the cost per month if the machine deployments are scaled down or up to
+	// the limits of their autoscaling.
+	MinMonthly         float64                         `json:"minMonthly"`
+	MaxMonthly         float64                         `json:"maxMonthly"`
+	MachineDeployments []MachineDeploymentCostEstimate `json:"machineDeployments"`
+	// Warnings tell why the estimate misses the cost of some nodes.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// MachineDeploymentCostEstimate is the estimated monthly cost of a machine deployment.
+// swagger:model MachineDeploymentCostEstimate
+type MachineDeploymentCostEstimate struct {
+	Name string `json:"name"`
+	// InstanceType is the instance type, flavor or size of the nodes, it is empty for providers whose
+	// nodes are priced by their CPUs and memory.
+	InstanceType string `json:"instanceType,omitempty"`
+	Replicas     int32  `json:"replicas"`
+	MinReplicas  int32  `json:"minReplicas"`
+	MaxReplicas  int32  `json:"maxReplicas"`
+	// Priced tells whether the catalog has a price for the nodes, the costs are 0 otherwise.
+	Priced bool `json:"priced"`
+	// NodeMonthly is the cost of a node per month.
+	NodeMonthly float64 `json:"nodeMonthly"`
+	Monthly     float64 `json:"monthly"`
+	MinMonthly  float64 `json:"minMonthly"`
+	MaxMonthly  float64 `json:"maxMonthly"`
+}
+
+// ChargebackSchema defines the chargeback attributes, like the cost center or the owner, which projects and
+// clusters carry as labels.
+// swagger:model ChargebackSchema
+type ChargebackSchema struct {
+	Attributes []ChargebackAttribute `json:"attributes"`
+}
+
+// ChargebackAttribute is a chargeback attribute, its value is the label with its key.
+// swagger:model ChargebackAttribute
+type ChargebackAttribute struct {
+	// Key is the label key of the attribute, e.g. cost-center.
+	Key         string `json:"key"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Scopes are the resources which have the attribute, project and cluster. Clusters inherit the value of
+	// their project unless they have their own.
+	Scopes []string `json:"scopes"`
+	// Required attributes must have a value in all their scopes.
+	Required bool `json:"required,omitempty"`
+	// AllowedValues restricts the values of the attribute, any value is allowed if it is empty.
+	AllowedValues []string `json:"allowedValues,omitempty"`
+	// Pattern is a regular expression which the values of the attribute must match.
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// ChargebackQuotaBreakdown holds the resource quotas of projects and their usage by chargeback attributes.
+// swagger:model ChargebackQuotaBreakdown
+type ChargebackQuotaBreakdown struct {
+	// Attributes are the keys of the attributes the quotas are broken down by.
+	Attributes []string               `json:"attributes"`
+	Groups     []ChargebackQuotaGroup `json:"groups"`
+}
+
+// ChargebackQuotaGroup holds the sum of the resource quotas of projects with the same chargeback attributes.
+// swagger:model ChargebackQuotaGroup
+type ChargebackQuotaGroup struct {
+	// Attributes are the values of the attributes, they are empty for projects without the attribute.
+	Attributes map[string]string `json:"attributes"`
+	// Projects are the IDs of the projects.
+	Projects    []string `json:"projects"`
+	Quota       Quota    `json:"quota"`
+	GlobalUsage Quota    `json:"globalUsage"`
+}
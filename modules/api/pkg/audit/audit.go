@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records the mutating API calls and writes them to pluggable sinks.
+//
+// The sinks hold the complete audit log. The logger also keeps the most recent entries in the memory of the API
+// replica for the admin audit endpoint, which is best-effort: each replica only knows the calls it served and
+// forgets them on restart.
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultRetainedEntries is the number of recent entries kept in memory for queries.
+const DefaultRetainedEntries = 1000
+
+// Entry is a single audit record of an API call.
+type Entry struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// User is the email of the authenticated user or service account.
+	User string `json:"user"`
+	// Groups are the groups of the user taken from the token.
+	Groups []string `json:"groups,omitempty"`
+	// ImpersonatedGroups are the groups the API impersonated when acting on behalf of the user in the project.
+	ImpersonatedGroups []string `json:"impersonatedGroups,omitempty"`
+	Admin              bool     `json:"admin,omitempty"`
+
+	ProjectID string `json:"projectID,omitempty"`
+	ClusterID string `json:"clusterID,omitempty"`
+
+	Method     string `json:"method"`
+	Route      string `json:"route"`
+	Path       string `json:"path"`
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	UserAgent  string `json:"userAgent,omitempty"`
+
+	// Changes are the objects created, updated or deleted by the call, in the order they were changed.
+	Changes []Change `json:"changes,omitempty"`
+
+	Outcome Outcome `json:"outcome"`
+	// Duration is the time the call took in milliseconds.
+	Duration int64 `json:"durationMs"`
+}
+
+// Outcome is the result of the API call.
+type Outcome struct {
+	// Code is the HTTP status code of failed calls, successful calls are recorded with 200.
+	Code  int    `json:"code"`
+	Error string `json:"error,omitempty"`
+}
+
+// Sink receives the audit entries.
+type Sink interface {
+	Write(ctx context.Context, entry *Entry) error
+}
+
+// QueryOptions filters the entries returned by Query. Empty fields match all entries.
+type QueryOptions struct {
+	User      string
+	ProjectID string
+	ClusterID string
+	Method    string
+	Since     time.Time
+	// FailedOnly returns only calls which did not succeed.
+	FailedOnly bool
+	// Limit is the maximum number of entries returned, 0 means all retained entries.
+	Limit int
+}
+
+func (o QueryOptions) matches(entry *Entry) bool {
+	switch {
+	case o.User != "" && o.User != entry.User:
+		return false
+	case o.ProjectID != "" && o.ProjectID != entry.ProjectID:
+		return false
+	case o.ClusterID != "" && o.ClusterID != entry.ClusterID:
+		return false
+	case o.Method != "" && o.Method != entry.Method:
+		return false
+	case !o.Since.IsZero() && entry.Timestamp.Before(o.Since):
+		return false
+	case o.FailedOnly && entry.Outcome.Code < 400:
+		return false
+	}
+	return true
+}
+
+// Logger redacts the entries, writes them to all sinks and keeps the most recent ones in memory.
+type Logger struct {
+	log    *zap.SugaredLogger
+	policy *RedactionPolicy
+	sinks  []Sink
+
+	lock    sync.RWMutex
+	entries []*Entry
+	next    int
+	full    bool
+}
+
+// NewLogger returns a new audit logger. retained is the number of entries kept for queries.
+func NewLogger(log *zap.SugaredLogger, policy *RedactionPolicy, retained int, sinks ...Sink) (*Logger, error) {
+	if retained <= 0 {
+		return nil, errors.New("the number of retained entries must be positive")
+	}
+	if policy == nil {
+		policy = DefaultRedactionPolicy()
+	}
+
+	return &Logger{
+		log:     log,
+		policy:  policy,
+		sinks:   sinks,
+		entries: make([]*Entry, retained),
+	}, nil
+}
+
+// Log records the entry. Errors of the sinks are logged and do not fail the API call.
+func (l *Logger) Log(ctx context.Context, entry *Entry) {
+	if entry.ID == "" {
+		entry.ID = newEntryID()
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+
+	l.lock.Lock()
+	l.entries[l.next] = entry
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+	l.lock.Unlock()
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(ctx, entry); err != nil {
+			l.log.Warnw("Failed to write audit entry", "id", entry.ID, zap.Error(err))
+		}
+	}
+}
+
+// Query returns the retained entries matching the options, newest first. Only the entries logged by this replica
+// since it started are known.
+func (l *Logger) Query(opts QueryOptions) []Entry {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	count := l.next
+	if l.full {
+		count = len(l.entries)
+	}
+
+	result := []Entry{}
+	for i := 1; i <= count; i++ {
+		entry := l.entries[(l.next-i+len(l.entries))%len(l.entries)]
+		if !opts.matches(entry) {
+			continue
+		}
+		result = append(result, *entry)
+		if opts.Limit > 0 && len(result) >= opts.Limit {
+			break
+		}
+	}
+	return result
+}
+
+func newEntryID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return time.Now().UTC().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
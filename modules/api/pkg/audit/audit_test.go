@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/audit"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRedact(t *testing.T) {
+	policy := audit.NewRedactionPolicy("sshPublicKey")
+
+	request := map[string]interface{}{
+		"project_id": "my-project",
+		"Body": map[string]interface{}{
+			"name": "preset",
+			"spec": map[string]interface{}{
+				"aws": map[string]interface{}{
+					"accessKeyID":     "AKIA",
+					"secretAccessKey": "secret",
+					"vpcID":           "vpc-1",
+				},
+				"openstack": map[string]interface{}{
+					"password":                      "pass",
+					"application_credential_secret": "secret",
+				},
+				"gcp":     map[string]interface{}{"serviceAccount": "e30="},
+				"hetzner": map[string]interface{}{"token": "abc"},
+			},
+			"keys": []interface{}{
+				map[string]interface{}{"sshPublicKey": "ssh-rsa"},
+			},
+		},
+		"serviceaccount_id": "sa-1",
+		"token_id":          "token-1",
+	}
+
+	raw, err := policy.Redact(request)
+	if err != nil {
+		t.Fatalf("failed to redact: %v", err)
+	}
+
+	redacted := string(raw)
+	for _, secret := range []string{"AKIA", "\"secret\"", "\"pass\"", "e30=", "\"abc\"", "ssh-rsa"} {
+		if strings.Contains(redacted, secret) {
+			t.Errorf("expected %s to be redacted in %s", secret, redacted)
+		}
+	}
+	for _, visible := range []string{"my-project", "vpc-1", "sa-1", "token-1"} {
+		if !strings.Contains(redacted, visible) {
+			t.Errorf("expected %s to be kept in %s", visible, redacted)
+		}
+	}
+}
+
+func TestLoggerQuery(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := audit.NewLogger(zap.NewNop().Sugar(), nil, 3, audit.NewWriterSink(&buf))
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+
+	now := time.Now().UTC()
+	for i, user := range []string{"bob@acme.com", "john@acme.com", "bob@acme.com", "bob@acme.com"} {
+		logger.Log(context.Background(), &audit.Entry{
+			Timestamp: now.Add(time.Duration(i) * time.Minute),
+			User:      user,
+			ProjectID: "my-project",
+			Method:    http.MethodDelete,
+			Outcome:   audit.Outcome{Code: http.StatusOK + i*100},
+		})
+	}
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 4 {
+		t.Fatalf("expected 4 lines written to the sink, got %d", lines)
+	}
+
+	// the first entry is no longer retained
+	entries := logger.Query(audit.QueryOptions{User: "bob@acme.com"})
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if !entries[0].Timestamp.After(entries[1].Timestamp) {
+		t.Fatal("expected the newest entry first")
+	}
+
+	if entries := logger.Query(audit.QueryOptions{FailedOnly: true}); len(entries) != 2 {
+		t.Fatalf("expected 2 failed entries, got %d", len(entries))
+	}
+	if entries := logger.Query(audit.QueryOptions{Limit: 1}); len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	if entries := logger.Query(audit.QueryOptions{Since: now.Add(150 * time.Second)}); len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+}
+
+func TestRecordingClient(t *testing.T) {
+	logger, err := audit.NewLogger(zap.NewNop().Sugar(), nil, 1)
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+	client := audit.NewRecordingClient(fake.NewClientBuilder().Build())
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "kubermatic"},
+		Data:       map[string]string{"replicas": "1", "apiToken": "abc"},
+	}
+	// changes outside of an audited call are not recorded
+	if err := client.Create(context.Background(), configMap); err != nil {
+		t.Fatalf("failed to create config map: %v", err)
+	}
+
+	ctx, changes := logger.RecordChanges(context.Background())
+
+	configMap.Data["replicas"] = "3"
+	if err := client.Update(ctx, configMap); err != nil {
+		t.Fatalf("failed to update config map: %v", err)
+	}
+	if err := client.Update(ctx, configMap, ctrlruntimeclient.DryRunAll); err != nil {
+		t.Fatalf("failed to update config map: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "credentials",
+			Namespace: "kubermatic",
+			Annotations: map[string]string{
+				corev1.LastAppliedConfigAnnotation: `{"data":{"region":"ZXUtd2VzdC0x"}}`,
+			},
+		},
+		Data: map[string][]byte{"region": []byte("eu-west-1")},
+	}
+	if err := client.Create(ctx, secret); err != nil {
+		t.Fatalf("failed to create secret: %v", err)
+	}
+	if err := client.Delete(ctx, configMap); err != nil {
+		t.Fatalf("failed to delete config map: %v", err)
+	}
+
+	recorded := changes()
+	if len(recorded) != 3 {
+		t.Fatalf("expected 3 changes, got %d: %+v", len(recorded), recorded)
+	}
+
+	update := recorded[0]
+	if update.Kind != "ConfigMap" || update.Namespace != "kubermatic" || update.Name != "settings" {
+		t.Fatalf("unexpected object %s %s/%s", update.Kind, update.Namespace, update.Name)
+	}
+	if !strings.Contains(string(update.Before), `"replicas":"1"`) || !strings.Contains(string(update.After), `"replicas":"3"`) {
+		t.Errorf("expected the states before and after the update, got %s and %s", update.Before, update.After)
+	}
+	if strings.Contains(string(update.After), "abc") {
+		t.Errorf("expected the token to be redacted in %s", update.After)
+	}
+
+	if create := recorded[1]; create.Before != nil || create.After == nil || strings.Contains(string(create.After), "ZXUtd2VzdC0x") {
+		t.Errorf("expected only the state after the creation with the secret values redacted, got %s and %s", create.Before, create.After)
+	}
+	if !strings.Contains(string(recorded[1].After), `"kubectl.kubernetes.io/last-applied-configuration":"[REDACTED]"`) {
+		t.Errorf("expected the last applied configuration of the secret to be redacted in %s", recorded[1].After)
+	}
+	if deletion := recorded[2]; deletion.Before == nil || deletion.After != nil {
+		t.Errorf("expected only the state before the deletion, got %s and %s", deletion.Before, deletion.After)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan audit.Entry, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var entry audit.Entry
+		if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+			t.Errorf("failed to decode entry: %v", err)
+		}
+		received <- entry
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sink := audit.NewWebhookSink(ctx, zap.NewNop().Sugar(), server.URL, audit.DefaultWebhookTimeout, 1)
+	if err := sink.Write(ctx, &audit.Entry{ID: "entry-1", User: "bob@acme.com"}); err != nil {
+		t.Fatalf("failed to write entry: %v", err)
+	}
+
+	select {
+	case entry := <-received:
+		if entry.ID != "entry-1" {
+			t.Fatalf("expected entry-1, got %s", entry.ID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the webhook")
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Change is an object created, updated or deleted by an API call. The states are recorded with the credentials
+// redacted and without the managed fields.
+type Change struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Before is the object before the call, empty if it was created.
+	Before json.RawMessage `json:"before,omitempty"`
+	// After is the object after the call, empty if it was deleted.
+	After json.RawMessage `json:"after,omitempty"`
+}
+
+type changesContextKey struct{}
+
+type changeRecorder struct {
+	policy *RedactionPolicy
+
+	lock    sync.Mutex
+	changes []Change
+}
+
+// RecordChanges returns a context in which the changes made by the clients returned by NewRecordingClient are
+// recorded, and a function returning the recorded changes.
+func (l *Logger) RecordChanges(ctx context.Context) (context.Context, func() []Change) {
+	recorder := &changeRecorder{policy: l.policy}
+
+	return context.WithValue(ctx, changesContextKey{}, recorder), func() []Change {
+		recorder.lock.Lock()
+		defer recorder.lock.Unlock()
+
+		return recorder.changes
+	}
+}
+
+func (r *changeRecorder) record(kind string, key ctrlruntimeclient.ObjectKey, before, after ctrlruntimeclient.Object) {
+	change := Change{Kind: kind, Namespace: key.Namespace, Name: key.Name}
+	// objects which cannot be serialized are recorded without their state
+	if before != nil {
+		change.Before, _ = r.policy.redactObject(kind, before)
+	}
+	if after != nil {
+		change.After, _ = r.policy.redactObject(kind, after)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.changes = append(r.changes, change)
+}
+
+// recordingClient records the objects it changes if the context was returned by Logger.RecordChanges.
+type recordingClient struct {
+	ctrlruntimeclient.Client
+}
+
+// NewRecordingClient returns a client which records the objects it creates, updates and deletes in the contexts
+// returned by Logger.RecordChanges. The object is fetched before it is updated or deleted, calls with other
+// contexts are passed through.
+func NewRecordingClient(client ctrlruntimeclient.Client) ctrlruntimeclient.Client {
+	return &recordingClient{Client: client}
+}
+
+func (c *recordingClient) Create(ctx context.Context, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.CreateOption) error {
+	recorder, _ := ctx.Value(changesContextKey{}).(*changeRecorder)
+	if recorder == nil || len((&ctrlruntimeclient.CreateOptions{}).ApplyOptions(opts).DryRun) > 0 {
+		return c.Client.Create(ctx, obj, opts...)
+	}
+
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	recorder.record(c.kind(obj), ctrlruntimeclient.ObjectKeyFromObject(obj), nil, obj)
+	return nil
+}
+
+func (c *recordingClient) Update(ctx context.Context, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.UpdateOption) error {
+	recorder, _ := ctx.Value(changesContextKey{}).(*changeRecorder)
+	if recorder == nil || len((&ctrlruntimeclient.UpdateOptions{}).ApplyOptions(opts).DryRun) > 0 {
+		return c.Client.Update(ctx, obj, opts...)
+	}
+
+	before := c.current(ctx, obj)
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	recorder.record(c.kind(obj), ctrlruntimeclient.ObjectKeyFromObject(obj), before, obj)
+	return nil
+}
+
+func (c *recordingClient) Patch(ctx context.Context, obj ctrlruntimeclient.Object, patch ctrlruntimeclient.Patch, opts ...ctrlruntimeclient.PatchOption) error {
+	recorder, _ := ctx.Value(changesContextKey{}).(*changeRecorder)
+	if recorder == nil || len((&ctrlruntimeclient.PatchOptions{}).ApplyOptions(opts).DryRun) > 0 {
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+
+	before := c.current(ctx, obj)
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	recorder.record(c.kind(obj), ctrlruntimeclient.ObjectKeyFromObject(obj), before, obj)
+	return nil
+}
+
+func (c *recordingClient) Delete(ctx context.Context, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.DeleteOption) error {
+	recorder, _ := ctx.Value(changesContextKey{}).(*changeRecorder)
+	if recorder == nil || len((&ctrlruntimeclient.DeleteOptions{}).ApplyOptions(opts).DryRun) > 0 {
+		return c.Client.Delete(ctx, obj, opts...)
+	}
+
+	before := c.current(ctx, obj)
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+	recorder.record(c.kind(obj), ctrlruntimeclient.ObjectKeyFromObject(obj), before, nil)
+	return nil
+}
+
+func (c *recordingClient) kind(obj ctrlruntimeclient.Object) string {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return obj.GetObjectKind().GroupVersionKind().Kind
+	}
+	return gvk.Kind
+}
+
+// current returns the stored state of the object, nil if it cannot be fetched.
+func (c *recordingClient) current(ctx context.Context, obj ctrlruntimeclient.Object) ctrlruntimeclient.Object {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return nil
+	}
+
+	var current ctrlruntimeclient.Object
+	if _, ok := obj.(*unstructured.Unstructured); ok {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		current = u
+	} else {
+		newObj, err := c.Scheme().New(gvk)
+		if err != nil {
+			return nil
+		}
+		typed, ok := newObj.(ctrlruntimeclient.Object)
+		if !ok {
+			return nil
+		}
+		current = typed
+	}
+
+	if err := c.Client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), current); err != nil {
+		return nil
+	}
+	return current
+}
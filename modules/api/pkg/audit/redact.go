@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Redacted replaces the values of sensitive fields.
+const Redacted = "[REDACTED]"
+
+// defaultRedactedFields covers the credentials of the cloud specs and presets, e.g. secretAccessKey,
+// clientSecret, applicationCredentialSecret, password, token, apiKey, kubeconfig or the GCP serviceAccount.
+var defaultRedactedFields = []string{
+	"password",
+	"secret",
+	"token",
+	"apikey",
+	"accesskey",
+	"accesskeyid",
+	"privatekey",
+	"kubeconfig",
+	"serviceaccount",
+}
+
+// RedactionPolicy decides which fields of a request are replaced before it is recorded.
+type RedactionPolicy struct {
+	fields []string
+}
+
+// DefaultRedactionPolicy returns a policy which redacts the credentials of the cloud specs and presets.
+func DefaultRedactionPolicy() *RedactionPolicy {
+	return NewRedactionPolicy()
+}
+
+// NewRedactionPolicy returns the default policy extended by the given fields. Fields are matched against the
+// JSON keys ignoring case, '_' and '-', a key is redacted if it ends with one of the fields. Matching the suffix
+// keeps references like tokenID or serviceaccount_id readable.
+func NewRedactionPolicy(additionalFields ...string) *RedactionPolicy {
+	p := &RedactionPolicy{}
+	for _, field := range slices.Concat(defaultRedactedFields, additionalFields) {
+		if field = normalizeField(field); field != "" {
+			p.fields = append(p.fields, field)
+		}
+	}
+	return p
+}
+
+// Redact returns the JSON representation of the value with all sensitive fields replaced.
+func (p *RedactionPolicy) Redact(value interface{}) (json.RawMessage, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var object interface{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(p.redact(object))
+}
+
+// redactObject returns the JSON representation of the Kubernetes object without the managed fields and with all
+// sensitive fields replaced. All values of secrets are replaced, as their keys are arbitrary, and so is the last
+// applied configuration of secrets.
+func (p *RedactionPolicy) redactObject(kind string, obj interface{}) (json.RawMessage, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, err
+	}
+
+	metadata, _ := object["metadata"].(map[string]interface{})
+	delete(metadata, "managedFields")
+	if kind == "Secret" {
+		// the annotation written by kubectl apply holds the whole secret
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; ok {
+				annotations[corev1.LastAppliedConfigAnnotation] = Redacted
+			}
+		}
+		for _, field := range []string{"data", "stringData"} {
+			if data, ok := object[field].(map[string]interface{}); ok {
+				for key := range data {
+					data[key] = Redacted
+				}
+			}
+		}
+	}
+
+	return json.Marshal(p.redact(object))
+}
+
+func (p *RedactionPolicy) redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if field != nil && p.isSensitive(key) {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = p.redact(field)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = p.redact(v[i])
+		}
+	}
+	return value
+}
+
+func (p *RedactionPolicy) isSensitive(key string) bool {
+	key = normalizeField(key)
+	for _, field := range p.fields {
+		if strings.HasSuffix(key, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeField(field string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(field)))
+}
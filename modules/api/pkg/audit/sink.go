@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/tracing"
+)
+
+const (
+	// DefaultWebhookTimeout is the timeout for delivering a single entry to the webhook.
+	DefaultWebhookTimeout = 5 * time.Second
+	// DefaultWebhookQueueSize is the number of entries buffered for the webhook. Entries are dropped when the queue is full,
+	// so a slow webhook does not slow down the API.
+	DefaultWebhookQueueSize = 1000
+)
+
+// ErrQueueFull is returned when the entry could not be queued for delivery.
+var ErrQueueFull = errors.New("audit webhook queue is full")
+
+// WriterSink writes the entries as JSON lines.
+type WriterSink struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+var _ Sink = &WriterSink{}
+
+// NewWriterSink returns a sink writing to the given writer, e.g. os.Stdout.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// NewFileSink returns a sink appending to the given file.
+func NewFileSink(path string) (*WriterSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return NewWriterSink(f), nil
+}
+
+func (s *WriterSink) Write(_ context.Context, entry *Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// WebhookSink sends every entry as JSON in a POST request to the configured URL. The entries are delivered
+// asynchronously in the order they were recorded.
+type WebhookSink struct {
+	log    *zap.SugaredLogger
+	url    string
+	client *http.Client
+	queue  chan *Entry
+}
+
+var _ Sink = &WebhookSink{}
+
+// NewWebhookSink returns a sink delivering the entries to the webhook until the context is done.
+func NewWebhookSink(ctx context.Context, log *zap.SugaredLogger, url string, timeout time.Duration, queueSize int) *WebhookSink {
+	s := &WebhookSink{
+		log:    log,
+		url:    url,
+		client: &http.Client{Timeout: timeout, Transport: tracing.NewTransport()},
+		queue:  make(chan *Entry, queueSize),
+	}
+
+	go s.run(ctx)
+
+	return s
+}
+
+func (s *WebhookSink) Write(_ context.Context, entry *Entry) error {
+	select {
+	case s.queue <- entry:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (s *WebhookSink) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-s.queue:
+			if err := s.send(ctx, entry); err != nil {
+				s.log.Warnw("Failed to send audit entry to webhook", "id", entry.ID, zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *WebhookSink) send(ctx context.Context, entry *Entry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chargeback validates the chargeback attributes of projects and clusters, like their cost center or
+// owner, against the schema defined by the admins. The attributes are labels, so that they show up in the
+// metering reports and can be selected like any other label.
+package chargeback
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	ScopeProject = "project"
+	ScopeCluster = "cluster"
+)
+
+// ValidateSchema validates the attributes of the schema and defaults their scopes to projects and clusters.
+func ValidateSchema(schema *apiv2.ChargebackSchema) error {
+	keys := sets.New[string]()
+	for i := range schema.Attributes {
+		attribute := &schema.Attributes[i]
+		if errs := validation.IsQualifiedName(attribute.Key); len(errs) > 0 {
+			return fmt.Errorf("invalid attribute key %q: %s", attribute.Key, strings.Join(errs, ", "))
+		}
+		if keys.Has(attribute.Key) {
+			return fmt.Errorf("duplicate attribute %q", attribute.Key)
+		}
+		keys.Insert(attribute.Key)
+
+		if len(attribute.Scopes) == 0 {
+			attribute.Scopes = []string{ScopeProject, ScopeCluster}
+		}
+		for _, scope := range attribute.Scopes {
+			if scope != ScopeProject && scope != ScopeCluster {
+				return fmt.Errorf("invalid scope %q of attribute %q, expected %s or %s", scope, attribute.Key, ScopeProject, ScopeCluster)
+			}
+		}
+
+		var pattern *regexp.Regexp
+		if attribute.Pattern != "" {
+			var err error
+			if pattern, err = regexp.Compile(attribute.Pattern); err != nil {
+				return fmt.Errorf("invalid pattern of attribute %q: %w", attribute.Key, err)
+			}
+		}
+		for _, value := range attribute.AllowedValues {
+			if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+				return fmt.Errorf("invalid value %q of attribute %q: %s", value, attribute.Key, strings.Join(errs, ", "))
+			}
+			if pattern != nil && !pattern.MatchString(value) {
+				return fmt.Errorf("the value %q of attribute %q does not match its pattern", value, attribute.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateLabels validates the labels of a resource in the scope against the schema. The inherited labels are
+// the labels of the project of a cluster, they provide the values which the cluster does not set itself.
+func ValidateLabels(schema *apiv2.ChargebackSchema, scope string, labels, inherited map[string]string) error {
+	if schema == nil {
+		return nil
+	}
+
+	var violations []string
+	for _, attribute := range schema.Attributes {
+		if !sets.New(attribute.Scopes...).Has(scope) {
+			continue
+		}
+
+		value, ok := labels[attribute.Key]
+		if !ok {
+			if _, inheritedOK := inherited[attribute.Key]; attribute.Required && !inheritedOK {
+				violations = append(violations, fmt.Sprintf("the %s attribute %s is required", scope, attributeName(attribute)))
+			}
+			continue
+		}
+		if err := validateValue(attribute, value); err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("invalid chargeback attributes: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+func validateValue(attribute apiv2.ChargebackAttribute, value string) error {
+	if attribute.Required && value == "" {
+		return fmt.Errorf("the attribute %s cannot be empty", attributeName(attribute))
+	}
+	if len(attribute.AllowedValues) > 0 && !sets.New(attribute.AllowedValues...).Has(value) {
+		return fmt.Errorf("the value %q of the attribute %s is not one of %s", value, attributeName(attribute), strings.Join(attribute.AllowedValues, ", "))
+	}
+	if attribute.Pattern != "" {
+		// the pattern was validated with the schema
+		if matched, _ := regexp.MatchString(attribute.Pattern, value); !matched {
+			return fmt.Errorf("the value %q of the attribute %s does not match %s", value, attributeName(attribute), attribute.Pattern)
+		}
+	}
+	return nil
+}
+
+func attributeName(attribute apiv2.ChargebackAttribute) string {
+	if attribute.DisplayName != "" {
+		return fmt.Sprintf("%s (%s)", attribute.DisplayName, attribute.Key)
+	}
+	return attribute.Key
+}
+
+// Keys returns the keys of the attributes of the schema.
+func Keys(schema *apiv2.ChargebackSchema) []string {
+	keys := make([]string, 0, len(schema.Attributes))
+	for _, attribute := range schema.Attributes {
+		keys = append(keys, attribute.Key)
+	}
+	return keys
+}
+
+// Values returns the values of the attributes with the keys. The labels are the labels of a resource and its
+// parents, the first one with the attribute provides its value.
+func Values(keys []string, labels ...map[string]string) map[string]string {
+	values := make(map[string]string, len(keys))
+	for _, key := range keys {
+		values[key] = ""
+		for _, l := range labels {
+			if value, ok := l[key]; ok {
+				values[key] = value
+				break
+			}
+		}
+	}
+	return values
+}
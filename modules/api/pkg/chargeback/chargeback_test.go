@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chargeback
+
+import (
+	"reflect"
+	"testing"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+)
+
+func testSchema() *apiv2.ChargebackSchema {
+	return &apiv2.ChargebackSchema{
+		Attributes: []apiv2.ChargebackAttribute{
+			{
+				Key:           "cost-center",
+				DisplayName:   "Cost Center",
+				Required:      true,
+				AllowedValues: []string{"cc-100", "cc-200"},
+			},
+			{
+				Key:      "owner",
+				Scopes:   []string{ScopeProject},
+				Required: true,
+				Pattern:  "^[a-z]+$",
+			},
+		},
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name       string
+		attributes []apiv2.ChargebackAttribute
+		valid      bool
+	}{
+		{
+			name:       "valid schema",
+			attributes: testSchema().Attributes,
+			valid:      true,
+		},
+		{
+			name:       "invalid key",
+			attributes: []apiv2.ChargebackAttribute{{Key: "cost center"}},
+		},
+		{
+			name:       "duplicate key",
+			attributes: []apiv2.ChargebackAttribute{{Key: "owner"}, {Key: "owner"}},
+		},
+		{
+			name:       "invalid scope",
+			attributes: []apiv2.ChargebackAttribute{{Key: "owner", Scopes: []string{"namespace"}}},
+		},
+		{
+			name:       "invalid pattern",
+			attributes: []apiv2.ChargebackAttribute{{Key: "owner", Pattern: "[a-z"}},
+		},
+		{
+			name:       "allowed value not matching the pattern",
+			attributes: []apiv2.ChargebackAttribute{{Key: "owner", Pattern: "^[a-z]+$", AllowedValues: []string{"Alice"}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSchema(&apiv2.ChargebackSchema{Attributes: tc.attributes})
+			if tc.valid && err != nil {
+				t.Errorf("expected the schema to be valid, got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected the schema to be invalid")
+			}
+		})
+	}
+}
+
+func TestValidateSchemaDefaultsScopes(t *testing.T) {
+	schema := testSchema()
+	if err := ValidateSchema(schema); err != nil {
+		t.Fatalf("failed to validate the schema: %v", err)
+	}
+
+	expected := []string{ScopeProject, ScopeCluster}
+	if !reflect.DeepEqual(schema.Attributes[0].Scopes, expected) {
+		t.Errorf("expected the scopes %v, got %v", expected, schema.Attributes[0].Scopes)
+	}
+}
+
+func TestValidateLabels(t *testing.T) {
+	schema := testSchema()
+	if err := ValidateSchema(schema); err != nil {
+		t.Fatalf("failed to validate the schema: %v", err)
+	}
+
+	testCases := []struct {
+		name      string
+		scope     string
+		labels    map[string]string
+		inherited map[string]string
+		valid     bool
+	}{
+		{
+			name:   "project with all attributes",
+			scope:  ScopeProject,
+			labels: map[string]string{"cost-center": "cc-100", "owner": "alice"},
+			valid:  true,
+		},
+		{
+			name:   "project without required attribute",
+			scope:  ScopeProject,
+			labels: map[string]string{"cost-center": "cc-100"},
+		},
+		{
+			name:   "value not allowed",
+			scope:  ScopeProject,
+			labels: map[string]string{"cost-center": "cc-300", "owner": "alice"},
+		},
+		{
+			name:   "value not matching the pattern",
+			scope:  ScopeProject,
+			labels: map[string]string{"cost-center": "cc-100", "owner": "Alice"},
+		},
+		{
+			name:   "empty required value",
+			scope:  ScopeProject,
+			labels: map[string]string{"cost-center": "", "owner": "alice"},
+		},
+		{
+			name:   "cluster ignores project attributes",
+			scope:  ScopeCluster,
+			labels: map[string]string{"cost-center": "cc-200"},
+			valid:  true,
+		},
+		{
+			name:      "cluster inherits attribute of project",
+			scope:     ScopeCluster,
+			labels:    map[string]string{},
+			inherited: map[string]string{"cost-center": "cc-100"},
+			valid:     true,
+		},
+		{
+			name:      "cluster overrides attribute of project with invalid value",
+			scope:     ScopeCluster,
+			labels:    map[string]string{"cost-center": "cc-300"},
+			inherited: map[string]string{"cost-center": "cc-100"},
+		},
+		{
+			name:   "cluster without required attribute",
+			scope:  ScopeCluster,
+			labels: map[string]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateLabels(schema, tc.scope, tc.labels, tc.inherited)
+			if tc.valid && err != nil {
+				t.Errorf("expected the labels to be valid, got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected the labels to be invalid")
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	cluster := map[string]string{"owner": "bob"}
+	project := map[string]string{"owner": "alice", "cost-center": "cc-100"}
+
+	values := Values([]string{"cost-center", "owner", "team"}, cluster, project)
+	expected := map[string]string{"cost-center": "cc-100", "owner": "bob", "team": ""}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+}
@@ -0,0 +1,530 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credentials resolves references to credentials kept in external stores, so that presets do not have
+// to contain the credentials themselves. A reference replaces the value of a field and has the form
+//
+//	ref+<source>://<path>#<key>
+//
+// e.g. "ref+vault://aws/creds/kkp#access_key" for a dynamic AWS secret of HashiCorp Vault,
+// "ref+file://azure/credentials.json#clientSecret" for a file mounted by a CSI driver or
+// "ref+secret://cloud-credentials/gcp#serviceAccount" for a Kubernetes secret in another namespace. The key can be
+// omitted if the secret contains a single value.
+//
+// Clusters created from a preset keep its references, also in their credential secrets. The references are
+// resolved where the credentials are used, the API resolves them with the resolver of the request context.
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultCacheTTL is the time for which resolved secrets are cached.
+	DefaultCacheTTL = time.Minute
+	// DefaultCacheSize is the maximum number of cached secrets.
+	DefaultCacheSize = 256
+
+	referencePrefix = "ref+"
+)
+
+// Secret is a secret of an external store.
+type Secret struct {
+	// Data are the values of the secret by their keys.
+	Data map[string]string
+	// TTL is the time the secret is valid for, e.g. the lease of a dynamic secret. Zero means that the secret does
+	// not expire.
+	TTL time.Duration
+	// LeaseID identifies the lease of a dynamic secret, which is renewed and revoked through the LeaseManager of
+	// its source.
+	LeaseID string
+	// Renewable is true if the lease can be renewed.
+	Renewable bool
+}
+
+// CredentialSource reads secrets from an external store.
+type CredentialSource interface {
+	// Resolve returns the secret at the path.
+	Resolve(ctx context.Context, path string) (*Secret, error)
+}
+
+// LeaseManager is implemented by the sources of dynamic secrets. The leases of the secrets are renewed while they
+// are used and revoked once they are evicted from the cache, so that every lease is owned by exactly one entry.
+type LeaseManager interface {
+	// Renew renews the lease and returns its new duration.
+	Renew(ctx context.Context, leaseID string) (time.Duration, error)
+	// Revoke revokes the lease, the credentials of the secret are invalid afterwards.
+	Revoke(ctx context.Context, leaseID string) error
+}
+
+// Reference is a parsed reference to a value of a secret.
+type Reference struct {
+	Source string
+	Path   string
+	Key    string
+}
+
+func (r Reference) String() string {
+	if r.Key == "" {
+		return fmt.Sprintf("%s%s://%s", referencePrefix, r.Source, r.Path)
+	}
+	return fmt.Sprintf("%s%s://%s#%s", referencePrefix, r.Source, r.Path, r.Key)
+}
+
+// IsReference returns true if the value is a reference to a secret.
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, referencePrefix)
+}
+
+// ParseReference parses a reference to a value of a secret.
+func ParseReference(value string) (Reference, error) {
+	if !IsReference(value) {
+		return Reference{}, errors.New("the value is not a reference")
+	}
+
+	source, location, found := strings.Cut(strings.TrimPrefix(value, referencePrefix), "://")
+	if !found || source == "" {
+		return Reference{}, fmt.Errorf("the reference %q has no source, expected %s<source>://<path>#<key>", value, referencePrefix)
+	}
+	path, key, _ := strings.Cut(location, "#")
+	if path == "" {
+		return Reference{}, fmt.Errorf("the reference %q has no path", value)
+	}
+	return Reference{Source: source, Path: path, Key: key}, nil
+}
+
+// Config configures the sources of the credentials. Sources without configuration are disabled.
+type Config struct {
+	// Vault configures the source "vault".
+	Vault VaultConfig
+	// FileRoot is the directory of the source "file", e.g. the mount point of a CSI volume.
+	FileRoot string
+	// SecretNamespaces are the namespaces the source "secret" can read secrets from.
+	SecretNamespaces []string
+	// CacheTTL is the time for which resolved secrets are cached, DefaultCacheTTL if zero. Dynamic secrets are
+	// cached as long as they are used within the TTL and their lease can be renewed.
+	CacheTTL time.Duration
+	// CacheSize is the maximum number of cached secrets, DefaultCacheSize if zero.
+	CacheSize int
+}
+
+// New returns a resolver for the configured sources, or nil if there are none. The reader is used to read the
+// secrets of the source "secret".
+func New(config Config, reader ctrlruntimeclient.Reader) (*Resolver, error) {
+	sources := map[string]CredentialSource{}
+	if config.Vault.Address != "" {
+		vault, err := NewVaultSource(config.Vault)
+		if err != nil {
+			return nil, err
+		}
+		sources["vault"] = vault
+	}
+	if config.FileRoot != "" {
+		sources["file"] = NewFileSource(config.FileRoot)
+	}
+	if len(config.SecretNamespaces) > 0 {
+		sources["secret"] = NewSecretSource(reader, config.SecretNamespaces)
+	}
+
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	ttl := config.CacheTTL
+	if ttl == 0 {
+		ttl = DefaultCacheTTL
+	}
+	resolver := NewResolver(sources, ttl)
+	if config.CacheSize > 0 {
+		resolver.size = config.CacheSize
+	}
+	return resolver, nil
+}
+
+type cacheEntry struct {
+	source  string
+	secret  *Secret
+	expires time.Time
+	used    time.Time
+}
+
+// Resolver resolves references with its sources and caches the secrets for a short time, so that they are read
+// at the time they are used without querying the stores for every request. The cache is bounded, the least
+// recently used secrets are evicted first.
+type Resolver struct {
+	sources map[string]CredentialSource
+	ttl     time.Duration
+	size    int
+	now     func() time.Time
+
+	lock  sync.Mutex
+	cache map[string]*cacheEntry
+}
+
+// NewResolver returns a resolver for the sources by their names, which caches the secrets for at most the TTL.
+func NewResolver(sources map[string]CredentialSource, ttl time.Duration) *Resolver {
+	return &Resolver{
+		sources: sources,
+		ttl:     ttl,
+		size:    DefaultCacheSize,
+		now:     time.Now,
+		cache:   map[string]*cacheEntry{},
+	}
+}
+
+// Start renews the leases of the cached dynamic secrets and evicts the expired and unused secrets until the
+// context is done. The leases of the cache are revoked when the context is done.
+func (r *Resolver) Start(ctx context.Context, log *zap.SugaredLogger) {
+	go func() {
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			r.maintain(ctx, log)
+		}, r.ttl/2)
+
+		r.lock.Lock()
+		evicted := make([]*cacheEntry, 0, len(r.cache))
+		for key, entry := range r.cache {
+			evicted = append(evicted, entry)
+			delete(r.cache, key)
+		}
+		r.lock.Unlock()
+
+		revokeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		r.revoke(revokeCtx, log, evicted)
+	}()
+}
+
+// maintain evicts the expired secrets and the secrets which were not used within the TTL, and renews the leases
+// of the secrets which are still used.
+func (r *Resolver) maintain(ctx context.Context, log *zap.SugaredLogger) {
+	now := r.now()
+
+	var evicted, renewed []*cacheEntry
+	r.lock.Lock()
+	for key, entry := range r.cache {
+		switch {
+		case !now.Before(entry.expires) || now.Sub(entry.used) > r.ttl:
+			evicted = append(evicted, entry)
+			delete(r.cache, key)
+		case entry.secret.LeaseID != "" && entry.secret.Renewable:
+			renewed = append(renewed, entry)
+		}
+	}
+	r.lock.Unlock()
+
+	r.revoke(ctx, log, evicted)
+
+	for _, entry := range renewed {
+		manager, ok := r.sources[entry.source].(LeaseManager)
+		if !ok {
+			continue
+		}
+		duration, err := manager.Renew(ctx, entry.secret.LeaseID)
+		if err != nil {
+			// the secret is used until its lease expires, a new secret is read afterwards
+			log.Warnw("Failed to renew the lease of a credential", "source", entry.source, zap.Error(err))
+			continue
+		}
+		r.lock.Lock()
+		entry.expires = leaseExpiry(r.now(), duration)
+		r.lock.Unlock()
+	}
+}
+
+func (r *Resolver) revoke(ctx context.Context, log *zap.SugaredLogger, entries []*cacheEntry) {
+	for _, entry := range entries {
+		if err := r.revokeLease(ctx, entry); err != nil {
+			log.Warnw("Failed to revoke the lease of a credential", "source", entry.source, zap.Error(err))
+		}
+	}
+}
+
+func (r *Resolver) revokeLease(ctx context.Context, entry *cacheEntry) error {
+	if entry.secret.LeaseID == "" {
+		return nil
+	}
+	manager, ok := r.sources[entry.source].(LeaseManager)
+	if !ok {
+		return nil
+	}
+	return manager.Revoke(ctx, entry.secret.LeaseID)
+}
+
+// leaseExpiry returns the time after which a secret with a lease is read again. It is a bit before the lease
+// expires, so that the credentials are not used until the last second.
+func leaseExpiry(now time.Time, duration time.Duration) time.Time {
+	return now.Add(duration * 9 / 10)
+}
+
+// Resolve returns the value the reference points to. Values which are not references are returned as they are.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	return r.resolve(ctx, value, map[Reference]*Secret{})
+}
+
+// ResolveFields replaces the references in the string fields of the struct the object points to, including its
+// nested structs. All values of the same secret are taken from one read of the secret, so that e.g. the access
+// key and the secret key of a dynamic secret belong together.
+func (r *Resolver) ResolveFields(ctx context.Context, obj interface{}) error {
+	value := reflect.ValueOf(obj)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return errors.New("expected a pointer to a struct")
+	}
+	return r.resolveValue(ctx, value.Elem(), map[Reference]*Secret{})
+}
+
+func (r *Resolver) resolveValue(ctx context.Context, value reflect.Value, secrets map[Reference]*Secret) error {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			return r.resolveValue(ctx, value.Elem(), secrets)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if !value.Type().Field(i).IsExported() {
+				continue
+			}
+			if err := r.resolveValue(ctx, value.Field(i), secrets); err != nil {
+				return fmt.Errorf("%s: %w", value.Type().Field(i).Name, err)
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			if err := r.resolveValue(ctx, value.Index(i), secrets); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		if !IsReference(value.String()) || !value.CanSet() {
+			return nil
+		}
+		resolved, err := r.resolve(ctx, value.String(), secrets)
+		if err != nil {
+			return err
+		}
+		value.SetString(resolved)
+	}
+	return nil
+}
+
+func (r *Resolver) resolve(ctx context.Context, value string, secrets map[Reference]*Secret) (string, error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+
+	reference, err := ParseReference(value)
+	if err != nil {
+		return "", err
+	}
+	if r == nil {
+		return "", fmt.Errorf("no credential store is configured to resolve %s", reference)
+	}
+	secretReference := Reference{Source: reference.Source, Path: reference.Path}
+
+	secret, ok := secrets[secretReference]
+	if !ok {
+		if secret, err = r.secret(ctx, secretReference); err != nil {
+			return "", err
+		}
+		secrets[secretReference] = secret
+	}
+
+	if reference.Key == "" {
+		if len(secret.Data) != 1 {
+			return "", fmt.Errorf("the secret %s has %d values, the reference requires a key", secretReference, len(secret.Data))
+		}
+		for _, data := range secret.Data {
+			return data, nil
+		}
+	}
+	data, ok := secret.Data[reference.Key]
+	if !ok {
+		keys := make([]string, 0, len(secret.Data))
+		for key := range secret.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return "", fmt.Errorf("the secret %s has no key %q, expected one of %s", secretReference, reference.Key, strings.Join(keys, ", "))
+	}
+	return data, nil
+}
+
+func (r *Resolver) secret(ctx context.Context, reference Reference) (*Secret, error) {
+	key := reference.String()
+
+	r.lock.Lock()
+	stale, ok := r.cache[key]
+	if ok && r.now().Before(stale.expires) {
+		stale.used = r.now()
+		r.lock.Unlock()
+		return stale.secret, nil
+	}
+	r.lock.Unlock()
+
+	source, ok := r.sources[reference.Source]
+	if !ok {
+		return nil, fmt.Errorf("the source %q of the reference %s is not configured", reference.Source, reference)
+	}
+	secret, err := source.Resolve(ctx, reference.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", reference, err)
+	}
+
+	now := r.now()
+	added := &cacheEntry{source: reference.Source, secret: secret, used: now}
+	switch {
+	case secret.LeaseID != "" && secret.TTL > 0:
+		// dynamic secrets are kept for their lease, so that not every read creates new credentials
+		added.expires = leaseExpiry(now, secret.TTL)
+	case secret.TTL > 0 && secret.TTL < r.ttl:
+		added.expires = now.Add(secret.TTL)
+	default:
+		added.expires = now.Add(r.ttl)
+	}
+
+	var evicted []*cacheEntry
+	r.lock.Lock()
+	if existing, ok := r.cache[key]; ok {
+		if existing != stale && now.Before(existing.expires) {
+			// another request read the secret concurrently, its secret is used and ours is discarded
+			existing.used = now
+			r.lock.Unlock()
+			if err := r.revokeLease(ctx, added); err != nil {
+				return nil, fmt.Errorf("failed to revoke the lease of %s: %w", reference, err)
+			}
+			return existing.secret, nil
+		}
+		evicted = append(evicted, existing)
+		delete(r.cache, key)
+	}
+	evicted = append(evicted, r.evict(now)...)
+	r.cache[key] = added
+	r.lock.Unlock()
+
+	for _, old := range evicted {
+		// the lease of an evicted secret is not used anymore, failures only delay its expiry
+		_ = r.revokeLease(ctx, old)
+	}
+	return secret, nil
+}
+
+// evict removes the expired secrets from the cache and, if it is full, the least recently used secret. It must
+// be called with the lock held, the leases of the returned secrets must be revoked.
+func (r *Resolver) evict(now time.Time) []*cacheEntry {
+	var evicted []*cacheEntry
+	var oldestKey string
+	var oldest *cacheEntry
+	for key, entry := range r.cache {
+		if !now.Before(entry.expires) {
+			evicted = append(evicted, entry)
+			delete(r.cache, key)
+			continue
+		}
+		if oldest == nil || entry.used.Before(oldest.used) {
+			oldestKey, oldest = key, entry
+		}
+	}
+	if len(r.cache) >= r.size && oldest != nil {
+		evicted = append(evicted, oldest)
+		delete(r.cache, oldestKey)
+	}
+	return evicted
+}
+
+type resolverContextKey struct{}
+
+// WithResolver returns a context which carries the resolver, so that the credentials read during a request can
+// be resolved where they are used. A nil resolver is not added.
+func WithResolver(ctx context.Context, resolver *Resolver) context.Context {
+	if resolver == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, resolverContextKey{}, resolver)
+}
+
+// FromContext returns the resolver of the context. It returns nil if there is none, the nil resolver returns
+// values which are no references as they are and fails for references.
+func FromContext(ctx context.Context) *Resolver {
+	resolver, _ := ctx.Value(resolverContextKey{}).(*Resolver)
+	return resolver
+}
+
+// RestoreReferences sets the string fields of the struct dst points to back to the references in the same fields
+// of the struct src points to, e.g. after a copy with resolved references has been validated. Both must point to
+// the same type.
+func RestoreReferences(dst, src interface{}) error {
+	dstValue, srcValue := reflect.ValueOf(dst), reflect.ValueOf(src)
+	if dstValue.Kind() != reflect.Pointer || dstValue.IsNil() || srcValue.Kind() != reflect.Pointer || srcValue.IsNil() {
+		return errors.New("expected pointers to structs")
+	}
+	if dstValue.Type() != srcValue.Type() {
+		return fmt.Errorf("expected pointers to the same type, got %s and %s", dstValue.Type(), srcValue.Type())
+	}
+	restoreReferences(dstValue.Elem(), srcValue.Elem())
+	return nil
+}
+
+func restoreReferences(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if !src.IsNil() && !dst.IsNil() {
+			restoreReferences(dst.Elem(), src.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).IsExported() {
+				restoreReferences(dst.Field(i), src.Field(i))
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < src.Len() && i < dst.Len(); i++ {
+			restoreReferences(dst.Index(i), src.Index(i))
+		}
+	case reflect.String:
+		if IsReference(src.String()) && dst.CanSet() {
+			dst.SetString(src.String())
+		}
+	}
+}
+
+// stringValues converts the values of a JSON object to strings, values which are no strings are JSON encoded.
+func stringValues(fields map[string]interface{}) (map[string]string, error) {
+	values := make(map[string]string, len(fields))
+	for key, value := range fields {
+		if s, ok := value.(string); ok {
+			values[key] = s
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the value of %s: %w", key, err)
+		}
+		values[key] = string(encoded)
+	}
+	return values, nil
+}
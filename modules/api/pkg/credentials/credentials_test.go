@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// dynamicSource returns new values for every read, like the dynamic secrets of Vault.
+type dynamicSource struct {
+	reads int
+	ttl   time.Duration
+}
+
+func (s *dynamicSource) Resolve(_ context.Context, path string) (*Secret, error) {
+	s.reads++
+	return &Secret{
+		Data: map[string]string{
+			"access_key": fmt.Sprintf("%s-access-%d", path, s.reads),
+			"secret_key": fmt.Sprintf("%s-secret-%d", path, s.reads),
+		},
+		TTL: s.ttl,
+	}, nil
+}
+
+// leasedSource returns dynamic secrets with renewable leases and records the renewed and revoked leases.
+type leasedSource struct {
+	reads   int
+	renewed []string
+	revoked []string
+}
+
+func (s *leasedSource) Resolve(_ context.Context, path string) (*Secret, error) {
+	s.reads++
+	return &Secret{
+		Data:      map[string]string{"token": fmt.Sprintf("%s-%d", path, s.reads)},
+		TTL:       10 * time.Minute,
+		LeaseID:   fmt.Sprintf("lease-%d", s.reads),
+		Renewable: true,
+	}, nil
+}
+
+func (s *leasedSource) Renew(_ context.Context, leaseID string) (time.Duration, error) {
+	s.renewed = append(s.renewed, leaseID)
+	return 10 * time.Minute, nil
+}
+
+func (s *leasedSource) Revoke(_ context.Context, leaseID string) error {
+	s.revoked = append(s.revoked, leaseID)
+	return nil
+}
+
+func TestParseReference(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected Reference
+		valid    bool
+	}{
+		{value: "ref+vault://aws/creds/kkp#access_key", expected: Reference{Source: "vault", Path: "aws/creds/kkp", Key: "access_key"}, valid: true},
+		{value: "ref+file://token", expected: Reference{Source: "file", Path: "token"}, valid: true},
+		{value: "plain"},
+		{value: "ref+vault:aws"},
+		{value: "ref+://path#key"},
+		{value: "ref+secret://#key"},
+	}
+
+	for _, tc := range testCases {
+		reference, err := ParseReference(tc.value)
+		if tc.valid != (err == nil) {
+			t.Errorf("expected %q to be valid: %t, got %v", tc.value, tc.valid, err)
+			continue
+		}
+		if reference != tc.expected {
+			t.Errorf("expected %q to be parsed to %+v, got %+v", tc.value, tc.expected, reference)
+		}
+	}
+}
+
+func TestResolver(t *testing.T) {
+	source := &dynamicSource{}
+	resolver := NewResolver(map[string]CredentialSource{"vault": source}, time.Minute)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	credentials := struct {
+		AccessKeyID     string
+		SecretAccessKey string
+		Region          string
+		Nested          *struct{ Token string }
+	}{
+		AccessKeyID:     "ref+vault://aws/creds/kkp#access_key",
+		SecretAccessKey: "ref+vault://aws/creds/kkp#secret_key",
+		Region:          "eu-central-1",
+		Nested:          &struct{ Token string }{Token: "ref+vault://token/creds/kkp#access_key"},
+	}
+	if err := resolver.ResolveFields(ctx, &credentials); err != nil {
+		t.Fatalf("failed to resolve the fields: %v", err)
+	}
+	if credentials.AccessKeyID != "aws/creds/kkp-access-1" || credentials.SecretAccessKey != "aws/creds/kkp-secret-1" {
+		t.Errorf("expected the keys of a single read, got %q and %q", credentials.AccessKeyID, credentials.SecretAccessKey)
+	}
+	if credentials.Region != "eu-central-1" || credentials.Nested.Token != "token/creds/kkp-access-2" {
+		t.Errorf("unexpected values %+v", credentials)
+	}
+
+	// the secret is cached
+	if value, err := resolver.Resolve(ctx, "ref+vault://aws/creds/kkp#secret_key"); err != nil || value != "aws/creds/kkp-secret-1" {
+		t.Errorf("expected the cached value, got %q (%v)", value, err)
+	}
+	now = now.Add(2 * time.Minute)
+	if value, err := resolver.Resolve(ctx, "ref+vault://aws/creds/kkp#secret_key"); err != nil || value != "aws/creds/kkp-secret-3" {
+		t.Errorf("expected a new value after the TTL, got %q (%v)", value, err)
+	}
+
+	// the cache does not outlive the lease
+	source.ttl = 10 * time.Second
+	if _, err := resolver.Resolve(ctx, "ref+vault://azure/creds/kkp#access_key"); err != nil {
+		t.Fatalf("failed to resolve: %v", err)
+	}
+	now = now.Add(20 * time.Second)
+	if value, err := resolver.Resolve(ctx, "ref+vault://azure/creds/kkp#access_key"); err != nil || value != "azure/creds/kkp-access-5" {
+		t.Errorf("expected a new value after the lease, got %q (%v)", value, err)
+	}
+
+	for _, reference := range []string{"ref+vault://aws/creds/kkp", "ref+vault://aws/creds/kkp#token", "ref+file://aws"} {
+		if _, err := resolver.Resolve(ctx, reference); err == nil {
+			t.Errorf("expected an error for %q", reference)
+		}
+	}
+}
+
+func TestResolverLeases(t *testing.T) {
+	source := &leasedSource{}
+	resolver := NewResolver(map[string]CredentialSource{"vault": source}, time.Minute)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	resolve := func(reference, expected string) {
+		t.Helper()
+		if value, err := resolver.Resolve(ctx, reference); err != nil || value != expected {
+			t.Fatalf("expected %q for %s, got %q (%v)", expected, reference, value, err)
+		}
+	}
+
+	// a dynamic secret is kept while it is used, its lease is renewed instead of creating new credentials
+	resolve("ref+vault://aws/creds/kkp#token", "aws/creds/kkp-1")
+	for range 4 {
+		now = now.Add(45 * time.Second)
+		resolve("ref+vault://aws/creds/kkp#token", "aws/creds/kkp-1")
+		resolver.maintain(ctx, log)
+	}
+	if len(source.renewed) != 4 || len(source.revoked) != 0 {
+		t.Errorf("expected the lease to be renewed 4 times, got renewed %v and revoked %v", source.renewed, source.revoked)
+	}
+
+	// the lease of a secret which is not used anymore is revoked
+	now = now.Add(2 * time.Minute)
+	resolver.maintain(ctx, log)
+	if !reflect.DeepEqual(source.revoked, []string{"lease-1"}) || len(resolver.cache) != 0 {
+		t.Errorf("expected the unused secret to be evicted and its lease revoked, got revoked %v and %d cached secrets", source.revoked, len(resolver.cache))
+	}
+
+	// the cache is bounded, the least recently used secret is evicted and its lease revoked
+	resolver.size = 2
+	resolve("ref+vault://a#token", "a-2")
+	now = now.Add(time.Second)
+	resolve("ref+vault://b#token", "b-3")
+	now = now.Add(time.Second)
+	resolve("ref+vault://a#token", "a-2")
+	resolve("ref+vault://c#token", "c-4")
+	if !reflect.DeepEqual(source.revoked, []string{"lease-1", "lease-3"}) || len(resolver.cache) != 2 {
+		t.Errorf("expected the secret b to be evicted, got revoked %v and %d cached secrets", source.revoked, len(resolver.cache))
+	}
+
+	// an expired secret is read again, the expired secrets are evicted and their leases revoked
+	now = now.Add(9*time.Minute + 30*time.Second)
+	resolve("ref+vault://a#token", "a-5")
+	if !reflect.DeepEqual(source.revoked, []string{"lease-1", "lease-3", "lease-2", "lease-4"}) || len(resolver.cache) != 1 {
+		t.Errorf("expected the leases of the expired secrets to be revoked, got revoked %v and %d cached secrets", source.revoked, len(resolver.cache))
+	}
+}
+
+func TestRestoreReferences(t *testing.T) {
+	type spec struct {
+		Token    string
+		Region   string
+		Nested   *struct{ Password string }
+		Excluded []string
+	}
+	referenced := &spec{
+		Token:    "ref+vault://token#value",
+		Region:   "ref-less",
+		Nested:   &struct{ Password string }{Password: "ref+file://password"},
+		Excluded: []string{"ref+vault://token#value"},
+	}
+	resolved := &spec{
+		Token:    "token",
+		Region:   "eu-central-1",
+		Nested:   &struct{ Password string }{Password: "password"},
+		Excluded: []string{},
+	}
+	if err := RestoreReferences(resolved, referenced); err != nil {
+		t.Fatalf("failed to restore the references: %v", err)
+	}
+	expected := &spec{
+		Token:    "ref+vault://token#value",
+		Region:   "eu-central-1",
+		Nested:   &struct{ Password string }{Password: "ref+file://password"},
+		Excluded: []string{},
+	}
+	if !reflect.DeepEqual(resolved, expected) {
+		t.Errorf("expected %+v, got %+v", expected, resolved)
+	}
+
+	if err := RestoreReferences(resolved, &struct{ Token string }{}); err == nil {
+		t.Error("expected an error for different types")
+	}
+}
+
+func TestFileSource(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "token"), []byte("secret-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "azure.json"), []byte(`{"clientID":"id","clientSecret":"secret"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := NewResolver(map[string]CredentialSource{"file": NewFileSource(root)}, time.Minute)
+	ctx := context.Background()
+	for reference, expected := range map[string]string{
+		"ref+file://token":                     "secret-token",
+		"ref+file://azure.json#clientSecret":   "secret",
+		"ref+file://./azure.json#clientID":     "id",
+		"ref+file://token#token":               "secret-token",
+		"ref+file://azure.json#tenantID":       "",
+		"ref+file://../etc/passwd":             "",
+		"ref+file://" + root + "/azure.json#x": "",
+	} {
+		value, err := resolver.Resolve(ctx, reference)
+		if expected == "" && err == nil {
+			t.Errorf("expected an error for %q, got %q", reference, value)
+		}
+		if expected != "" && value != expected {
+			t.Errorf("expected %q for %q, got %q (%v)", expected, reference, value, err)
+		}
+	}
+}
+
+func TestSecretSource(t *testing.T) {
+	client := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "cloud-credentials"},
+			Data:       map[string][]byte{"accessKeyID": []byte("id"), "secretAccessKey": []byte("secret")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "kube-system"},
+			Data:       map[string][]byte{"accessKeyID": []byte("id")},
+		},
+	).Build()
+	source := NewSecretSource(client, []string{"cloud-credentials"})
+
+	secret, err := source.Resolve(context.Background(), "cloud-credentials/aws")
+	if err != nil {
+		t.Fatalf("failed to resolve the secret: %v", err)
+	}
+	if expected := map[string]string{"accessKeyID": "id", "secretAccessKey": "secret"}; !reflect.DeepEqual(secret.Data, expected) {
+		t.Errorf("expected %v, got %v", expected, secret.Data)
+	}
+
+	for _, path := range []string{"kube-system/aws", "cloud-credentials/missing", "aws"} {
+		if _, err := source.Resolve(context.Background(), path); err == nil {
+			t.Errorf("expected an error for %q", path)
+		}
+	}
+}
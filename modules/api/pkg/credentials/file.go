@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileSource reads secrets from files below a directory, e.g. the mount point of a volume of the Secrets Store
+// CSI driver. A file with a JSON object provides its fields as values, any other file provides its content as the
+// single value.
+type FileSource struct {
+	root string
+}
+
+var _ CredentialSource = &FileSource{}
+
+// NewFileSource returns a source for the files below the root directory.
+func NewFileSource(root string) *FileSource {
+	return &FileSource{root: root}
+}
+
+func (s *FileSource) Resolve(_ context.Context, path string) (*Secret, error) {
+	if !filepath.IsLocal(path) {
+		return nil, fmt.Errorf("the path %q is not within the credentials directory", path)
+	}
+
+	content, err := os.ReadFile(filepath.Join(s.root, path))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(content, &fields); err == nil {
+		values, err := stringValues(fields)
+		if err != nil {
+			return nil, err
+		}
+		return &Secret{Data: values}, nil
+	}
+
+	return &Secret{Data: map[string]string{filepath.Base(path): strings.TrimSpace(string(content))}}, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretSource reads Kubernetes secrets in other namespaces than the one of the presets. The path is the
+// namespace and the name of the secret, e.g. "cloud-credentials/aws". Only the allowed namespaces can be read, so
+// that presets cannot reference arbitrary secrets of the cluster.
+type SecretSource struct {
+	reader     ctrlruntimeclient.Reader
+	namespaces sets.Set[string]
+}
+
+var _ CredentialSource = &SecretSource{}
+
+// NewSecretSource returns a source for the secrets in the namespaces.
+func NewSecretSource(reader ctrlruntimeclient.Reader, namespaces []string) *SecretSource {
+	return &SecretSource{
+		reader:     reader,
+		namespaces: sets.New(namespaces...),
+	}
+}
+
+func (s *SecretSource) Resolve(ctx context.Context, path string) (*Secret, error) {
+	namespace, name, found := strings.Cut(path, "/")
+	if !found || namespace == "" || name == "" {
+		return nil, fmt.Errorf("invalid path %q, expected <namespace>/<name>", path)
+	}
+	if !s.namespaces.Has(namespace) {
+		return nil, fmt.Errorf("secrets in the namespace %s cannot be referenced", namespace)
+	}
+
+	secret := &corev1.Secret{}
+	if err := s.reader.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		data[key] = string(value)
+	}
+	return &Secret{Data: data}, nil
+}
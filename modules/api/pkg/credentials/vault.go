@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/tracing"
+)
+
+const (
+	// DefaultVaultKubernetesAuthMount is the mount path of the Kubernetes auth method of Vault.
+	DefaultVaultKubernetesAuthMount = "kubernetes"
+	// DefaultServiceAccountTokenFile is the token of the service account of the pod the API runs in.
+	DefaultServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	vaultTimeout = 10 * time.Second
+)
+
+// VaultConfig configures the access to HashiCorp Vault.
+type VaultConfig struct {
+	// Address is the URL of Vault, e.g. https://vault.example.com:8200.
+	Address string
+	// Namespace is the Vault Enterprise namespace of the secrets.
+	Namespace string
+	// TokenFile is a file with the token for Vault, e.g. written by the Vault agent. It is read for every request,
+	// so that the token can be renewed.
+	TokenFile string
+	// KubernetesRole is the role to log in with the service account token through the Kubernetes auth method. It
+	// is used if no TokenFile is set.
+	KubernetesRole string
+	// KubernetesAuthMount is the mount path of the Kubernetes auth method, DefaultVaultKubernetesAuthMount if empty.
+	KubernetesAuthMount string
+	// ServiceAccountTokenFile is the service account token to log in with, DefaultServiceAccountTokenFile if empty.
+	ServiceAccountTokenFile string
+}
+
+// VaultSource reads the secrets of HashiCorp Vault. The path is the API path of the secret without the version
+// prefix, e.g. "secret/data/aws" for a KV v2 secret or "aws/creds/kkp" for a dynamic AWS secret. The values of
+// KV v2 secrets are unwrapped from their metadata.
+type VaultSource struct {
+	config VaultConfig
+	client *http.Client
+	now    func() time.Time
+
+	lock         sync.Mutex
+	token        string
+	tokenExpires time.Time
+}
+
+var (
+	_ CredentialSource = &VaultSource{}
+	_ LeaseManager     = &VaultSource{}
+)
+
+// NewVaultSource returns a source for the secrets of Vault.
+func NewVaultSource(config VaultConfig) (*VaultSource, error) {
+	if config.TokenFile == "" && config.KubernetesRole == "" {
+		return nil, errors.New("either a token file or a Kubernetes auth role is required for Vault")
+	}
+	if config.KubernetesAuthMount == "" {
+		config.KubernetesAuthMount = DefaultVaultKubernetesAuthMount
+	}
+	if config.ServiceAccountTokenFile == "" {
+		config.ServiceAccountTokenFile = DefaultServiceAccountTokenFile
+	}
+	config.Address = strings.TrimSuffix(config.Address, "/")
+
+	return &VaultSource{
+		config: config,
+		client: &http.Client{Timeout: vaultTimeout, Transport: tracing.NewTransport()},
+		now:    time.Now,
+	}, nil
+}
+
+type vaultResponse struct {
+	LeaseID       string                 `json:"lease_id"`
+	Renewable     bool                   `json:"renewable"`
+	LeaseDuration int                    `json:"lease_duration"`
+	Data          map[string]interface{} `json:"data"`
+	Auth          *struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int    `json:"lease_duration"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+func (s *VaultSource) Resolve(ctx context.Context, path string) (*Secret, error) {
+	token, err := s.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.do(ctx, http.MethodGet, strings.TrimPrefix(path, "/"), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data := response.Data
+	// KV v2 secrets contain the values in data.data and the version in data.metadata
+	if values, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"].(map[string]interface{}); ok {
+			data = values
+		}
+	}
+
+	values, err := stringValues(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Secret{
+		Data:      values,
+		TTL:       time.Duration(response.LeaseDuration) * time.Second,
+		LeaseID:   response.LeaseID,
+		Renewable: response.Renewable,
+	}, nil
+}
+
+// Renew renews the lease of a dynamic secret.
+func (s *VaultSource) Renew(ctx context.Context, leaseID string) (time.Duration, error) {
+	token, err := s.getToken(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := s.do(ctx, http.MethodPut, "sys/leases/renew", token, map[string]string{"lease_id": leaseID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to renew the lease: %w", err)
+	}
+	return time.Duration(response.LeaseDuration) * time.Second, nil
+}
+
+// Revoke revokes the lease of a dynamic secret.
+func (s *VaultSource) Revoke(ctx context.Context, leaseID string) error {
+	token, err := s.getToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.do(ctx, http.MethodPut, "sys/leases/revoke", token, map[string]string{"lease_id": leaseID}); err != nil {
+		return fmt.Errorf("failed to revoke the lease: %w", err)
+	}
+	return nil
+}
+
+func (s *VaultSource) getToken(ctx context.Context) (string, error) {
+	if s.config.TokenFile != "" {
+		token, err := os.ReadFile(s.config.TokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the Vault token: %w", err)
+		}
+		return strings.TrimSpace(string(token)), nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.token != "" && s.now().Before(s.tokenExpires) {
+		return s.token, nil
+	}
+
+	jwt, err := os.ReadFile(s.config.ServiceAccountTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the service account token: %w", err)
+	}
+	login := map[string]string{"role": s.config.KubernetesRole, "jwt": strings.TrimSpace(string(jwt))}
+	response, err := s.do(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", s.config.KubernetesAuthMount), "", login)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in to Vault: %w", err)
+	}
+	if response.Auth == nil || response.Auth.ClientToken == "" {
+		return "", errors.New("failed to log in to Vault: no token in the response")
+	}
+
+	s.token = response.Auth.ClientToken
+	// renew the token before it expires
+	s.tokenExpires = s.now().Add(time.Duration(response.Auth.LeaseDuration) * time.Second * 9 / 10)
+	return s.token, nil
+}
+
+func (s *VaultSource) do(ctx context.Context, method, path, token string, body interface{}) (*vaultResponse, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/v1/%s", s.config.Address, path), reader)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		request.Header.Set("X-Vault-Token", token)
+	}
+	if s.config.Namespace != "" {
+		request.Header.Set("X-Vault-Namespace", s.config.Namespace)
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := &vaultResponse{}
+	if resp.StatusCode == http.StatusNoContent {
+		return response, nil
+	}
+	decodeErr := json.NewDecoder(resp.Body).Decode(response)
+	if resp.StatusCode != http.StatusOK {
+		if len(response.Errors) > 0 {
+			return nil, fmt.Errorf("vault returned %s: %s", resp.Status, strings.Join(response.Errors, "; "))
+		}
+		return nil, fmt.Errorf("vault returned %s", resp.Status)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("invalid response of Vault: %w", decodeErr)
+	}
+	return response, nil
+}
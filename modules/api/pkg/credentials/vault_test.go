@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestVault(t *testing.T) (*httptest.Server, *int) {
+	t.Helper()
+
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/kubernetes/login" {
+			login := map[string]string{}
+			if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login["role"] != "kkp" || login["jwt"] != "sa-token" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+				return
+			}
+			logins++
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"vault-token","lease_duration":3600}}`))
+			return
+		}
+
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/openstack":
+			_, _ = w.Write([]byte(`{"data":{"data":{"username":"admin","password":"secret","port":5000},"metadata":{"version":3}}}`))
+		case "/v1/aws/creds/kkp":
+			_, _ = w.Write([]byte(`{"lease_id":"aws/creds/kkp/abc","renewable":true,"lease_duration":900,"data":{"access_key":"AKIA","secret_key":"secret","security_token":null}}`))
+		case "/v1/sys/leases/renew", "/v1/sys/leases/revoke":
+			lease := map[string]string{}
+			if r.Method != http.MethodPut || json.NewDecoder(r.Body).Decode(&lease) != nil || lease["lease_id"] != "aws/creds/kkp/abc" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["invalid lease id"]}`))
+				return
+			}
+			if r.URL.Path == "/v1/sys/leases/revoke" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			_, _ = w.Write([]byte(`{"lease_id":"aws/creds/kkp/abc","renewable":true,"lease_duration":1800}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &logins
+}
+
+func TestVaultSource(t *testing.T) {
+	server, logins := newTestVault(t)
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("sa-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := NewVaultSource(VaultConfig{Address: server.URL + "/", KubernetesRole: "kkp", ServiceAccountTokenFile: tokenFile})
+	if err != nil {
+		t.Fatalf("failed to create the source: %v", err)
+	}
+	ctx := context.Background()
+
+	secret, err := source.Resolve(ctx, "secret/data/openstack")
+	if err != nil {
+		t.Fatalf("failed to resolve the KV secret: %v", err)
+	}
+	expected := &Secret{Data: map[string]string{"username": "admin", "password": "secret", "port": "5000"}}
+	if !reflect.DeepEqual(secret, expected) {
+		t.Errorf("expected %+v, got %+v", expected, secret)
+	}
+
+	secret, err = source.Resolve(ctx, "aws/creds/kkp")
+	if err != nil {
+		t.Fatalf("failed to resolve the dynamic secret: %v", err)
+	}
+	expected = &Secret{
+		Data:      map[string]string{"access_key": "AKIA", "secret_key": "secret", "security_token": "null"},
+		TTL:       15 * time.Minute,
+		LeaseID:   "aws/creds/kkp/abc",
+		Renewable: true,
+	}
+	if !reflect.DeepEqual(secret, expected) {
+		t.Errorf("expected %+v, got %+v", expected, secret)
+	}
+
+	if duration, err := source.Renew(ctx, secret.LeaseID); err != nil || duration != 30*time.Minute {
+		t.Errorf("expected the lease to be renewed for 30m, got %v (%v)", duration, err)
+	}
+	if err := source.Revoke(ctx, secret.LeaseID); err != nil {
+		t.Errorf("failed to revoke the lease: %v", err)
+	}
+	if err := source.Revoke(ctx, "unknown"); err == nil {
+		t.Error("expected an error for an unknown lease")
+	}
+
+	if *logins != 1 {
+		t.Errorf("expected the token to be reused, got %d logins", *logins)
+	}
+
+	if _, err := source.Resolve(ctx, "secret/data/missing"); err == nil {
+		t.Error("expected an error for a missing secret")
+	}
+
+	// a token file is used as it is
+	if err := os.WriteFile(tokenFile, []byte("invalid"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	source, err = NewVaultSource(VaultConfig{Address: server.URL, TokenFile: tokenFile})
+	if err != nil {
+		t.Fatalf("failed to create the source: %v", err)
+	}
+	if _, err := source.Resolve(ctx, "aws/creds/kkp"); err == nil {
+		t.Error("expected an error for an invalid token")
+	}
+
+	if _, err := NewVaultSource(VaultConfig{Address: server.URL}); err == nil {
+		t.Error("expected an error without authentication")
+	}
+}
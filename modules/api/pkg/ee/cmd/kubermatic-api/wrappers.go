@@ -26,15 +26,21 @@ package kubermaticapi
 
 import (
 	"context"
+	"fmt"
+
+	"go.uber.org/zap"
 
 	backupstorage "k8c.io/dashboard/v2/pkg/ee/clusterbackup/storage-location"
 	groupprojectbinding "k8c.io/dashboard/v2/pkg/ee/group-project-binding/provider"
 	policytemplate "k8c.io/dashboard/v2/pkg/ee/kyverno/policy-template"
 	eeprovider "k8c.io/dashboard/v2/pkg/ee/provider"
 	resourcequotas "k8c.io/dashboard/v2/pkg/ee/resource-quota"
+	"k8c.io/dashboard/v2/pkg/notification"
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
+	ctrlruntimecache "sigs.k8s.io/controller-runtime/pkg/cache"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -61,3 +67,19 @@ func BackupStorageProviderFactory(createMasterImpersonatedClient kubernetes.Impe
 func PolicyTemplateProviderFactory(privilegedClient ctrlruntimeclient.Client) provider.PolicyTemplateProvider {
 	return policytemplate.NewPolicyTemplateProvider(privilegedClient)
 }
+
+// StartResourceQuotaAlerts notifies about resource quotas crossing their alert thresholds whenever their usage
+// changes.
+func StartResourceQuotaAlerts(ctx context.Context, cache ctrlruntimecache.Cache, client ctrlruntimeclient.Client, notifier notification.Notifier, log *zap.SugaredLogger) error {
+	informer, err := cache.GetInformer(ctx, &kubermaticv1.ResourceQuota{})
+	if err != nil {
+		return fmt.Errorf("failed to setup resource quota informer: %w", err)
+	}
+
+	alertNotifier := resourcequotas.NewAlertNotifier(client, notifier, log)
+	if _, err := informer.AddEventHandler(alertNotifier); err != nil {
+		return fmt.Errorf("failed to setup event handler for resource quota informer: %w", err)
+	}
+	go alertNotifier.Run(ctx)
+	return nil
+}
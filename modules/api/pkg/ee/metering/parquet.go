@@ -0,0 +1,87 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package metering
+
+import (
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// exportColumn is a column of an export, only one of its value slices is set.
+type exportColumn struct {
+	name    string
+	strings []string
+	int64s  []int64
+	doubles []float64
+}
+
+// node returns the required Parquet column of the values.
+func (c exportColumn) node() parquet.Node {
+	switch {
+	case c.int64s != nil:
+		return parquet.Leaf(parquet.Int64Type)
+	case c.doubles != nil:
+		return parquet.Leaf(parquet.DoubleType)
+	default:
+		return parquet.String()
+	}
+}
+
+func (c exportColumn) value(row int) parquet.Value {
+	switch {
+	case c.int64s != nil:
+		return parquet.Int64Value(c.int64s[row])
+	case c.doubles != nil:
+		return parquet.DoubleValue(c.doubles[row])
+	default:
+		return parquet.ByteArrayValue([]byte(c.strings[row]))
+	}
+}
+
+// writeParquet writes the columns with the given number of rows as a Parquet file. Parquet orders the columns of
+// the schema by their names.
+func writeParquet(w io.Writer, columns []exportColumn, rows int) error {
+	group := make(parquet.Group, len(columns))
+	byName := make(map[string]exportColumn, len(columns))
+	for _, column := range columns {
+		group[column.name] = column.node()
+		byName[column.name] = column
+	}
+	schema := parquet.NewSchema("export", group)
+
+	writer := parquet.NewWriter(w, schema)
+	paths := schema.Columns()
+	for row := 0; row < rows; row++ {
+		values := make(parquet.Row, len(paths))
+		for i, path := range paths {
+			values[i] = byName[path[0]].value(row).Level(0, 0, i)
+		}
+		if _, err := writer.WriteRows([]parquet.Row{values}); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
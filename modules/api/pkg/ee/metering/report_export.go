@@ -0,0 +1,659 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package metering
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+const (
+	ExportFormatJSON    = "json"
+	ExportFormatCSV     = "csv"
+	ExportFormatParquet = "parquet"
+
+	ParquetContentType = "application/vnd.apache.parquet"
+
+	dateFormat = "2006-01-02"
+
+	// maxExportedReports limits the number of reports which are read for an export.
+	maxExportedReports = 1000
+)
+
+// Dimensions to group the report rows by, besides label:<key>.
+const (
+	groupByProject = "project"
+	groupByCluster = "cluster"
+	groupByDay     = "day"
+	groupByWeek    = "week"
+	groupByMonth   = "month"
+
+	labelPrefix = "label:"
+	periodName  = "period"
+	rowsName    = "rows"
+)
+
+// ReportExport is the aggregation of the reports in the requested format, it is written by EncodeReportExport.
+type ReportExport struct {
+	Name        string
+	Format      string
+	Aggregation *apiv1.MeteringReportAggregation
+}
+
+// ExportReports reads the reports of a configuration in the date range and aggregates their rows.
+// Assumes all Seeds uses the same secrets.
+func ExportReports(ctx context.Context, req interface{}, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) (*ReportExport, error) {
+	if seedsGetter == nil || seedClientGetter == nil {
+		return nil, errors.New("parameter seedsGetter nor seedClientGetter cannot be nil")
+	}
+
+	request, ok := req.(exportReportReq)
+	if !ok {
+		return nil, utilerrors.NewBadRequest("invalid request")
+	}
+
+	seedsMap, err := seedsGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, seed := range seedsMap {
+		seedClient, err := seedClientGetter(seed)
+		if err != nil {
+			return nil, err
+		}
+
+		mc, bucket, err := getS3DataFromSeed(ctx, seed, seedClient)
+		if err != nil {
+			return nil, err
+		}
+
+		aggregation, err := aggregateReports(ctx, mc, bucket, request, reportInterval(seed, request.ConfigurationName))
+		if err != nil {
+			return nil, err
+		}
+
+		return &ReportExport{
+			Name:        strings.TrimSuffix(request.ConfigurationName, "-"),
+			Format:      request.Format,
+			Aggregation: aggregation,
+		}, nil
+	}
+
+	return nil, utilerrors.New(http.StatusNotFound, "no seed with metering reports found")
+}
+
+// ExportReportsByLabels aggregates the reports like ExportReports, grouped by the labels before the dimensions of
+// the request.
+func ExportReportsByLabels(ctx context.Context, req interface{}, labels []string, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) (*ReportExport, error) {
+	request, ok := req.(exportReportReq)
+	if !ok {
+		return nil, utilerrors.NewBadRequest("invalid request")
+	}
+
+	groupBy := make([]string, 0, len(labels)+len(request.groupBy))
+	for _, label := range labels {
+		groupBy = append(groupBy, labelPrefix+label)
+	}
+	request.groupBy = append(groupBy, request.groupBy...)
+
+	return ExportReports(ctx, request, seedsGetter, seedClientGetter)
+}
+
+// reportInterval returns the number of days covered by the reports of the configuration. The legacy reports
+// are daily.
+func reportInterval(seed *kubermaticv1.Seed, configurationName string) int {
+	if seed.Spec.Metering != nil {
+		if config, ok := seed.Spec.Metering.ReportConfigurations[configurationName]; ok && config.Interval > 0 {
+			return int(config.Interval)
+		}
+	}
+	return 1
+}
+
+func aggregateReports(ctx context.Context, mc *minio.Client, bucket string, request exportReportReq, interval int) (*apiv1.MeteringReportAggregation, error) {
+	// See ListReports for the prefix of the legacy reports.
+	prefix := request.ConfigurationName + "/"
+	if request.ConfigurationName == "report-" {
+		prefix = request.ConfigurationName
+	}
+
+	mcCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var reports []reportObject
+	for object := range mc.ListObjects(mcCtx, bucket, minio.ListObjectsOptions{Prefix: prefix}) {
+		if object.Err != nil {
+			return nil, errors.New(object.Err.Error())
+		}
+		if !strings.HasSuffix(object.Key, ".csv") {
+			continue
+		}
+		report := reportObject{key: object.Key, written: object.LastModified, period: newReportPeriod(object.LastModified, interval)}
+		if !request.inRange(report.period.start) {
+			continue
+		}
+		if len(reports) == maxExportedReports {
+			return nil, utilerrors.NewBadRequest("more than %d reports are in the date range, please narrow it", maxExportedReports)
+		}
+		reports = append(reports, report)
+	}
+
+	aggregator := newReportAggregator(request.groupBy)
+	added, err := aggregator.addReports(reports, request.Type, func(key string) (io.ReadCloser, error) {
+		return mc.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.MeteringReportAggregation{
+		From:    request.From,
+		To:      request.To,
+		Type:    request.Type,
+		GroupBy: request.groupBy,
+		Reports: added,
+		Records: aggregator.records(),
+	}, nil
+}
+
+// reportPeriod is the time range covered by a report, from the start of the first day until the start of the
+// day after the last one.
+type reportPeriod struct {
+	start, end time.Time
+}
+
+// newReportPeriod returns the period of a report written at the time. The reports cover the interval of their
+// configuration in days before the day they were written.
+func newReportPeriod(written time.Time, interval int) reportPeriod {
+	written = written.UTC()
+	end := time.Date(written.Year(), written.Month(), written.Day(), 0, 0, 0, 0, time.UTC)
+	return reportPeriod{start: end.AddDate(0, 0, -interval), end: end}
+}
+
+// reportObject is a report in the bucket.
+type reportObject struct {
+	key     string
+	written time.Time
+	period  reportPeriod
+}
+
+// addReports adds the reports of the given type and returns their keys. Reports overlapping the period of a report
+// which was already added are skipped, so that the usage is not counted twice, e.g. when the schedule of a
+// configuration runs more often than its interval or a report was written again. Of the reports ending first the
+// latest one is used.
+func (a *reportAggregator) addReports(reports []reportObject, typ string, open func(key string) (io.ReadCloser, error)) ([]string, error) {
+	sort.Slice(reports, func(i, j int) bool {
+		if !reports[i].period.end.Equal(reports[j].period.end) {
+			return reports[i].period.end.Before(reports[j].period.end)
+		}
+		if !reports[i].written.Equal(reports[j].written) {
+			return reports[i].written.After(reports[j].written)
+		}
+		return reports[i].key < reports[j].key
+	})
+
+	added := []string{}
+	var covered time.Time
+	for _, report := range reports {
+		if report.period.start.Before(covered) {
+			continue
+		}
+
+		reader, err := open(report.key)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := a.addReport(reader, report.period, typ)
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read report %s: %w", report.key, err)
+		}
+		// reports of the other type cover the same periods
+		if ok {
+			covered = report.period.end
+			added = append(added, report.key)
+		}
+	}
+	return added, nil
+}
+
+// reportAggregator groups the rows of reports by their dimensions. The values of columns starting with
+// average- are averaged, maximum- and minimum- columns keep their extreme and all other values are summed up.
+type reportAggregator struct {
+	groupBy []string
+	groups  map[string]*reportGroup
+}
+
+type reportGroup struct {
+	dimensions map[string]string
+	rows       int
+	values     map[string]*reportValue
+}
+
+type reportValue struct {
+	sum, min, max float64
+	count         int
+}
+
+func newReportAggregator(groupBy []string) *reportAggregator {
+	return &reportAggregator{groupBy: groupBy, groups: map[string]*reportGroup{}}
+}
+
+// reportType returns the type of the report with the columns, namespace reports have a row per namespace.
+func reportType(header []string) string {
+	for _, column := range header {
+		if column == "namespace-name" {
+			return "namespace"
+		}
+	}
+	return "cluster"
+}
+
+// addReport adds the rows of a report in the CSV format, unless it is not of the given type.
+func (a *reportAggregator) addReport(report io.Reader, period reportPeriod, typ string) (bool, error) {
+	reader := csv.NewReader(report)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if reportType(header) != typ {
+		return false, nil
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			row[column] = record[i]
+		}
+		a.add(row, period)
+	}
+}
+
+// add adds the row of a report of the period. Reports spanning several days, weeks or months are grouped by the
+// first day of their period.
+func (a *reportAggregator) add(row map[string]string, period reportPeriod) {
+	dimensions := map[string]string{}
+	key := make([]string, 0, len(a.groupBy))
+	for _, groupBy := range a.groupBy {
+		for _, name := range dimensionNames(groupBy) {
+			switch {
+			case name == periodName:
+				dimensions[name] = timeBucket(period.start, groupBy)
+			case strings.HasPrefix(name, labelPrefix):
+				dimensions[name] = rowLabel(row, strings.TrimPrefix(name, labelPrefix))
+			default:
+				dimensions[name] = row[name]
+			}
+		}
+		// the names are not part of the key, they can change while the IDs are the same
+		key = append(key, dimensions[dimensionNames(groupBy)[0]])
+	}
+
+	groupKey := strings.Join(key, "\x00")
+	group, ok := a.groups[groupKey]
+	if !ok {
+		group = &reportGroup{dimensions: dimensions, values: map[string]*reportValue{}}
+		a.groups[groupKey] = group
+	}
+	group.rows++
+
+	for column, cell := range row {
+		if isDimensionColumn(column) || cell == "" {
+			continue
+		}
+		// timestamps like created-at are not aggregated
+		v, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			continue
+		}
+		value, ok := group.values[column]
+		if !ok {
+			value = &reportValue{min: v, max: v}
+			group.values[column] = value
+		}
+		value.sum += v
+		value.min = math.Min(value.min, v)
+		value.max = math.Max(value.max, v)
+		value.count++
+	}
+}
+
+func (a *reportAggregator) records() []apiv1.MeteringReportRecord {
+	keys := make([]string, 0, len(a.groups))
+	for key := range a.groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	records := make([]apiv1.MeteringReportRecord, 0, len(keys))
+	for _, key := range keys {
+		group := a.groups[key]
+		record := apiv1.MeteringReportRecord{
+			Dimensions: group.dimensions,
+			Rows:       group.rows,
+			Values:     make(map[string]float64, len(group.values)),
+		}
+		for column, value := range group.values {
+			switch {
+			case strings.HasPrefix(column, "average-"):
+				record.Values[column] = value.sum / float64(value.count)
+			case strings.HasPrefix(column, "maximum-"):
+				record.Values[column] = value.max
+			case strings.HasPrefix(column, "minimum-"):
+				record.Values[column] = value.min
+			default:
+				record.Values[column] = value.sum
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// dimensionNames returns the columns of the records grouped by the dimension, the first one is unique.
+func dimensionNames(groupBy string) []string {
+	switch groupBy {
+	case groupByProject:
+		return []string{"project-id", "project-name"}
+	case groupByCluster:
+		return []string{"cluster-id", "cluster-name"}
+	case groupByDay, groupByWeek, groupByMonth:
+		return []string{periodName}
+	default:
+		return []string{groupBy}
+	}
+}
+
+func isDimensionColumn(column string) bool {
+	return strings.HasSuffix(column, "-id") || strings.HasSuffix(column, "-name") || strings.HasSuffix(column, "-labels")
+}
+
+// rowLabel returns the value of the label of the cluster or, if the cluster does not have it, of its project.
+func rowLabel(row map[string]string, key string) string {
+	for _, column := range []string{"cluster-labels", "project-labels"} {
+		if value, ok := parseLabels(row[column])[key]; ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// parseLabels parses labels written as key=value or key:value pairs, separated by commas, semicolons or
+// spaces and optionally enclosed in map[...].
+func parseLabels(labels string) map[string]string {
+	labels = strings.TrimSuffix(strings.TrimPrefix(labels, "map["), "]")
+	result := map[string]string{}
+	for _, pair := range strings.FieldsFunc(labels, func(r rune) bool { return r == ',' || r == ';' || r == ' ' }) {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			key, value, _ = strings.Cut(pair, ":")
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// timeBucket returns the first day of the day, week or month of the time. Weeks start on Monday.
+func timeBucket(t time.Time, interval string) string {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case groupByWeek:
+		day = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case groupByMonth:
+		day = day.AddDate(0, 0, 1-day.Day())
+	}
+	return day.Format(dateFormat)
+}
+
+// exportColumns returns the aggregation as a table with a column per dimension and value. Values missing in
+// a record are zero.
+func exportColumns(aggregation *apiv1.MeteringReportAggregation) []exportColumn {
+	var columns []exportColumn
+	seen := map[string]bool{}
+	for _, groupBy := range aggregation.GroupBy {
+		for _, name := range dimensionNames(groupBy) {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			column := exportColumn{name: name, strings: make([]string, len(aggregation.Records))}
+			for i, record := range aggregation.Records {
+				column.strings[i] = record.Dimensions[name]
+			}
+			columns = append(columns, column)
+		}
+	}
+
+	rows := exportColumn{name: rowsName, int64s: make([]int64, len(aggregation.Records))}
+	valueNames := map[string]bool{}
+	for i, record := range aggregation.Records {
+		rows.int64s[i] = int64(record.Rows)
+		for name := range record.Values {
+			valueNames[name] = true
+		}
+	}
+	columns = append(columns, rows)
+
+	names := make([]string, 0, len(valueNames))
+	for name := range valueNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		column := exportColumn{name: name, doubles: make([]float64, len(aggregation.Records))}
+		for i, record := range aggregation.Records {
+			column.doubles[i] = record.Values[name]
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func writeCSV(w io.Writer, columns []exportColumn, rows int) error {
+	writer := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.name
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for row := 0; row < rows; row++ {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			switch {
+			case column.int64s != nil:
+				record[i] = strconv.FormatInt(column.int64s[row], 10)
+			case column.doubles != nil:
+				record[i] = strconv.FormatFloat(column.doubles[row], 'f', -1, 64)
+			default:
+				record[i] = column.strings[row]
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// EncodeReportExport writes the export as JSON or as a CSV or Parquet file download.
+func EncodeReportExport(w http.ResponseWriter, response interface{}) error {
+	export, ok := response.(*ReportExport)
+	if !ok {
+		return fmt.Errorf("unexpected response type %T", response)
+	}
+
+	if export.Format == ExportFormatJSON {
+		w.Header().Set("Content-Type", "application/json")
+		return json.NewEncoder(w).Encode(export.Aggregation)
+	}
+
+	columns := exportColumns(export.Aggregation)
+	rows := len(export.Aggregation.Records)
+
+	w.Header().Set("Content-disposition", fmt.Sprintf("attachment; filename=%s.%s", export.Name, export.Format))
+	w.Header().Add("Cache-Control", "no-cache")
+	if export.Format == ExportFormatCSV {
+		w.Header().Set("Content-Type", "text/csv")
+		return writeCSV(w, columns, rows)
+	}
+	w.Header().Set("Content-Type", ParquetContentType)
+	return writeParquet(w, columns, rows)
+}
+
+// swagger:parameters exportMeteringReports getChargebackMetering
+type exportReportReq struct {
+	// in: query
+	// required: true
+	ConfigurationName string `json:"configuration_name"`
+	// The first day of the reports, in the format 2006-01-02. Reports are dated by the first day of the period they
+	// cover.
+	// in: query
+	From string `json:"from"`
+	// The last day of the reports, in the format 2006-01-02.
+	// in: query
+	To string `json:"to"`
+	// The type of the reports, cluster (default) or namespace.
+	// in: query
+	Type string `json:"type"`
+	// Comma separated dimensions to aggregate the rows by: project, cluster, label:<key>, day, week or month.
+	// Without dimensions all rows are aggregated into a single record.
+	// in: query
+	GroupBy string `json:"group_by"`
+	// The format of the export: json (default), csv or parquet.
+	// in: query
+	Format string `json:"format"`
+
+	from, to time.Time
+	groupBy  []string
+}
+
+// inRange returns whether a report starting at the time is in the date range, which includes its last day.
+func (r exportReportReq) inRange(t time.Time) bool {
+	if !r.from.IsZero() && t.Before(r.from) {
+		return false
+	}
+	return r.to.IsZero() || t.Before(r.to.AddDate(0, 0, 1))
+}
+
+func DecodeExportMeteringReportReq(r *http.Request) (interface{}, error) {
+	var req exportReportReq
+	query := r.URL.Query()
+
+	req.ConfigurationName = query.Get("configuration_name")
+	if req.ConfigurationName == "" {
+		return nil, utilerrors.NewBadRequest("`configuration_name` cannot be empty")
+	}
+
+	var err error
+	req.From = query.Get("from")
+	if req.From != "" {
+		if req.from, err = time.Parse(dateFormat, req.From); err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for `from`, expected a date like %s", dateFormat)
+		}
+	}
+	req.To = query.Get("to")
+	if req.To != "" {
+		if req.to, err = time.Parse(dateFormat, req.To); err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for `to`, expected a date like %s", dateFormat)
+		}
+	}
+	if !req.from.IsZero() && !req.to.IsZero() && req.to.Before(req.from) {
+		return nil, utilerrors.NewBadRequest("`to` cannot be before `from`")
+	}
+
+	req.Type = query.Get("type")
+	switch req.Type {
+	case "":
+		req.Type = "cluster"
+	case "cluster", "namespace":
+	default:
+		return nil, utilerrors.NewBadRequest("invalid value for `type`, expected cluster or namespace")
+	}
+
+	req.GroupBy = query.Get("group_by")
+	req.groupBy = []string{}
+	if req.GroupBy != "" {
+		periods := 0
+		for _, groupBy := range strings.Split(req.GroupBy, ",") {
+			switch {
+			case groupBy == groupByProject || groupBy == groupByCluster:
+			case groupBy == groupByDay || groupBy == groupByWeek || groupBy == groupByMonth:
+				periods++
+			case strings.HasPrefix(groupBy, labelPrefix) && len(groupBy) > len(labelPrefix):
+			default:
+				return nil, utilerrors.NewBadRequest("invalid dimension %q in `group_by`, expected project, cluster, label:<key>, day, week or month", groupBy)
+			}
+			req.groupBy = append(req.groupBy, groupBy)
+		}
+		if periods > 1 {
+			return nil, utilerrors.NewBadRequest("`group_by` can contain only one of day, week and month")
+		}
+	}
+
+	req.Format = query.Get("format")
+	switch req.Format {
+	case "":
+		req.Format = ExportFormatJSON
+	case ExportFormatJSON, ExportFormatCSV, ExportFormatParquet:
+	default:
+		return nil, utilerrors.NewBadRequest("invalid value for `format`, expected json, csv or parquet")
+	}
+
+	return req, nil
+}
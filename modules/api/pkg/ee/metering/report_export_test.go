@@ -0,0 +1,336 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package metering
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+)
+
+const (
+	testClusterReport = `project-name,project-id,project-labels,cluster-name,cluster-id,cluster-labels,average-cluster-machines,maximum-cluster-machines,total-used-cpu-seconds,created-at
+web,p1,team=shop,prod,c1,env=prod,3,4,100,2026-01-01T00:00:00Z
+web,p1,team=shop,staging,c2,env=staging,1,1,20,2026-01-01T00:00:00Z
+data,p2,team=analytics,lake,c3,,2,3,50,2026-01-01T00:00:00Z
+`
+	testNamespaceReport = `cluster-name,cluster-id,namespace-name,average-used-cpu-millicores
+prod,c1,default,250
+`
+)
+
+func aggregate(t *testing.T, groupBy []string) []apiv1.MeteringReportRecord {
+	t.Helper()
+
+	aggregator := newReportAggregator(groupBy)
+	reports := []struct {
+		content string
+		written time.Time
+	}{
+		{content: testClusterReport, written: time.Date(2026, 4, 6, 1, 0, 0, 0, time.UTC)},
+		{content: testClusterReport, written: time.Date(2026, 4, 13, 1, 0, 0, 0, time.UTC)},
+		{content: testNamespaceReport, written: time.Date(2026, 4, 13, 1, 0, 0, 0, time.UTC)},
+	}
+	for _, report := range reports {
+		if _, err := aggregator.addReport(strings.NewReader(report.content), newReportPeriod(report.written, 7), "cluster"); err != nil {
+			t.Fatalf("failed to add report: %v", err)
+		}
+	}
+	return aggregator.records()
+}
+
+func TestAggregateReports(t *testing.T) {
+	testCases := []struct {
+		name     string
+		groupBy  []string
+		expected []apiv1.MeteringReportRecord
+	}{
+		{
+			name:    "all rows",
+			groupBy: []string{},
+			expected: []apiv1.MeteringReportRecord{{
+				Dimensions: map[string]string{},
+				Rows:       6,
+				Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 340},
+			}},
+		},
+		{
+			name:    "by project",
+			groupBy: []string{"project"},
+			expected: []apiv1.MeteringReportRecord{
+				{
+					Dimensions: map[string]string{"project-id": "p1", "project-name": "web"},
+					Rows:       4,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 240},
+				},
+				{
+					Dimensions: map[string]string{"project-id": "p2", "project-name": "data"},
+					Rows:       2,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 3, "total-used-cpu-seconds": 100},
+				},
+			},
+		},
+		{
+			name:    "by label and month",
+			groupBy: []string{"label:env", "month"},
+			expected: []apiv1.MeteringReportRecord{
+				{
+					Dimensions: map[string]string{"label:env": "", "period": "2026-03-01"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 3, "total-used-cpu-seconds": 50},
+				},
+				{
+					Dimensions: map[string]string{"label:env": "", "period": "2026-04-01"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 3, "total-used-cpu-seconds": 50},
+				},
+				{
+					Dimensions: map[string]string{"label:env": "prod", "period": "2026-03-01"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 3, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 100},
+				},
+				{
+					Dimensions: map[string]string{"label:env": "prod", "period": "2026-04-01"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 3, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 100},
+				},
+				{
+					Dimensions: map[string]string{"label:env": "staging", "period": "2026-03-01"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 1, "maximum-cluster-machines": 1, "total-used-cpu-seconds": 20},
+				},
+				{
+					Dimensions: map[string]string{"label:env": "staging", "period": "2026-04-01"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 1, "maximum-cluster-machines": 1, "total-used-cpu-seconds": 20},
+				},
+			},
+		},
+		{
+			name:    "by project label and week",
+			groupBy: []string{"label:team", "week"},
+			expected: []apiv1.MeteringReportRecord{
+				{
+					Dimensions: map[string]string{"label:team": "analytics", "period": "2026-03-30"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 3, "total-used-cpu-seconds": 50},
+				},
+				{
+					Dimensions: map[string]string{"label:team": "analytics", "period": "2026-04-06"},
+					Rows:       1,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 3, "total-used-cpu-seconds": 50},
+				},
+				{
+					Dimensions: map[string]string{"label:team": "shop", "period": "2026-03-30"},
+					Rows:       2,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 120},
+				},
+				{
+					Dimensions: map[string]string{"label:team": "shop", "period": "2026-04-06"},
+					Rows:       2,
+					Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 120},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			records := aggregate(t, tc.groupBy)
+			if !reflect.DeepEqual(records, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, records)
+			}
+		})
+	}
+}
+
+func TestAddReports(t *testing.T) {
+	contents := map[string]string{}
+	daily := func(key string, written time.Time, interval int) reportObject {
+		contents[key] = testClusterReport
+		return reportObject{key: key, written: written, period: newReportPeriod(written, interval)}
+	}
+	namespaces := reportObject{key: "weekly/namespaces.csv", written: time.Date(2026, 4, 6, 1, 0, 0, 0, time.UTC)}
+	namespaces.period = newReportPeriod(namespaces.written, 7)
+	contents[namespaces.key] = testNamespaceReport
+
+	reports := []reportObject{
+		// a weekly report written daily, each one overlaps the previous six
+		daily("weekly/04-07.csv", time.Date(2026, 4, 7, 1, 0, 0, 0, time.UTC), 7),
+		namespaces,
+		daily("weekly/04-06.csv", time.Date(2026, 4, 6, 1, 0, 0, 0, time.UTC), 7),
+		daily("weekly/04-13.csv", time.Date(2026, 4, 13, 1, 0, 0, 0, time.UTC), 7),
+		// the report of the first week was written again
+		daily("weekly/04-06-again.csv", time.Date(2026, 4, 6, 9, 0, 0, 0, time.UTC), 7),
+	}
+
+	aggregator := newReportAggregator([]string{"week"})
+	added, err := aggregator.addReports(reports, "cluster", func(key string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(contents[key])), nil
+	})
+	if err != nil {
+		t.Fatalf("failed to add the reports: %v", err)
+	}
+	if expected := []string{"weekly/04-06-again.csv", "weekly/04-13.csv"}; !reflect.DeepEqual(added, expected) {
+		t.Errorf("expected the reports %v, got %v", expected, added)
+	}
+
+	expected := []apiv1.MeteringReportRecord{
+		{
+			Dimensions: map[string]string{"period": "2026-03-30"},
+			Rows:       3,
+			Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 170},
+		},
+		{
+			Dimensions: map[string]string{"period": "2026-04-06"},
+			Rows:       3,
+			Values:     map[string]float64{"average-cluster-machines": 2, "maximum-cluster-machines": 4, "total-used-cpu-seconds": 170},
+		},
+	}
+	if records := aggregator.records(); !reflect.DeepEqual(records, expected) {
+		t.Errorf("expected %+v, got %+v", expected, records)
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	expected := map[string]string{"env": "prod", "team": "shop"}
+	for _, labels := range []string{"env=prod,team=shop", "env=prod; team=shop", "map[env:prod team:shop]"} {
+		if parsed := parseLabels(labels); !reflect.DeepEqual(parsed, expected) {
+			t.Errorf("expected %q to be parsed to %v, got %v", labels, expected, parsed)
+		}
+	}
+}
+
+func TestDecodeExportMeteringReportReq(t *testing.T) {
+	testCases := []struct {
+		query string
+		valid bool
+	}{
+		{query: "configuration_name=weekly&from=2026-01-01&to=2026-01-31&group_by=project,label:env,month&format=parquet", valid: true},
+		{query: "configuration_name=weekly", valid: true},
+		{query: "from=2026-01-01"},
+		{query: "configuration_name=weekly&from=01.01.2026"},
+		{query: "configuration_name=weekly&from=2026-02-01&to=2026-01-01"},
+		{query: "configuration_name=weekly&group_by=day,month"},
+		{query: "configuration_name=weekly&group_by=label:"},
+		{query: "configuration_name=weekly&format=xlsx"},
+	}
+
+	for _, tc := range testCases {
+		_, err := DecodeExportMeteringReportReq(httptest.NewRequest("GET", "/api/v1/admin/metering/export?"+tc.query, nil))
+		if tc.valid && err != nil {
+			t.Errorf("expected %q to be valid, got %v", tc.query, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected %q to be invalid", tc.query)
+		}
+	}
+}
+
+func TestEncodeReportExport(t *testing.T) {
+	export := &ReportExport{
+		Name:   "weekly",
+		Format: ExportFormatCSV,
+		Aggregation: &apiv1.MeteringReportAggregation{
+			GroupBy: []string{"project"},
+			Records: aggregate(t, []string{"project"}),
+		},
+	}
+
+	recorder := httptest.NewRecorder()
+	if err := EncodeReportExport(recorder, export); err != nil {
+		t.Fatalf("failed to encode the export: %v", err)
+	}
+	expected := `project-id,project-name,rows,average-cluster-machines,maximum-cluster-machines,total-used-cpu-seconds
+p1,web,4,2,4,240
+p2,data,2,2,3,100
+`
+	if recorder.Body.String() != expected {
+		t.Errorf("expected CSV\n%s\ngot\n%s", expected, recorder.Body.String())
+	}
+	if disposition := recorder.Header().Get("Content-disposition"); disposition != "attachment; filename=weekly.csv" {
+		t.Errorf("unexpected content disposition %q", disposition)
+	}
+
+	export.Format = ExportFormatParquet
+	recorder = httptest.NewRecorder()
+	if err := EncodeReportExport(recorder, export); err != nil {
+		t.Fatalf("failed to encode the export: %v", err)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != ParquetContentType {
+		t.Errorf("unexpected content type %q", contentType)
+	}
+
+	file, err := parquet.OpenFile(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+	if err != nil {
+		t.Fatalf("failed to open the Parquet file: %v", err)
+	}
+	columns := map[string]string{}
+	for _, field := range file.Schema().Fields() {
+		if !field.Required() {
+			t.Errorf("expected the column %s to be required", field.Name())
+		}
+		columns[field.Name()] = field.Type().Kind().String()
+	}
+	expectedColumns := map[string]string{
+		"project-id":               "BYTE_ARRAY",
+		"project-name":             "BYTE_ARRAY",
+		"rows":                     "INT64",
+		"average-cluster-machines": "DOUBLE",
+		"maximum-cluster-machines": "DOUBLE",
+		"total-used-cpu-seconds":   "DOUBLE",
+	}
+	if !reflect.DeepEqual(columns, expectedColumns) {
+		t.Errorf("expected the columns %v, got %v", expectedColumns, columns)
+	}
+
+	type exportRow struct {
+		ProjectID              string  `parquet:"project-id"`
+		ProjectName            string  `parquet:"project-name"`
+		Rows                   int64   `parquet:"rows"`
+		AverageClusterMachines float64 `parquet:"average-cluster-machines"`
+		MaximumClusterMachines float64 `parquet:"maximum-cluster-machines"`
+		TotalUsedCPUSeconds    float64 `parquet:"total-used-cpu-seconds"`
+	}
+	rows, err := parquet.Read[exportRow](bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+	if err != nil {
+		t.Fatalf("failed to read the Parquet file: %v", err)
+	}
+	expectedRows := []exportRow{
+		{ProjectID: "p1", ProjectName: "web", Rows: 4, AverageClusterMachines: 2, MaximumClusterMachines: 4, TotalUsedCPUSeconds: 240},
+		{ProjectID: "p2", ProjectName: "data", Rows: 2, AverageClusterMachines: 2, MaximumClusterMachines: 3, TotalUsedCPUSeconds: 100},
+	}
+	if !reflect.DeepEqual(rows, expectedRows) {
+		t.Errorf("expected the rows %+v, got %+v", expectedRows, rows)
+	}
+}
@@ -0,0 +1,386 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/notification"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/workqueue"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AlertThresholdsAnnotation holds the comma separated soft thresholds of a resource quota in percent of the
+	// quota, e.g. "80,95". Resource quotas without the annotation use the DefaultAlertThresholds, an empty value
+	// disables the alerts.
+	AlertThresholdsAnnotation = "dashboard.kubermatic.io/alert-thresholds"
+	// AlertStateAnnotation holds the thresholds per resource the last notifications were delivered for, so that
+	// every crossing of a threshold is notified once.
+	AlertStateAnnotation = "dashboard.kubermatic.io/alert-state"
+)
+
+// DefaultAlertThresholds are the soft thresholds of the resource quotas without their own.
+var DefaultAlertThresholds = []int{80, 95}
+
+// swagger:parameters putResourceQuotaAlertThresholds
+type putResourceQuotaAlertThresholds struct {
+	// in: path
+	// required: true
+	Name string `json:"quota_name"`
+
+	// in: body
+	// required: true
+	Body apiv2.ResourceQuotaAlertThresholds
+}
+
+func DecodePutResourceQuotaAlertThresholdsReq(r *http.Request) (interface{}, error) {
+	var req putResourceQuotaAlertThresholds
+
+	req.Name = mux.Vars(r)["quota_name"]
+	if req.Name == "" {
+		return nil, utilerrors.NewBadRequest("`quota_name` cannot be empty")
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+
+	return req, nil
+}
+
+// PutResourceQuotaAlertThresholds sets the soft thresholds of a resource quota.
+func PutResourceQuotaAlertThresholds(ctx context.Context, request interface{}, provider provider.ResourceQuotaProvider) error {
+	req, ok := request.(putResourceQuotaAlertThresholds)
+	if !ok {
+		return utilerrors.NewBadRequest("invalid request")
+	}
+
+	thresholds, err := normalizeAlertThresholds(req.Body.Thresholds)
+	if err != nil {
+		return utilerrors.NewBadRequest("%v", err)
+	}
+
+	originalResourceQuota, err := provider.GetUnsecured(ctx, req.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return utilerrors.NewNotFound("ResourceQuota", req.Name)
+		}
+		return err
+	}
+	newResourceQuota := originalResourceQuota.DeepCopy()
+	if newResourceQuota.Annotations == nil {
+		newResourceQuota.Annotations = map[string]string{}
+	}
+	newResourceQuota.Annotations[AlertThresholdsAnnotation] = FormatAlertThresholds(thresholds)
+
+	if err := provider.PatchUnsecured(ctx, originalResourceQuota, newResourceQuota); err != nil {
+		if apierrors.IsNotFound(err) {
+			return utilerrors.NewNotFound("ResourceQuota", req.Name)
+		}
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	return nil
+}
+
+// ParseAlertThresholds parses comma separated thresholds, which must be between 1 and 100 percent. The returned
+// thresholds are sorted and unique.
+func ParseAlertThresholds(value string) ([]int, error) {
+	thresholds := []int{}
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		threshold, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold %q", field)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return normalizeAlertThresholds(thresholds)
+}
+
+func normalizeAlertThresholds(thresholds []int) ([]int, error) {
+	normalized := make([]int, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		if threshold < 1 || threshold > 100 {
+			return nil, fmt.Errorf("the threshold %d is not between 1 and 100 percent", threshold)
+		}
+		normalized = append(normalized, threshold)
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// FormatAlertThresholds formats the thresholds for the AlertThresholdsAnnotation.
+func FormatAlertThresholds(thresholds []int) string {
+	fields := make([]string, len(thresholds))
+	for i, threshold := range thresholds {
+		fields[i] = strconv.Itoa(threshold)
+	}
+	return strings.Join(fields, ",")
+}
+
+// alertThresholds returns the thresholds of the resource quota. Invalid annotations, which can only be set
+// bypassing the API, fall back to the defaults.
+func alertThresholds(resourceQuota *kubermaticv1.ResourceQuota) []int {
+	value, ok := resourceQuota.Annotations[AlertThresholdsAnnotation]
+	if !ok {
+		return DefaultAlertThresholds
+	}
+	thresholds, err := ParseAlertThresholds(value)
+	if err != nil {
+		return DefaultAlertThresholds
+	}
+	return thresholds
+}
+
+// evaluateAlerts returns an alert for every resource whose global usage crossed one of the thresholds, with the
+// highest threshold crossed.
+func evaluateAlerts(resourceQuota *kubermaticv1.ResourceQuota, thresholds []int) []apiv2.ResourceQuotaAlert {
+	resources := []struct {
+		name  string
+		quota *resource.Quantity
+		usage *resource.Quantity
+	}{
+		{name: "cpu", quota: resourceQuota.Spec.Quota.CPU, usage: resourceQuota.Status.GlobalUsage.CPU},
+		{name: "memory", quota: resourceQuota.Spec.Quota.Memory, usage: resourceQuota.Status.GlobalUsage.Memory},
+		{name: "storage", quota: resourceQuota.Spec.Quota.Storage, usage: resourceQuota.Status.GlobalUsage.Storage},
+	}
+
+	var alerts []apiv2.ResourceQuotaAlert
+	for _, r := range resources {
+		if r.quota == nil || r.usage == nil || r.quota.Sign() <= 0 {
+			continue
+		}
+		usage := r.usage.AsApproximateFloat64() * 100 / r.quota.AsApproximateFloat64()
+
+		crossed := 0
+		for _, threshold := range thresholds {
+			if usage >= float64(threshold) {
+				crossed = threshold
+			}
+		}
+		if crossed > 0 {
+			alerts = append(alerts, apiv2.ResourceQuotaAlert{
+				Resource:  r.name,
+				Threshold: crossed,
+				Usage:     float64(int(usage*100)) / 100,
+			})
+		}
+	}
+	return alerts
+}
+
+// AlertNotifier notifies about resource quotas whose usage crossed one of their thresholds. It is an event
+// handler of the informer of the resource quotas, so the alerts are evaluated whenever the usage changes. The
+// events only queue the resource quotas, Run evaluates them and retries failed notifications with backoff.
+type AlertNotifier struct {
+	client   ctrlruntimeclient.Client
+	notifier notification.Notifier
+	queue    workqueue.TypedRateLimitingInterface[string]
+	log      *zap.SugaredLogger
+}
+
+func NewAlertNotifier(client ctrlruntimeclient.Client, notifier notification.Notifier, log *zap.SugaredLogger) *AlertNotifier {
+	return &AlertNotifier{
+		client:   client,
+		notifier: notifier,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(workqueue.DefaultTypedControllerRateLimiter[string](), workqueue.TypedRateLimitingQueueConfig[string]{
+			Name: "resource_quota_alerts",
+		}),
+		log: log,
+	}
+}
+
+func (n *AlertNotifier) OnAdd(obj interface{}, _ bool) {
+	n.onEvent(obj)
+}
+
+func (n *AlertNotifier) OnUpdate(_, newObj interface{}) {
+	n.onEvent(newObj)
+}
+
+func (n *AlertNotifier) OnDelete(_ interface{}) {}
+
+func (n *AlertNotifier) onEvent(obj interface{}) {
+	resourceQuota, ok := obj.(*kubermaticv1.ResourceQuota)
+	if !ok {
+		n.log.Warnf("expected ResourceQuota got %T", obj)
+		return
+	}
+
+	// the notifications must not block the informer, multiple events of a resource quota are evaluated once
+	n.queue.Add(resourceQuota.Name)
+}
+
+// Run evaluates the queued resource quotas until the context is cancelled.
+func (n *AlertNotifier) Run(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		n.queue.ShutDown()
+	}()
+
+	for n.processNextItem(ctx) {
+	}
+}
+
+func (n *AlertNotifier) processNextItem(ctx context.Context) bool {
+	name, shutdown := n.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer n.queue.Done(name)
+
+	if err := n.reconcile(ctx, name); err != nil {
+		n.log.Warnw("Failed to notify about the alerts of the resource quota, retrying", "resourcequota", name, zap.Error(err))
+		n.queue.AddRateLimited(name)
+		return true
+	}
+	n.queue.Forget(name)
+	return true
+}
+
+// reconcile evaluates the current resource quota, the queued events can be outdated.
+func (n *AlertNotifier) reconcile(ctx context.Context, name string) error {
+	resourceQuota := &kubermaticv1.ResourceQuota{}
+	if err := n.client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: name}, resourceQuota); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+	return n.evaluate(ctx, resourceQuota)
+}
+
+// evaluate notifies about the thresholds the resource quota crossed since the last notifications. The state is
+// recorded after the notifications were delivered, the ones which failed are notified again when the resource
+// quota is retried. Replicas evaluating the same change at the same time can notify a crossing twice, the
+// optimistic lock only makes sure that one of them records it.
+func (n *AlertNotifier) evaluate(ctx context.Context, resourceQuota *kubermaticv1.ResourceQuota) error {
+	alerts := evaluateAlerts(resourceQuota, alertThresholds(resourceQuota))
+	state := make(map[string]int, len(alerts))
+	for _, alert := range alerts {
+		state[alert.Resource] = alert.Threshold
+	}
+
+	notified := map[string]int{}
+	if value := resourceQuota.Annotations[AlertStateAnnotation]; value != "" {
+		if err := json.Unmarshal([]byte(value), &notified); err != nil {
+			n.log.Warnw("Ignoring the invalid alert state of the resource quota", "resourcequota", resourceQuota.Name, zap.Error(err))
+		}
+	}
+	if maps.Equal(state, notified) {
+		return nil
+	}
+
+	// only crossing a higher threshold is notified, not the usage dropping below one
+	var errs []error
+	for _, alert := range alerts {
+		if alert.Threshold <= notified[alert.Resource] {
+			continue
+		}
+		if err := n.notifier.Notify(ctx, n.message(ctx, resourceQuota, alert)); err != nil {
+			// the previous state is kept, so the crossing is notified again
+			if previous, ok := notified[alert.Resource]; ok {
+				state[alert.Resource] = previous
+			} else {
+				delete(state, alert.Resource)
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	if err := n.recordState(ctx, resourceQuota, state); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// recordState stores the notified thresholds in the resource quota.
+func (n *AlertNotifier) recordState(ctx context.Context, resourceQuota *kubermaticv1.ResourceQuota, state map[string]int) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if resourceQuota.Annotations[AlertStateAnnotation] == string(data) {
+		return nil
+	}
+
+	newResourceQuota := resourceQuota.DeepCopy()
+	if newResourceQuota.Annotations == nil {
+		newResourceQuota.Annotations = map[string]string{}
+	}
+	newResourceQuota.Annotations[AlertStateAnnotation] = string(data)
+	patch := ctrlruntimeclient.MergeFromWithOptions(resourceQuota, ctrlruntimeclient.MergeFromWithOptimisticLock{})
+	if err := n.client.Patch(ctx, newResourceQuota, patch); err != nil {
+		// another replica recorded the state meanwhile
+		if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (n *AlertNotifier) message(ctx context.Context, resourceQuota *kubermaticv1.ResourceQuota, alert apiv2.ResourceQuotaAlert) notification.Message {
+	subject := resourceQuota.Spec.Subject.Name
+	if resourceQuota.Spec.Subject.Kind == kubermaticv1.ProjectSubjectKind {
+		project := &kubermaticv1.Project{}
+		if err := n.client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: resourceQuota.Spec.Subject.Name}, project); err == nil {
+			subject = fmt.Sprintf("%s (%s)", project.Spec.Name, project.Name)
+		}
+	}
+
+	return notification.Message{
+		Subject: fmt.Sprintf("The %s usage of %s %s reached %d%% of its quota", alert.Resource, resourceQuota.Spec.Subject.Kind, subject, alert.Threshold),
+		Text: fmt.Sprintf("The %s usage of %s %s is at %.2f%% of its resource quota %s, crossing the alert threshold of %d%%.",
+			alert.Resource, resourceQuota.Spec.Subject.Kind, subject, alert.Usage, resourceQuota.Name, alert.Threshold),
+		Labels: map[string]string{
+			"resourceQuota": resourceQuota.Name,
+			"subjectKind":   resourceQuota.Spec.Subject.Kind,
+			"subjectName":   resourceQuota.Spec.Subject.Name,
+			"resource":      alert.Resource,
+			"threshold":     strconv.Itoa(alert.Threshold),
+		},
+	}
+}
@@ -0,0 +1,253 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/notification"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type recordingNotifier struct {
+	lock     sync.Mutex
+	messages []notification.Message
+	// err fails the delivery
+	err error
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, message notification.Message) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.err != nil {
+		return n.err
+	}
+	n.messages = append(n.messages, message)
+	return nil
+}
+
+func genAlertResourceQuota(cpuUsage, memoryUsage string) *kubermaticv1.ResourceQuota {
+	cpuQuota, memoryQuota := resource.MustParse("10"), resource.MustParse("10Gi")
+	cpu, memory := resource.MustParse(cpuUsage), resource.MustParse(memoryUsage)
+
+	return &kubermaticv1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "project-my-project"},
+		Spec: kubermaticv1.ResourceQuotaSpec{
+			Subject: kubermaticv1.Subject{Name: "my-project", Kind: kubermaticv1.ProjectSubjectKind},
+			Quota:   kubermaticv1.ResourceDetails{CPU: &cpuQuota, Memory: &memoryQuota},
+		},
+		Status: kubermaticv1.ResourceQuotaStatus{
+			GlobalUsage: kubermaticv1.ResourceDetails{CPU: &cpu, Memory: &memory},
+		},
+	}
+}
+
+func TestParseAlertThresholds(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected []int
+		valid    bool
+	}{
+		{value: "95, 80,80", expected: []int{80, 95}, valid: true},
+		{value: "", expected: []int{}, valid: true},
+		{value: "0"},
+		{value: "101"},
+		{value: "eighty"},
+	}
+
+	for _, tc := range testCases {
+		thresholds, err := ParseAlertThresholds(tc.value)
+		if tc.valid && (err != nil || !reflect.DeepEqual(thresholds, tc.expected)) {
+			t.Errorf("expected %q to be parsed to %v, got %v, %v", tc.value, tc.expected, thresholds, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected %q to be invalid", tc.value)
+		}
+	}
+}
+
+func TestEvaluateAlerts(t *testing.T) {
+	resourceQuota := genAlertResourceQuota("9.6", "8Gi")
+
+	alerts := evaluateAlerts(resourceQuota, alertThresholds(resourceQuota))
+	expected := []apiv2.ResourceQuotaAlert{
+		{Resource: "cpu", Threshold: 95, Usage: 96},
+		{Resource: "memory", Threshold: 80, Usage: 80},
+	}
+	if !reflect.DeepEqual(alerts, expected) {
+		t.Errorf("expected the alerts %+v, got %+v", expected, alerts)
+	}
+
+	resourceQuota.Annotations = map[string]string{AlertThresholdsAnnotation: ""}
+	if alerts := evaluateAlerts(resourceQuota, alertThresholds(resourceQuota)); len(alerts) != 0 {
+		t.Errorf("expected no alerts with disabled thresholds, got %+v", alerts)
+	}
+}
+
+func TestAlertNotifier(t *testing.T) {
+	ctx := context.Background()
+	resourceQuota := genAlertResourceQuota("5", "1Gi")
+	project := &kubermaticv1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project"},
+		Spec:       kubermaticv1.ProjectSpec{Name: "Shop"},
+	}
+	client := fake.NewClientBuilder().WithObjects(resourceQuota, project).Build()
+	notifier := &recordingNotifier{}
+	alertNotifier := NewAlertNotifier(client, notifier, zap.NewNop().Sugar())
+
+	steps := []struct {
+		name                string
+		cpuUsage            string
+		memoryUsage         string
+		expectedSubjects    []string
+		expectedAlertStates string
+	}{
+		{
+			name:                "below the thresholds",
+			cpuUsage:            "5",
+			memoryUsage:         "1Gi",
+			expectedAlertStates: "",
+		},
+		{
+			name:                "crossing the first threshold",
+			cpuUsage:            "8",
+			memoryUsage:         "1Gi",
+			expectedSubjects:    []string{"The cpu usage of project Shop (my-project) reached 80% of its quota"},
+			expectedAlertStates: `{"cpu":80}`,
+		},
+		{
+			name:                "usage changing within the threshold",
+			cpuUsage:            "8.5",
+			memoryUsage:         "1Gi",
+			expectedAlertStates: `{"cpu":80}`,
+		},
+		{
+			name:        "crossing more thresholds",
+			cpuUsage:    "9.5",
+			memoryUsage: "9Gi",
+			expectedSubjects: []string{
+				"The cpu usage of project Shop (my-project) reached 95% of its quota",
+				"The memory usage of project Shop (my-project) reached 80% of its quota",
+			},
+			expectedAlertStates: `{"cpu":95,"memory":80}`,
+		},
+		{
+			name:                "usage dropping below the thresholds",
+			cpuUsage:            "1",
+			memoryUsage:         "1Gi",
+			expectedAlertStates: `{}`,
+		},
+		{
+			name:                "crossing a threshold again",
+			cpuUsage:            "8",
+			memoryUsage:         "1Gi",
+			expectedSubjects:    []string{"The cpu usage of project Shop (my-project) reached 80% of its quota"},
+			expectedAlertStates: `{"cpu":80}`,
+		},
+	}
+
+	for _, step := range steps {
+		current := &kubermaticv1.ResourceQuota{}
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(resourceQuota), current); err != nil {
+			t.Fatalf("%s: failed to get the resource quota: %v", step.name, err)
+		}
+		cpu, memory := resource.MustParse(step.cpuUsage), resource.MustParse(step.memoryUsage)
+		current.Status.GlobalUsage.CPU = &cpu
+		current.Status.GlobalUsage.Memory = &memory
+
+		notifier.messages = nil
+		if err := alertNotifier.evaluate(ctx, current); err != nil {
+			t.Fatalf("%s: failed to evaluate the alerts: %v", step.name, err)
+		}
+
+		var subjects []string
+		for _, message := range notifier.messages {
+			subjects = append(subjects, message.Subject)
+		}
+		if !reflect.DeepEqual(subjects, step.expectedSubjects) {
+			t.Errorf("%s: expected the notifications %v, got %v", step.name, step.expectedSubjects, subjects)
+		}
+
+		updated := &kubermaticv1.ResourceQuota{}
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(resourceQuota), updated); err != nil {
+			t.Fatalf("%s: failed to get the resource quota: %v", step.name, err)
+		}
+		if state := updated.Annotations[AlertStateAnnotation]; state != step.expectedAlertStates {
+			t.Errorf("%s: expected the alert state %q, got %q", step.name, step.expectedAlertStates, state)
+		}
+	}
+}
+
+func TestAlertNotifierRetry(t *testing.T) {
+	ctx := context.Background()
+	resourceQuota := genAlertResourceQuota("9", "1Gi")
+	client := fake.NewClientBuilder().WithObjects(resourceQuota).Build()
+	notifier := &recordingNotifier{err: errors.New("relay unavailable")}
+	alertNotifier := NewAlertNotifier(client, notifier, zap.NewNop().Sugar())
+
+	current := &kubermaticv1.ResourceQuota{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(resourceQuota), current); err != nil {
+		t.Fatalf("failed to get the resource quota: %v", err)
+	}
+	if err := alertNotifier.evaluate(ctx, current); err == nil {
+		t.Fatal("expected the failed notification to be returned, so that it is retried")
+	}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(resourceQuota), current); err != nil {
+		t.Fatalf("failed to get the resource quota: %v", err)
+	}
+	if state, ok := current.Annotations[AlertStateAnnotation]; ok && state != "{}" {
+		t.Errorf("expected the failed notification not to be recorded, got %q", state)
+	}
+
+	// the retry delivers the notification and records it
+	notifier.err = nil
+	if err := alertNotifier.reconcile(ctx, resourceQuota.Name); err != nil {
+		t.Fatalf("failed to evaluate the alerts: %v", err)
+	}
+	if err := alertNotifier.reconcile(ctx, resourceQuota.Name); err != nil {
+		t.Fatalf("failed to evaluate the alerts: %v", err)
+	}
+	if len(notifier.messages) != 1 {
+		t.Errorf("expected a single delivered notification, got %d", len(notifier.messages))
+	}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(resourceQuota), current); err != nil {
+		t.Fatalf("failed to get the resource quota: %v", err)
+	}
+	if state := current.Annotations[AlertStateAnnotation]; state != `{"cpu":80}` {
+		t.Errorf("expected the delivered notification to be recorded, got %q", state)
+	}
+}
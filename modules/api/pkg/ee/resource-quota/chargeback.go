@@ -0,0 +1,108 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/chargeback"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+type chargebackGroup struct {
+	attributes map[string]string
+	projects   []string
+	quotas     kubermaticv1.ResourceQuotaList
+}
+
+// GetChargebackBreakdown sums up the resource quotas and their global usage of the projects with the same values
+// of the chargeback attributes.
+func GetChargebackBreakdown(ctx context.Context, attributes []string, quotaProvider provider.ResourceQuotaProvider,
+	projectProvider provider.ProjectProvider) (*apiv2.ChargebackQuotaBreakdown, error) {
+	resourceQuotaList, err := quotaProvider.ListUnsecured(ctx, map[string]string{kubermaticv1.ResourceQuotaSubjectKindLabelKey: kubermaticv1.ProjectSubjectKind})
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := projectProvider.List(ctx, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	projectMap := make(map[string]*kubermaticv1.Project, len(projects))
+	for _, project := range projects {
+		projectMap[project.Name] = project
+	}
+
+	groups := map[string]*chargebackGroup{}
+	for _, rq := range resourceQuotaList.Items {
+		var labels map[string]string
+		if project, ok := projectMap[rq.Spec.Subject.Name]; ok {
+			labels = project.Labels
+		}
+		values := chargeback.Values(attributes, labels)
+
+		keyParts := make([]string, len(attributes))
+		for i, attribute := range attributes {
+			keyParts[i] = values[attribute]
+		}
+		key := strings.Join(keyParts, "\x00")
+
+		group, ok := groups[key]
+		if !ok {
+			group = &chargebackGroup{attributes: values}
+			groups[key] = group
+		}
+		group.projects = append(group.projects, rq.Spec.Subject.Name)
+		group.quotas.Items = append(group.quotas.Items, rq)
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	breakdown := &apiv2.ChargebackQuotaBreakdown{
+		Attributes: attributes,
+		Groups:     make([]apiv2.ChargebackQuotaGroup, 0, len(keys)),
+	}
+	for _, key := range keys {
+		group := groups[key]
+		sort.Strings(group.projects)
+		total := accumulateQuotas(&group.quotas)
+		breakdown.Groups = append(breakdown.Groups, apiv2.ChargebackQuotaGroup{
+			Attributes:  group.attributes,
+			Projects:    group.projects,
+			Quota:       total.Quota,
+			GlobalUsage: total.Status.GlobalUsage,
+		})
+	}
+	return breakdown, nil
+}
@@ -1006,6 +1006,9 @@ func convertToAPIStruct(resourceQuota *kubermaticv1.ResourceQuota, humanReadable
 		rq.IsDefault = true
 	}
 
+	rq.AlertThresholds = alertThresholds(resourceQuota)
+	rq.Alerts = evaluateAlerts(resourceQuota, rq.AlertThresholds)
+
 	return rq
 }
 
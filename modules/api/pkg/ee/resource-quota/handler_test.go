@@ -361,6 +361,13 @@ func TestHandlerResourceQuotas(t *testing.T) {
 				if resourceQuota.SubjectHumanReadableName != expectedHumanReadableName {
 					return fmt.Errorf("expected name %s, got %s", expectedHumanReadableName, resourceQuota.Name)
 				}
+				expectedAlerts := []apiv2.ResourceQuotaAlert{{Resource: "storage", Threshold: 80, Usage: 80}}
+				if !diff.DeepEqual(expectedAlerts, resourceQuota.Alerts) {
+					return fmt.Errorf("alerts differ:\n%v", diff.ObjectDiff(expectedAlerts, resourceQuota.Alerts))
+				}
+				if !diff.DeepEqual(resourcequota.DefaultAlertThresholds, resourceQuota.AlertThresholds) {
+					return fmt.Errorf("expected the default alert thresholds, got %v", resourceQuota.AlertThresholds)
+				}
 				return nil
 			},
 		},
@@ -502,6 +509,76 @@ func TestPutResourceQuotaAcceleratorCompatibility(t *testing.T) {
 	}
 }
 
+func TestPutResourceQuotaAlertThresholds(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		body               string
+		expectedStatus     int
+		expectedAnnotation string
+	}{
+		{
+			name:               "thresholds are sorted and deduplicated",
+			body:               `{"thresholds": [95, 50, 95]}`,
+			expectedStatus:     http.StatusOK,
+			expectedAnnotation: "50,95",
+		},
+		{
+			name:               "no thresholds disable the alerts",
+			body:               `{"thresholds": []}`,
+			expectedStatus:     http.StatusOK,
+			expectedAnnotation: "",
+		},
+		{
+			name:           "thresholds above 100 percent are rejected",
+			body:           `{"thresholds": [80, 120]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceQuota := genDefaultResourceQuota()
+			admin := test.GenAdminUser("John", "john@acme.com", true)
+
+			router, clients, err := test.CreateTestEndpointAndGetClients(
+				*test.GenAPIUser("John", "john@acme.com"),
+				nil,
+				nil,
+				nil,
+				test.GenDefaultKubermaticObjects(resourceQuota, admin),
+				nil,
+				hack.NewTestRouting,
+			)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v2/quotas/"+resourceQuota.Name+"/alertthresholds", strings.NewReader(tc.body))
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, req)
+			if resp.Code != tc.expectedStatus {
+				t.Fatalf("expected HTTP status %d, got %d: %s", tc.expectedStatus, resp.Code, resp.Body.String())
+			}
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			updated := &kubermaticv1.ResourceQuota{}
+			if err := clients.FakeMasterClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: resourceQuota.Name}, updated); err != nil {
+				t.Fatalf("failed to get updated ResourceQuota: %v", err)
+			}
+			annotation, ok := updated.Annotations[resourcequota.AlertThresholdsAnnotation]
+			if !ok || annotation != tc.expectedAnnotation {
+				t.Fatalf("expected the alert thresholds %q, got %q", tc.expectedAnnotation, annotation)
+			}
+		})
+	}
+}
+
 func TestPutResourceQuotaAcceleratorAccounting(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,395 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc"
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/provider"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+// FederatedIssuersAnnotation is the annotation of the KubermaticConfiguration with the
+// list of trusted issuers as YAML, see FederatedIssuer.
+const FederatedIssuersAnnotation = "dashboard.kubermatic.io/oidc-issuers"
+
+var _ authtypes.TokenVerifier = &FederatedVerifier{}
+
+// FederatedIssuer is an issuer of ID tokens which is trusted in addition to the
+// issuer configured with --oidc-url, e.g. a partner IdP or the OIDC provider of a CI system.
+// Every issuer must restrict its tokens with AllowedEmailDomains or RequiredClaims, and
+// can never authenticate users of the email domains owned by the primary issuer.
+type FederatedIssuer struct {
+	// Name identifies the issuer in errors and logs.
+	Name string `json:"name"`
+	// IssuerURL must match the iss claim of the tokens.
+	IssuerURL string `json:"issuerURL"`
+	// Audience must be contained in the aud claim of the tokens.
+	Audience string `json:"audience"`
+	// EmailClaim is the claim with the email of the user, defaults to "email".
+	// If the email claim is used, the tokens must also have a true email_verified claim.
+	EmailClaim string `json:"emailClaim,omitempty"`
+	// EmailDomain is appended to values of the email claim without a domain,
+	// e.g. to map the actor claim of CI tokens to the email of a dedicated user.
+	// As the issuer does not vouch for this domain, RequiredClaims must be set as well.
+	EmailDomain string `json:"emailDomain,omitempty"`
+	// GroupsClaim is the claim with the groups of the user, defaults to "groups".
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// GroupsPrefix is prepended to the groups, so that the groups of different issuers
+	// cannot clash, defaults to "<name>:".
+	GroupsPrefix string `json:"groupsPrefix,omitempty"`
+	// AllowedEmailDomains restricts the users to these email domains.
+	AllowedEmailDomains []string `json:"allowedEmailDomains,omitempty"`
+	// RequiredClaims are claims the tokens must have with exactly these values, e.g. the
+	// repository_owner of GitHub Actions tokens, whose audience is chosen by the caller.
+	RequiredClaims map[string]string `json:"requiredClaims,omitempty"`
+	// SkipTLSVerify skips the TLS verification of the issuer.
+	SkipTLSVerify bool `json:"skipTLSVerify,omitempty"`
+}
+
+// ParseFederatedIssuers parses and validates the list of trusted issuers of the annotation.
+// The primary domains are the email domains owned by the primary issuer, the trusted
+// issuers must not authenticate users of them.
+func ParseFederatedIssuers(raw string, primaryDomains []string) ([]FederatedIssuer, error) {
+	issuers := []FederatedIssuer{}
+	if err := yaml.UnmarshalStrict([]byte(raw), &issuers); err != nil {
+		return nil, fmt.Errorf("failed to parse the issuers: %w", err)
+	}
+	if len(issuers) > 0 && len(primaryDomains) == 0 {
+		return nil, errors.New("the email domains of the primary issuer must be configured to trust additional issuers")
+	}
+
+	names := sets.New[string]()
+	urls := sets.New[string]()
+	for i, issuer := range issuers {
+		switch {
+		case issuer.Name == "":
+			return nil, fmt.Errorf("issuer %d has no name", i)
+		case issuer.IssuerURL == "":
+			return nil, fmt.Errorf("issuer %q has no issuerURL", issuer.Name)
+		case issuer.Audience == "":
+			return nil, fmt.Errorf("issuer %q has no audience", issuer.Name)
+		case len(issuer.AllowedEmailDomains) == 0 && len(issuer.RequiredClaims) == 0:
+			return nil, fmt.Errorf("issuer %q must restrict its tokens with allowedEmailDomains or requiredClaims", issuer.Name)
+		case issuer.EmailDomain != "" && len(issuer.RequiredClaims) == 0:
+			return nil, fmt.Errorf("issuer %q appends an emailDomain and must restrict its tokens with requiredClaims", issuer.Name)
+		case names.Has(issuer.Name):
+			return nil, fmt.Errorf("issuer name %q is used more than once", issuer.Name)
+		case urls.Has(issuer.IssuerURL):
+			return nil, fmt.Errorf("issuer URL %q is used more than once", issuer.IssuerURL)
+		}
+		for _, domain := range append([]string{issuer.EmailDomain}, issuer.AllowedEmailDomains...) {
+			if domain != "" && inDomains(domain, primaryDomains) {
+				return nil, fmt.Errorf("issuer %q must not authenticate users of the domain %q of the primary issuer", issuer.Name, domain)
+			}
+		}
+		names.Insert(issuer.Name)
+		urls.Insert(issuer.IssuerURL)
+	}
+	return issuers, nil
+}
+
+// claims maps the claims of a verified token to TokenClaims according to the claim
+// mapping of the issuer, and enforces the required claims and the email domains.
+func (i FederatedIssuer) claims(raw map[string]interface{}, primaryDomains []string) (authtypes.TokenClaims, error) {
+	for claim, expected := range i.RequiredClaims {
+		if value, _ := raw[claim].(string); value != expected {
+			return authtypes.TokenClaims{}, fmt.Errorf("the token of issuer %q does not have the required %q claim", i.Name, claim)
+		}
+	}
+
+	claims := authtypes.TokenClaims{}
+	if name, ok := raw["name"].(string); ok {
+		claims.Name = name
+	}
+	if subject, ok := raw["sub"].(string); ok {
+		claims.Subject = subject
+	}
+	if exp, ok := raw["exp"].(float64); ok {
+		secs := int64(exp)
+		nsecs := int64((exp - float64(secs)) * 1e9)
+		claims.Expiry = apiv1.NewTime(time.Unix(secs, nsecs))
+	}
+
+	emailClaim := i.EmailClaim
+	if emailClaim == "" {
+		emailClaim = "email"
+	}
+	email, _ := raw[emailClaim].(string)
+	if email == "" {
+		return authtypes.TokenClaims{}, fmt.Errorf("the token of issuer %q has no %q claim", i.Name, emailClaim)
+	}
+	if emailClaim == "email" && !emailVerified(raw["email_verified"]) {
+		return authtypes.TokenClaims{}, fmt.Errorf("the email of the token of issuer %q is not verified", i.Name)
+	}
+	if !strings.Contains(email, "@") && i.EmailDomain != "" {
+		email = email + "@" + i.EmailDomain
+	}
+	_, domain, found := strings.Cut(email, "@")
+	if !found {
+		return authtypes.TokenClaims{}, fmt.Errorf("the email %q of the token of issuer %q has no domain", email, i.Name)
+	}
+	if inDomains(domain, primaryDomains) {
+		return authtypes.TokenClaims{}, fmt.Errorf("the email %q belongs to the primary issuer and is not allowed by issuer %q", email, i.Name)
+	}
+	if len(i.AllowedEmailDomains) > 0 && !slices.ContainsFunc(i.AllowedEmailDomains, func(allowed string) bool { return strings.EqualFold(domain, allowed) }) {
+		return authtypes.TokenClaims{}, fmt.Errorf("the email %q is not allowed by issuer %q", email, i.Name)
+	}
+	claims.Email = email
+
+	groupsClaim := i.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+	groupsPrefix := i.GroupsPrefix
+	if groupsPrefix == "" {
+		groupsPrefix = i.Name + ":"
+	}
+	switch groups := raw[groupsClaim].(type) {
+	case string:
+		claims.Groups = []string{groupsPrefix + groups}
+	case []interface{}:
+		for _, rawGroup := range groups {
+			if group, ok := rawGroup.(string); ok {
+				claims.Groups = append(claims.Groups, groupsPrefix+group)
+			}
+		}
+	}
+
+	return claims, nil
+}
+
+// emailVerified returns true for the email_verified claim of a verified email, some
+// issuers send it as string.
+func emailVerified(claim interface{}) bool {
+	switch verified := claim.(type) {
+	case bool:
+		return verified
+	case string:
+		return verified == "true"
+	}
+	return false
+}
+
+// inDomains returns true if the domain is one of the domains or a subdomain of them.
+func inDomains(domain string, domains []string) bool {
+	domain = strings.ToLower(domain)
+	for _, d := range domains {
+		d = strings.ToLower(d)
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// federatedIssuerVerifier is a trusted issuer with the verifier of its tokens.
+type federatedIssuerVerifier struct {
+	issuer   FederatedIssuer
+	verifier *oidc.IDTokenVerifier
+}
+
+// FederatedVerifier verifies the tokens of the trusted issuers configured in the
+// KubermaticConfiguration. The issuers are reloaded when the configuration changes,
+// so that they can be changed without restarting the API.
+type FederatedVerifier struct {
+	configGetter     provider.KubermaticConfigurationGetter
+	rootCertificates *x509.CertPool
+	// primaryDomains are the email domains owned by the primary issuer.
+	primaryDomains []string
+	// newVerifier discovers the keys of an issuer, it is replaced in tests.
+	newVerifier func(ctx context.Context, issuer FederatedIssuer, rootCertificates *x509.CertPool) (*oidc.IDTokenVerifier, error)
+
+	lock sync.RWMutex
+	// raw is the annotation the issuers were loaded from.
+	raw string
+	// issuers are the trusted issuers by issuer URL.
+	issuers map[string]federatedIssuerVerifier
+}
+
+// NewFederatedVerifier returns a verifier for the tokens of the trusted issuers, which must
+// not authenticate users of the primary domains. If rootCertificates is nil, the host's
+// root CAs will be used.
+func NewFederatedVerifier(configGetter provider.KubermaticConfigurationGetter, rootCertificates *x509.CertPool, primaryDomains []string) *FederatedVerifier {
+	return &FederatedVerifier{
+		configGetter:     configGetter,
+		rootCertificates: rootCertificates,
+		primaryDomains:   primaryDomains,
+		newVerifier:      newFederatedIssuerVerifier,
+		issuers:          map[string]federatedIssuerVerifier{},
+	}
+}
+
+// Start loads the trusted issuers and reloads them in the interval until the context is cancelled.
+func (v *FederatedVerifier) Start(ctx context.Context, interval time.Duration, log *zap.SugaredLogger) {
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := v.Reload(ctx); err != nil {
+			log.Warnw("Failed to reload the trusted OIDC issuers", zap.Error(err))
+		}
+	}, interval)
+}
+
+// Reload loads the trusted issuers from the KubermaticConfiguration if they changed.
+// Issuers whose keys cannot be discovered keep their previous verifier, so that a
+// temporary outage of an issuer does not lock its users out.
+func (v *FederatedVerifier) Reload(ctx context.Context) error {
+	config, err := v.configGetter(ctx)
+	if err != nil {
+		return err
+	}
+	raw := config.Annotations[FederatedIssuersAnnotation]
+
+	v.lock.RLock()
+	unchanged := raw == v.raw
+	previous := v.issuers
+	v.lock.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	issuers, err := ParseFederatedIssuers(raw, v.primaryDomains)
+	if err != nil {
+		return err
+	}
+
+	loaded := map[string]federatedIssuerVerifier{}
+	var errs []error
+	for _, issuer := range issuers {
+		verifier, err := v.newVerifier(ctx, issuer, v.rootCertificates)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("issuer %q: %w", issuer.Name, err))
+			if old, ok := previous[issuer.IssuerURL]; ok && reflect.DeepEqual(old.issuer, issuer) {
+				loaded[issuer.IssuerURL] = old
+			}
+			continue
+		}
+		loaded[issuer.IssuerURL] = federatedIssuerVerifier{issuer: issuer, verifier: verifier}
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.issuers = loaded
+	// the issuers which failed are retried with the next reload
+	if len(errs) == 0 {
+		v.raw = raw
+	}
+	return errors.Join(errs...)
+}
+
+// Verify verifies a token of a trusted issuer and maps its claims.
+func (v *FederatedVerifier) Verify(ctx context.Context, token string) (authtypes.TokenClaims, error) {
+	issuerURL, err := unverifiedIssuer(token)
+	if err != nil {
+		return authtypes.TokenClaims{}, err
+	}
+
+	v.lock.RLock()
+	issuer, ok := v.issuers[issuerURL]
+	v.lock.RUnlock()
+	if !ok {
+		return authtypes.TokenClaims{}, fmt.Errorf("the issuer %q is not trusted", issuerURL)
+	}
+
+	idToken, err := issuer.verifier.Verify(ctx, token)
+	if err != nil {
+		if strings.Contains(err.Error(), "oidc: token is expired") {
+			return authtypes.TokenClaims{}, &TokenExpiredError{msg: err.Error()}
+		}
+		return authtypes.TokenClaims{}, err
+	}
+
+	raw := map[string]interface{}{}
+	if err := idToken.Claims(&raw); err != nil {
+		return authtypes.TokenClaims{}, err
+	}
+	return issuer.issuer.claims(raw, v.primaryDomains)
+}
+
+// unverifiedIssuer returns the iss claim of a JWT without verifying it, to pick the verifier.
+func unverifiedIssuer(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("the token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the payload of the token: %w", err)
+	}
+
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("failed to parse the payload of the token: %w", err)
+	}
+	if claims.Issuer == "" {
+		return "", errors.New("the token has no iss claim")
+	}
+	return claims.Issuer, nil
+}
+
+func newFederatedIssuerVerifier(ctx context.Context, issuer FederatedIssuer, rootCertificates *x509.CertPool) (*oidc.IDTokenVerifier, error) {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				RootCAs:            rootCertificates,
+				InsecureSkipVerify: issuer.SkipTLSVerify,
+			},
+		},
+	}
+
+	// the discovery is bound to the reload, while the key set keeps fetching the keys when they are rotated
+	p, err := oidc.NewProvider(oidc.ClientContext(ctx, client), issuer.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+	var discovery struct {
+		JWKSURL    string   `json:"jwks_uri"`
+		Algorithms []string `json:"id_token_signing_alg_values_supported"`
+	}
+	if err := p.Claims(&discovery); err != nil {
+		return nil, err
+	}
+
+	keySet := oidc.NewRemoteKeySet(oidc.ClientContext(context.Background(), client), discovery.JWKSURL)
+	return oidc.NewVerifier(issuer.IssuerURL, keySet, &oidc.Config{
+		ClientID:             issuer.Audience,
+		SupportedSigningAlgs: discovery.Algorithms,
+	}), nil
+}
@@ -0,0 +1,390 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc"
+	"github.com/go-jose/go-jose/v4"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var testPrimaryDomains = []string{"example.com"}
+
+func TestParseFederatedIssuers(t *testing.T) {
+	testCases := []struct {
+		name           string
+		raw            string
+		primaryDomains []string
+		expected       []FederatedIssuer
+		expectedError  string
+	}{
+		{
+			name:     "no issuers",
+			raw:      "",
+			expected: []FederatedIssuer{},
+		},
+		{
+			name: "valid issuers",
+			raw: `
+- name: partner
+  issuerURL: https://idp.partner.com
+  audience: kubermatic
+  allowedEmailDomains: [partner.com]
+- name: github-actions
+  issuerURL: https://token.actions.githubusercontent.com
+  audience: kubermatic
+  emailClaim: actor
+  emailDomain: ci.partner.com
+  requiredClaims:
+    repository_owner: acme
+`,
+			primaryDomains: testPrimaryDomains,
+			expected: []FederatedIssuer{
+				{Name: "partner", IssuerURL: "https://idp.partner.com", Audience: "kubermatic", AllowedEmailDomains: []string{"partner.com"}},
+				{Name: "github-actions", IssuerURL: "https://token.actions.githubusercontent.com", Audience: "kubermatic", EmailClaim: "actor", EmailDomain: "ci.partner.com", RequiredClaims: map[string]string{"repository_owner": "acme"}},
+			},
+		},
+		{
+			name:           "unknown field",
+			raw:            `[{"name": "partner", "issuer": "https://idp.partner.com", "audience": "kubermatic", "allowedEmailDomains": ["partner.com"]}]`,
+			primaryDomains: testPrimaryDomains,
+			expectedError:  `unknown field "issuer"`,
+		},
+		{
+			name:           "missing audience",
+			raw:            `[{"name": "partner", "issuerURL": "https://idp.partner.com", "allowedEmailDomains": ["partner.com"]}]`,
+			primaryDomains: testPrimaryDomains,
+			expectedError:  `issuer "partner" has no audience`,
+		},
+		{
+			name:           "duplicate issuer URL",
+			raw:            `[{"name": "a", "issuerURL": "https://idp", "audience": "x", "allowedEmailDomains": ["a.com"]}, {"name": "b", "issuerURL": "https://idp", "audience": "y", "allowedEmailDomains": ["b.com"]}]`,
+			primaryDomains: testPrimaryDomains,
+			expectedError:  `issuer URL "https://idp" is used more than once`,
+		},
+		{
+			name:          "primary domains not configured",
+			raw:           `[{"name": "partner", "issuerURL": "https://idp.partner.com", "audience": "kubermatic", "allowedEmailDomains": ["partner.com"]}]`,
+			expectedError: "the email domains of the primary issuer must be configured to trust additional issuers",
+		},
+		{
+			name:           "unrestricted issuer",
+			raw:            `[{"name": "partner", "issuerURL": "https://idp.partner.com", "audience": "kubermatic"}]`,
+			primaryDomains: testPrimaryDomains,
+			expectedError:  `issuer "partner" must restrict its tokens with allowedEmailDomains or requiredClaims`,
+		},
+		{
+			name:           "email domain without required claims",
+			raw:            `[{"name": "github-actions", "issuerURL": "https://token.actions.githubusercontent.com", "audience": "kubermatic", "emailClaim": "actor", "emailDomain": "ci.partner.com", "allowedEmailDomains": ["ci.partner.com"]}]`,
+			primaryDomains: testPrimaryDomains,
+			expectedError:  `issuer "github-actions" appends an emailDomain and must restrict its tokens with requiredClaims`,
+		},
+		{
+			name:           "allowed domain of the primary issuer",
+			raw:            `[{"name": "partner", "issuerURL": "https://idp.partner.com", "audience": "kubermatic", "allowedEmailDomains": ["sub.example.com"]}]`,
+			primaryDomains: testPrimaryDomains,
+			expectedError:  `issuer "partner" must not authenticate users of the domain "sub.example.com" of the primary issuer`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			issuers, err := ParseFederatedIssuers(tc.raw, tc.primaryDomains)
+			if tc.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(issuers, tc.expected) {
+				t.Fatalf("expected %+v, got %+v", tc.expected, issuers)
+			}
+		})
+	}
+}
+
+func TestFederatedIssuerClaims(t *testing.T) {
+	partner := FederatedIssuer{Name: "partner", AllowedEmailDomains: []string{"partner.com"}}
+	githubActions := FederatedIssuer{Name: "github-actions", EmailClaim: "actor", EmailDomain: "ci.partner.com", GroupsClaim: "repository", RequiredClaims: map[string]string{"repository_owner": "acme"}}
+
+	testCases := []struct {
+		name           string
+		issuer         FederatedIssuer
+		raw            map[string]interface{}
+		expectedEmail  string
+		expectedGroups []string
+		expectedError  string
+	}{
+		{
+			name:           "default claims",
+			issuer:         partner,
+			raw:            map[string]interface{}{"sub": "1", "email": "jane@partner.com", "email_verified": true, "groups": []interface{}{"admins", "devs"}},
+			expectedEmail:  "jane@partner.com",
+			expectedGroups: []string{"partner:admins", "partner:devs"},
+		},
+		{
+			name:           "custom groups prefix",
+			issuer:         FederatedIssuer{Name: "partner", AllowedEmailDomains: []string{"partner.com"}, GroupsPrefix: "ext-"},
+			raw:            map[string]interface{}{"sub": "1", "email": "jane@Partner.com", "email_verified": "true", "groups": "admins"},
+			expectedEmail:  "jane@Partner.com",
+			expectedGroups: []string{"ext-admins"},
+		},
+		{
+			name:           "mapped claims",
+			issuer:         githubActions,
+			raw:            map[string]interface{}{"sub": "repo:acme/app", "actor": "deploy-bot", "repository": "acme/app", "repository_owner": "acme"},
+			expectedEmail:  "deploy-bot@ci.partner.com",
+			expectedGroups: []string{"github-actions:acme/app"},
+		},
+		{
+			name:          "required claim mismatch",
+			issuer:        githubActions,
+			raw:           map[string]interface{}{"sub": "repo:evil/app", "actor": "deploy-bot", "repository_owner": "evil"},
+			expectedError: `the token of issuer "github-actions" does not have the required "repository_owner" claim`,
+		},
+		{
+			name:          "required claim missing",
+			issuer:        githubActions,
+			raw:           map[string]interface{}{"sub": "repo:evil/app", "actor": "deploy-bot"},
+			expectedError: `the token of issuer "github-actions" does not have the required "repository_owner" claim`,
+		},
+		{
+			name:          "email not verified",
+			issuer:        partner,
+			raw:           map[string]interface{}{"sub": "1", "email": "jane@partner.com", "email_verified": false},
+			expectedError: `the email of the token of issuer "partner" is not verified`,
+		},
+		{
+			name:          "email_verified missing",
+			issuer:        partner,
+			raw:           map[string]interface{}{"sub": "1", "email": "jane@partner.com"},
+			expectedError: `the email of the token of issuer "partner" is not verified`,
+		},
+		{
+			name:          "domain not allowed",
+			issuer:        partner,
+			raw:           map[string]interface{}{"sub": "1", "email": "jane@other.com", "email_verified": true},
+			expectedError: `the email "jane@other.com" is not allowed by issuer "partner"`,
+		},
+		{
+			name:          "subdomain not allowed",
+			issuer:        partner,
+			raw:           map[string]interface{}{"sub": "1", "email": "jane@evil.partner.com", "email_verified": true},
+			expectedError: `the email "jane@evil.partner.com" is not allowed by issuer "partner"`,
+		},
+		{
+			name:          "domain of the primary issuer",
+			issuer:        FederatedIssuer{Name: "partner", RequiredClaims: map[string]string{"tenant": "partner"}},
+			raw:           map[string]interface{}{"sub": "1", "email": "admin@example.com", "email_verified": true, "tenant": "partner"},
+			expectedError: `the email "admin@example.com" belongs to the primary issuer and is not allowed by issuer "partner"`,
+		},
+		{
+			name:          "subdomain of the primary issuer",
+			issuer:        FederatedIssuer{Name: "partner", RequiredClaims: map[string]string{"tenant": "partner"}},
+			raw:           map[string]interface{}{"sub": "1", "email": "admin@EU.Example.com", "email_verified": true, "tenant": "partner"},
+			expectedError: `the email "admin@EU.Example.com" belongs to the primary issuer and is not allowed by issuer "partner"`,
+		},
+		{
+			name:          "missing email claim",
+			issuer:        githubActions,
+			raw:           map[string]interface{}{"sub": "1", "email": "jane@partner.com", "repository_owner": "acme"},
+			expectedError: `the token of issuer "github-actions" has no "actor" claim`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := tc.issuer.claims(tc.raw, testPrimaryDomains)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claims.Email != tc.expectedEmail {
+				t.Errorf("expected email %q, got %q", tc.expectedEmail, claims.Email)
+			}
+			if !equality.Semantic.DeepEqual(claims.Groups, tc.expectedGroups) {
+				t.Errorf("expected groups %v, got %v", tc.expectedGroups, claims.Groups)
+			}
+		})
+	}
+}
+
+func TestUnverifiedIssuer(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://idp.partner.com","sub":"1"}`))
+
+	issuer, err := unverifiedIssuer("header." + payload + ".signature")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issuer != "https://idp.partner.com" {
+		t.Fatalf("expected the issuer %q, got %q", "https://idp.partner.com", issuer)
+	}
+
+	if _, err := unverifiedIssuer("not-a-jwt"); err == nil {
+		t.Fatal("expected an error for a token which is not a JWT")
+	}
+}
+
+func TestFederatedVerifierReload(t *testing.T) {
+	config := &kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				FederatedIssuersAnnotation: `[{"name": "partner", "issuerURL": "https://idp.partner.com", "audience": "kubermatic", "allowedEmailDomains": ["partner.com"]}]`,
+			},
+		},
+	}
+	v := NewFederatedVerifier(func(context.Context) (*kubermaticv1.KubermaticConfiguration, error) { return config, nil }, nil, testPrimaryDomains)
+
+	discoveries := 0
+	unavailable := false
+	v.newVerifier = func(_ context.Context, _ FederatedIssuer, _ *x509.CertPool) (*oidc.IDTokenVerifier, error) {
+		discoveries++
+		if unavailable {
+			return nil, errors.New("connection refused")
+		}
+		return &oidc.IDTokenVerifier{}, nil
+	}
+
+	if err := v.Reload(context.Background()); err != nil {
+		t.Fatalf("failed to load the issuers: %v", err)
+	}
+	if _, ok := v.issuers["https://idp.partner.com"]; !ok {
+		t.Fatal("expected the issuer to be trusted")
+	}
+
+	// the issuers are only loaded again when the configuration changed
+	if err := v.Reload(context.Background()); err != nil {
+		t.Fatalf("failed to reload the issuers: %v", err)
+	}
+	if discoveries != 1 {
+		t.Fatalf("expected 1 discovery, got %d", discoveries)
+	}
+
+	// an unavailable issuer keeps its previous verifier and a new issuer is not trusted until it is available
+	unavailable = true
+	config.Annotations[FederatedIssuersAnnotation] = `[{"name": "partner", "issuerURL": "https://idp.partner.com", "audience": "kubermatic", "allowedEmailDomains": ["partner.com"]}, {"name": "ci", "issuerURL": "https://ci", "audience": "kubermatic", "allowedEmailDomains": ["partner.com"]}]`
+	if err := v.Reload(context.Background()); err == nil {
+		t.Fatal("expected an error for the unavailable issuers")
+	}
+	if _, ok := v.issuers["https://idp.partner.com"]; !ok {
+		t.Error("expected the unchanged issuer to stay trusted")
+	}
+	if _, ok := v.issuers["https://ci"]; ok {
+		t.Error("expected the unavailable new issuer not to be trusted")
+	}
+
+	// removed issuers are no longer trusted
+	unavailable = false
+	config.Annotations[FederatedIssuersAnnotation] = `[{"name": "ci", "issuerURL": "https://ci", "audience": "kubermatic", "allowedEmailDomains": ["partner.com"]}]`
+	if err := v.Reload(context.Background()); err != nil {
+		t.Fatalf("failed to reload the issuers: %v", err)
+	}
+	if _, ok := v.issuers["https://idp.partner.com"]; ok {
+		t.Error("expected the removed issuer not to be trusted")
+	}
+
+	if _, err := v.Verify(context.Background(), "header."+base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://unknown"}`))+".signature"); err == nil {
+		t.Error("expected an error for a token of an untrusted issuer")
+	}
+}
+
+func TestNewFederatedIssuerVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                server.URL,
+			"jwks_uri":                              server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, Algorithm: "RS256", Use: "sig"}}})
+	})
+	issuer := FederatedIssuer{Name: "partner", IssuerURL: server.URL, Audience: "kubermatic"}
+
+	// the discovery is bound to the context of the caller
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := newFederatedIssuerVerifier(cancelled, issuer, nil); err == nil {
+		t.Fatal("expected the discovery to fail with a cancelled context")
+	}
+
+	// the keys are fetched after the context of the caller is done
+	ctx, cancel := context.WithCancel(context.Background())
+	verifier, err := newFederatedIssuerVerifier(ctx, issuer, nil)
+	cancel()
+	if err != nil {
+		t.Fatalf("failed to discover the issuer: %v", err)
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"iss": server.URL,
+		"aud": "kubermatic",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal claims: %v", err)
+	}
+	signed, err := signer.Sign(payload)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	token, err := signed.CompactSerialize()
+	if err != nil {
+		t.Fatalf("failed to serialize token: %v", err)
+	}
+
+	if _, err := verifier.Verify(context.Background(), token); err != nil {
+		t.Fatalf("failed to verify the token: %v", err)
+	}
+}
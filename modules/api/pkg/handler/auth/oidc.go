@@ -20,8 +20,10 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -43,6 +45,21 @@ type OpenIDClient struct {
 	verifier       *oidc.IDTokenVerifier
 	provider       *oidc.Provider
 	httpClient     *http.Client
+	// deviceAuthURL is the device authorization endpoint of the provider, empty if the
+	// provider does not support the device authorization grant.
+	deviceAuthURL string
+}
+
+// deviceAuthGrantType is the grant type of token requests for a device authorization.
+const deviceAuthGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// deviceAuthErrorCodes are the error codes of token requests which the client of a device
+// authorization handles, see https://datatracker.ietf.org/doc/html/rfc8628#section-3.5.
+var deviceAuthErrorCodes = map[string]bool{
+	"authorization_pending": true,
+	"slow_down":             true,
+	"access_denied":         true,
+	"expired_token":         true,
 }
 
 // NewOpenIDClient returns an authentication middleware which authenticates against an openID server.
@@ -77,6 +94,13 @@ func NewOpenIDClient(
 		return nil, err
 	}
 
+	var discovery struct {
+		DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	}
+	if err := p.Claims(&discovery); err != nil {
+		return nil, err
+	}
+
 	return &OpenIDClient{
 		tokenExtractor: extractor,
 		redirectURI:    redirectURI,
@@ -84,6 +108,7 @@ func NewOpenIDClient(
 		provider:       p,
 		httpClient:     client,
 		oidcConfig:     oidcConfig,
+		deviceAuthURL:  discovery.DeviceAuthorizationEndpoint,
 	}, nil
 }
 
@@ -216,6 +241,96 @@ func (o *OpenIDClient) RefreshAccessToken(ctx context.Context, refreshToken stri
 	return oidcToken, nil
 }
 
+// DeviceAuth starts an OAuth 2.0 device authorization grant for the required scopes.
+func (o *OpenIDClient) DeviceAuth(ctx context.Context, offlineAsScope bool, scopes ...string) (authtypes.DeviceAuthorization, error) {
+	if o.deviceAuthURL == "" {
+		return authtypes.DeviceAuthorization{}, errors.New("the OpenID provider does not support the device authorization grant")
+	}
+
+	options := oauth2.AccessTypeOnline
+	if !offlineAsScope {
+		options = oauth2.AccessTypeOffline
+	}
+	response, err := o.oauth2Config("", scopes...).DeviceAuth(oidc.ClientContext(ctx, o.httpClient), options)
+	if err != nil {
+		return authtypes.DeviceAuthorization{}, err
+	}
+
+	return authtypes.DeviceAuthorization{
+		DeviceCode:              response.DeviceCode,
+		UserCode:                response.UserCode,
+		VerificationURI:         response.VerificationURI,
+		VerificationURIComplete: response.VerificationURIComplete,
+		Expiry:                  response.Expiry,
+		Interval:                response.Interval,
+	}, nil
+}
+
+// DeviceAccessToken requests the token of a device authorization once. Unlike
+// oauth2.Config.DeviceAccessToken it does not block until the user approved the
+// authorization, so that the client of the API polls instead of the API.
+func (o *OpenIDClient) DeviceAccessToken(ctx context.Context, deviceCode string) (authtypes.OIDCToken, error) {
+	form := url.Values{
+		"grant_type":  {deviceAuthGrantType},
+		"device_code": {deviceCode},
+		"client_id":   {o.oidcConfig.ClientID},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.provider.Endpoint().TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return authtypes.OIDCToken{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if o.oidcConfig.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(o.oidcConfig.ClientID), url.QueryEscape(o.oidcConfig.ClientSecret))
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return authtypes.OIDCToken{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return authtypes.OIDCToken{}, fmt.Errorf("failed to read the token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var tokenErr struct {
+			Code        string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		if err := json.Unmarshal(body, &tokenErr); err == nil && deviceAuthErrorCodes[tokenErr.Code] {
+			return authtypes.OIDCToken{}, &authtypes.DeviceAuthorizationError{Code: tokenErr.Code, Description: tokenErr.Description}
+		}
+		return authtypes.OIDCToken{}, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	var tokens struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		IDToken      string `json:"id_token"`
+		ExpiresIn    int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokens); err != nil {
+		return authtypes.OIDCToken{}, fmt.Errorf("failed to decode the token response: %w", err)
+	}
+	if tokens.IDToken == "" {
+		return authtypes.OIDCToken{}, errors.New("id_token not found in the token response")
+	}
+
+	oidcToken := authtypes.OIDCToken{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		IDToken:      tokens.IDToken,
+	}
+	if tokens.ExpiresIn > 0 {
+		oidcToken.Expiry = time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	}
+	return oidcToken, nil
+}
+
 func (o *OpenIDClient) GetRedirectURI(path string) (string, error) {
 	u, err := url.Parse(o.redirectURI)
 	if err != nil {
@@ -234,10 +349,13 @@ func (o *OpenIDClient) oauth2Config(overwriteRedirectURI string, scopes ...strin
 		redirectURI = overwriteRedirectURI
 	}
 
+	endpoint := o.provider.Endpoint()
+	endpoint.DeviceAuthURL = o.deviceAuthURL
+
 	return &oauth2.Config{
 		ClientID:     o.oidcConfig.ClientID,
 		ClientSecret: o.oidcConfig.ClientSecret,
-		Endpoint:     o.provider.Endpoint(),
+		Endpoint:     endpoint,
 		Scopes:       scopes,
 		RedirectURL:  redirectURI,
 	}
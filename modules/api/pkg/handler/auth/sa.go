@@ -72,6 +72,16 @@ func (s *ServiceAccountAuthClient) Verify(ctx context.Context, token string) (au
 		return authtypes.TokenClaims{}, &TokenExpiredError{msg: tokenExpiredMsg}
 	}
 
+	if customClaims.Scope != nil {
+		request, ok := authtypes.TokenRequestFrom(ctx)
+		if !ok {
+			return authtypes.TokenClaims{}, fmt.Errorf("sa: the token %s is scoped and cannot be used for this request", customClaims.TokenID)
+		}
+		if err := customClaims.Scope.Allows(request.Method, request.ClusterID, string(request.Operation)); err != nil {
+			return authtypes.TokenClaims{}, fmt.Errorf("sa: %w", err)
+		}
+	}
+
 	return authtypes.TokenClaims{
 		Name:    customClaims.TokenID,
 		Email:   customClaims.Email,
@@ -32,9 +32,12 @@ import (
 	"go.uber.org/zap"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/chargeback"
+	"k8c.io/dashboard/v2/pkg/credentials"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/label"
+	"k8c.io/dashboard/v2/pkg/healthhistory"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	"k8c.io/dashboard/v2/pkg/resources/cluster"
@@ -62,6 +65,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -101,6 +105,7 @@ func CreateEndpoint(
 	configGetter provider.KubermaticConfigurationGetter,
 	features features.FeatureGate,
 	settingsProvider provider.SettingsProvider,
+	chargebackSchemaProvider provider.ChargebackSchemaProvider,
 ) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
@@ -124,6 +129,9 @@ func CreateEndpoint(
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	if err := common.ValidateChargebackLabels(ctx, chargebackSchemaProvider, chargeback.ScopeCluster, body.Cluster.Labels, project.Labels); err != nil {
+		return nil, err
+	}
 	existingClusters, err := clusterProvider.List(ctx, project, &provider.ClusterListOptions{ClusterSpecName: partialCluster.Spec.HumanReadableName})
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
@@ -252,6 +260,13 @@ func GenerateCluster(
 		body.Cluster.Spec.Cloud = *cloudSpec
 	}
 
+	// The cluster keeps the references to credentials in external stores, they are only resolved to create and
+	// validate the spec.
+	referencedCloud := body.Cluster.Spec.Cloud.DeepCopy()
+	if err := credentials.FromContext(ctx).ResolveFields(ctx, &body.Cluster.Spec.Cloud); err != nil {
+		return nil, utilerrors.NewBadRequest("invalid credentials: %v", err)
+	}
+
 	// Fetch the defaulting ClusterTemplate.
 	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
 	defaultingTemplate, err := defaulting.GetDefaultingClusterTemplate(ctx, seedClient, seed)
@@ -374,6 +389,10 @@ func GenerateCluster(
 	if body.Cluster.Spec.BackupConfig != nil {
 		partialCluster.Spec.BackupConfig = body.Cluster.Spec.BackupConfig
 	}
+
+	if err := credentials.RestoreReferences(&partialCluster.Spec.Cloud, referencedCloud); err != nil {
+		return nil, err
+	}
 	return partialCluster, nil
 }
 
@@ -813,6 +832,43 @@ func HealthEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 	}, nil
 }
 
+// HealthHistoryEndpoint returns the uptime of the control plane components of the cluster in the windows.
+func HealthHistoryEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID, windows string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, history *healthhistory.History) (interface{}, error) {
+	if history == nil {
+		return nil, utilerrors.New(http.StatusNotFound, "the cluster health history is disabled")
+	}
+
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if _, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{}); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	durations, err := history.ParseWindows(windows)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+
+	result := apiv1.ClusterHealthHistory{
+		SampleInterval: history.Interval().String(),
+		Windows:        []apiv1.ClusterHealthWindow{},
+	}
+	for _, window := range history.Report(clusterID, time.Now(), durations) {
+		result.Windows = append(result.Windows, apiv1.ClusterHealthWindow{
+			Window:  window.Duration.String(),
+			Samples: window.Samples,
+			Uptime:  window.Uptime,
+		})
+	}
+
+	return result, nil
+}
+
 func GetMetricsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
@@ -1454,6 +1510,23 @@ func GetClusterClientWithClusterID(ctx context.Context, userInfoGetter provider.
 	return client, nil
 }
 
+// GetClusterClientConfigWithClusterID returns the client config of the cluster for the user, like
+// GetClusterClientWithClusterID does for the client.
+func GetClusterClientConfigWithClusterID(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (*rest.Config, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := common.GetClusterClientConfig(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return cfg, nil
+}
+
 func checkIfPresetCustomized(ctx context.Context, projectID string, adminUserInfo provider.UserInfo, cloudSpec kubermaticv1.CloudSpec, credentialManager provider.PresetProvider, credentialName string) bool {
 	preset, _ := credentialManager.GetPreset(ctx, &adminUserInfo, &projectID, credentialName)
 
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/pricing"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func priceCatalog(source pricing.Source) (*pricing.Catalog, error) {
+	if source == nil || source.Catalog() == nil {
+		return nil, utilerrors.New(http.StatusNotFound, "the cost estimation is disabled, no price catalog is configured")
+	}
+	return source.Catalog(), nil
+}
+
+// EstimateClusterCostEndpoint estimates the monthly cost of a cluster which is about to be created in the datacenter.
+func EstimateClusterCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string, body apiv2.CostEstimationBody, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, source pricing.Source) (interface{}, error) {
+	catalog, err := priceCatalog(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, body.Datacenter)
+	if err != nil {
+		return nil, err
+	}
+	providerName, err := kubermaticv1helper.DatacenterCloudProviderName(dc.Spec.DeepCopy())
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+
+	return catalog.EstimateCluster(providerName, body.Datacenter, body.MachineDeployments), nil
+}
+
+// ClusterCostEndpoint estimates the monthly cost of a running cluster from its machine deployments.
+func ClusterCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, source pricing.Source) (interface{}, error) {
+	catalog, err := priceCatalog(source)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate, err := estimateClusterCost(ctx, userInfoGetter, clusterProvider, cluster, projectID, catalog)
+	if err != nil {
+		return nil, err
+	}
+	return estimate, nil
+}
+
+// ProjectCostEndpoint estimates the monthly cost of the clusters of the project in all seeds. The clusters of
+// seeds which cannot be reached are missing in the estimate, which warns about them.
+func ProjectCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, source pricing.Source) (interface{}, error) {
+	catalog, err := priceCatalog(source)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	clusters := []apiv2.ClusterCostEstimate{}
+	var warnings []string
+	for _, seed := range seeds {
+		if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+			continue
+		}
+
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			kubermaticlog.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, zap.Error(err))
+			warnings = append(warnings, fmt.Sprintf("The clusters of the seed %s are missing, it cannot be reached.", seed.Name))
+			continue
+		}
+		seedClusters, err := clusterProvider.List(ctx, project, nil)
+		if err != nil {
+			kubermaticlog.Logger.Errorw("failed to get clusters from seed", "seed", seed.Name, zap.Error(err))
+			warnings = append(warnings, fmt.Sprintf("The clusters of the seed %s are missing, it cannot be reached.", seed.Name))
+			continue
+		}
+
+		for i := range seedClusters.Items {
+			cluster := &seedClusters.Items[i]
+			estimate, err := estimateClusterCost(ctx, userInfoGetter, clusterProvider, cluster, projectID, catalog)
+			if err != nil {
+				// the cluster is still part of the estimate, e.g. while it is created
+				warnings = append(warnings, fmt.Sprintf("The machine deployments of the cluster %s could not be listed.", cluster.Spec.HumanReadableName))
+				estimate = &apiv2.ClusterCostEstimate{
+					ID:                 cluster.Name,
+					Name:               cluster.Spec.HumanReadableName,
+					Datacenter:         cluster.Spec.Cloud.DatacenterName,
+					Currency:           catalog.Currency,
+					MachineDeployments: []apiv2.MachineDeploymentCostEstimate{},
+				}
+			}
+			clusters = append(clusters, *estimate)
+		}
+	}
+
+	estimate := catalog.EstimateProject(projectID, clusters)
+	estimate.Warnings = append(warnings, estimate.Warnings...)
+	return estimate, nil
+}
+
+func estimateClusterCost(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string, catalog *pricing.Catalog) (*apiv2.ClusterCostEstimate, error) {
+	providerName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+
+	machineDeployments, err := listClusterMachineDeployments(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	nodeDeployments := make([]apiv1.NodeDeployment, 0, len(machineDeployments.Items))
+	for i := range machineDeployments.Items {
+		nd, err := OutputMachineDeployment(&machineDeployments.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to output machine deployment %s: %w", machineDeployments.Items[i].Name, err)
+		}
+		nodeDeployments = append(nodeDeployments, *nd)
+	}
+
+	estimate := catalog.EstimateCluster(providerName, cluster.Spec.Cloud.DatacenterName, nodeDeployments)
+	estimate.ID = cluster.Name
+	estimate.Name = cluster.Spec.HumanReadableName
+	return &estimate, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// Operation is an existing endpoint together with the decoder of its requests. Features which consist of several
+// changes, like applying a project bundle or upgrading a cluster in stages, build the same requests a client of
+// the API would send, so the changes pass the same validations and permission checks as the single calls.
+type Operation struct {
+	// Method and Route describe the call like a request served by the router, e.g. PATCH and
+	// /api/v2/projects/{project_id}/clusters/{cluster_id}, so that middlewares like Audit record it.
+	Method   string
+	Route    string
+	Decode   httptransport.DecodeRequestFunc
+	Endpoint endpoint.Endpoint
+}
+
+// Call decodes a request with the path variables, the query and the body and passes it to the endpoint. The
+// response is converted into result if it is not nil.
+//
+// The body is marshalled as is. API types like apiv1.ClusterSpec hide the credentials when marshalled through
+// a pointer, so bodies must be passed by value.
+func (o Operation) Call(ctx context.Context, vars map[string]string, query url.Values, body interface{}, result interface{}) error {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	ctx = o.withRequestInfo(ctx, vars)
+
+	httpReq, err := http.NewRequestWithContext(ctx, o.method(), "/?"+query.Encode(), reader)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq = mux.SetURLVars(httpReq, vars)
+
+	request, err := o.Decode(ctx, httpReq)
+	if err != nil {
+		return utilerrors.NewBadRequest("%v", err)
+	}
+
+	response, err := o.Endpoint(ctx, request)
+	if err != nil || result == nil || response == nil {
+		return err
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
+
+func (o Operation) method() string {
+	if o.Method == "" {
+		return http.MethodPost
+	}
+	return o.Method
+}
+
+// withRequestInfo replaces the method and the route of the request which triggered the call with the ones of the
+// operation. The client is kept, as the call is done on its behalf.
+func (o Operation) withRequestInfo(ctx context.Context, vars map[string]string) context.Context {
+	info, _ := ctx.Value(middleware.RequestInfoContextKey).(middleware.RequestInfo)
+	info.Method = o.method()
+	info.Route = o.Route
+
+	path := o.Route
+	for name, value := range vars {
+		path = strings.ReplaceAll(path, "{"+name+"}", value)
+	}
+	info.Path = path
+
+	return context.WithValue(ctx, middleware.RequestInfoContextKey, info)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/watcher"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ProjectResourceListerFactory returns the lister used by the project watcher. It lists the clusters and their
+// events from all seeds and the machine deployments and nodes from the user clusters, converted to the same
+// API types as returned by the REST endpoints. Access to the project is checked when subscribing, so the lister
+// uses privileged clients.
+func ProjectResourceListerFactory(privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) watcher.ProjectResourceLister {
+	return func(ctx context.Context, projectID string) (*watcher.ProjectSnapshot, error) {
+		project, err := privilegedProjectProvider.GetUnsecured(ctx, projectID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		seeds, err := seedsGetter()
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot := &watcher.ProjectSnapshot{}
+		for _, seed := range seeds {
+			clusterProvider, err := clusterProviderGetter(seed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get cluster provider for seed %s: %w", seed.Name, err)
+			}
+
+			clusters, err := clusterProvider.List(ctx, project, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list clusters in seed %s: %w", seed.Name, err)
+			}
+
+			for i := range clusters.Items {
+				cluster := &clusters.Items[i]
+				if err := appendClusterResources(ctx, snapshot, seed, clusterProvider, cluster); err != nil {
+					return nil, fmt.Errorf("failed to list resources of cluster %s: %w", cluster.Name, err)
+				}
+			}
+		}
+
+		return snapshot, nil
+	}
+}
+
+func appendClusterResources(ctx context.Context, snapshot *watcher.ProjectSnapshot, seed *kubermaticv1.Seed, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster) error {
+	var dc *kubermaticv1.Datacenter
+	if datacenter, exists := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]; exists {
+		dc = &datacenter
+	}
+
+	snapshot.Resources = append(snapshot.Resources, watcher.ProjectResource{
+		Kind:      watcher.ProjectResourceCluster,
+		ClusterID: cluster.Name,
+		Name:      cluster.Name,
+		Object:    ConvertInternalClusterToExternal(cluster.DeepCopy(), dc, true),
+	})
+
+	privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+	if !ok {
+		return fmt.Errorf("cluster provider of seed %s is not privileged", seed.Name)
+	}
+
+	events, err := common.GetEvents(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), cluster, metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+	for i := range events {
+		snapshot.Resources = append(snapshot.Resources, watcher.ProjectResource{
+			Kind:      watcher.ProjectResourceEvent,
+			ClusterID: cluster.Name,
+			Name:      events[i].ID,
+			Object:    events[i],
+		})
+	}
+
+	// The user cluster is not reachable while it is being created or its control plane is down,
+	// keep the previously known machine deployments and nodes in that case.
+	if cluster.Status.ExtendedHealth.Apiserver != kubermaticv1.HealthStatusUp {
+		snapshot.UnreachableClusters = append(snapshot.UnreachableClusters, cluster.Name)
+		return nil
+	}
+
+	resources, err := listUserClusterResources(ctx, clusterProvider, cluster)
+	if err != nil {
+		snapshot.UnreachableClusters = append(snapshot.UnreachableClusters, cluster.Name)
+		return nil
+	}
+	snapshot.Resources = append(snapshot.Resources, resources...)
+
+	return nil
+}
+
+func listUserClusterResources(ctx context.Context, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster) ([]watcher.ProjectResource, error) {
+	client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, err
+	}
+
+	machines := &clusterv1alpha1.MachineList{}
+	if err := client.List(ctx, machines, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, err
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+
+	var resources []watcher.ProjectResource
+	for i := range machineDeployments.Items {
+		nd, err := OutputMachineDeployment(&machineDeployments.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to output machine deployment %s: %w", machineDeployments.Items[i].Name, err)
+		}
+		resources = append(resources, watcher.ProjectResource{
+			Kind:      watcher.ProjectResourceMachineDeployment,
+			ClusterID: cluster.Name,
+			Name:      nd.ID,
+			Object:    nd,
+		})
+	}
+
+	// Same as in ListNodesForCluster, nodes are reported together with their machine if there is one.
+	var apiNodes []*apiv1.Node
+	matchedMachineNodes := sets.New[string]()
+	for i := range machines.Items {
+		node := getNodeForMachine(&machines.Items[i], nodes.Items)
+		if node == nil {
+			continue
+		}
+
+		matchedMachineNodes.Insert(string(node.UID))
+		outNode, err := outputMachine(&machines.Items[i], node, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to output machine %s: %w", machines.Items[i].Name, err)
+		}
+		apiNodes = append(apiNodes, outNode)
+	}
+	for i := range nodes.Items {
+		if !matchedMachineNodes.Has(string(nodes.Items[i].UID)) {
+			apiNodes = append(apiNodes, outputNode(&nodes.Items[i], false))
+		}
+	}
+
+	for _, node := range apiNodes {
+		resources = append(resources, watcher.ProjectResource{
+			Kind:      watcher.ProjectResourceNode,
+			ClusterID: cluster.Name,
+			Name:      node.ID,
+			Object:    node,
+		})
+	}
+
+	return resources, nil
+}
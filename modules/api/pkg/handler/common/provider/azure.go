@@ -36,6 +36,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/cloud/azure"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/providercache"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
@@ -248,7 +249,7 @@ func (s *azureClientSetImpl) ListVnets(ctx context.Context, resourceGroupName st
 	return result, nil
 }
 
-func AzureSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID string) (interface{}, error) {
+func AzureSizeWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
 	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
@@ -289,7 +290,7 @@ func AzureSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter
 	}
 
 	filter := handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
-	return AzureSize(ctx, filter, creds.SubscriptionID, creds.ClientID, creds.ClientSecret, creds.TenantID, azureLocation)
+	return AzureSize(ctx, cache, filter, creds.SubscriptionID, creds.ClientID, creds.ClientSecret, creds.TenantID, azureLocation)
 }
 
 func AzureAvailabilityZonesWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID, skuName string) (interface{}, error) {
@@ -378,56 +379,65 @@ func isValidVM(sku armcompute.ResourceSKU, location string) bool {
 	return true
 }
 
-func AzureSize(ctx context.Context, machineFilter kubermaticv1.MachineFlavorFilter, subscriptionID, clientID, clientSecret, tenantID, location string) (interface{}, error) {
-	sizesClient, err := NewAzureClientSet(subscriptionID, clientID, clientSecret, tenantID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create authorizer for size client: %w", err)
-	}
-
-	skuList, err := sizesClient.ListSKU(ctx, location)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list SKU resource: %w", err)
-	}
+func AzureSize(ctx context.Context, cache *providercache.Cache, machineFilter kubermaticv1.MachineFlavorFilter, subscriptionID, clientID, clientSecret, tenantID, location string) (interface{}, error) {
+	// the unfiltered sizes are cached, so that changes of the machine flavor filter apply immediately
+	key := providercache.Key{Provider: "azure", Datacenter: location, Resource: "sizes", Credentials: []string{subscriptionID, clientID, clientSecret, tenantID}}
+	validSKUList, err := providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv1.AzureSizeList, error) {
+		sizesClient, err := NewAzureClientSet(subscriptionID, clientID, clientSecret, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authorizer for size client: %w", err)
+		}
 
-	// prepare a list of valid VM AzureSize types from SKU resources
-	var validSKUList apiv1.AzureSizeList
+		skuList, err := sizesClient.ListSKU(ctx, location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SKU resource: %w", err)
+		}
 
-	for _, sku := range skuList {
-		if isValidVM(sku, location) {
-			var vm apiv1.AzureSize
-			if sku.Name != nil {
-				vm.Name = *sku.Name
-				for _, cap := range sku.Capabilities {
-					if cap.Name == nil || cap.Value == nil {
-						continue
-					}
+		// prepare a list of valid VM AzureSize types from SKU resources
+		var validSKUList apiv1.AzureSizeList
+
+		for _, sku := range skuList {
+			if isValidVM(sku, location) {
+				var vm apiv1.AzureSize
+				if sku.Name != nil {
+					vm.Name = *sku.Name
+					for _, cap := range sku.Capabilities {
+						if cap.Name == nil || cap.Value == nil {
+							continue
+						}
 
-					val, err := strconv.ParseFloat(*cap.Value, 64)
-					if err != nil {
-						if *cap.Name == "AcceleratedNetworkingEnabled" && *cap.Value == "True" {
-							vm.AcceleratedNetworkingEnabled = true
+						val, err := strconv.ParseFloat(*cap.Value, 64)
+						if err != nil {
+							if *cap.Name == "AcceleratedNetworkingEnabled" && *cap.Value == "True" {
+								vm.AcceleratedNetworkingEnabled = true
+							}
+							continue
 						}
-						continue
-					}
 
-					switch *cap.Name {
-					case "vCPUs":
-						vm.NumberOfCores = int32(val)
-					case "GPUs":
-						vm.NumberOfGPUs = int32(val)
-					case "OSVhdSizeMB":
-						vm.OsDiskSizeInMB = int32(val)
-					case "MaxResourceVolumeMB":
-						vm.ResourceDiskSizeInMB = int32(val)
-					case "MemoryGB":
-						vm.MemoryInMB = int32(val * 1024)
-					case "MaxDataDiskCount":
-						vm.MaxDataDiskCount = int32(val)
+						switch *cap.Name {
+						case "vCPUs":
+							vm.NumberOfCores = int32(val)
+						case "GPUs":
+							vm.NumberOfGPUs = int32(val)
+						case "OSVhdSizeMB":
+							vm.OsDiskSizeInMB = int32(val)
+						case "MaxResourceVolumeMB":
+							vm.ResourceDiskSizeInMB = int32(val)
+						case "MemoryGB":
+							vm.MemoryInMB = int32(val * 1024)
+						case "MaxDataDiskCount":
+							vm.MaxDataDiskCount = int32(val)
+						}
 					}
+					validSKUList = append(validSKUList, vm)
 				}
-				validSKUList = append(validSKUList, vm)
 			}
 		}
+
+		return validSKUList, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return filterMachineFlavorsForAzure(validSKUList, machineFilter), nil
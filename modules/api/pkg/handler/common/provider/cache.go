@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// credentialsKey returns the values of the resolved credentials as part of a cache key, so that the cached
+// responses can be invalidated by any of the values, e.g. the ones of a preset.
+func credentialsKey(credentials interface{}) []string {
+	value := reflect.Indirect(reflect.ValueOf(credentials))
+	if value.Kind() != reflect.Struct {
+		return []string{fmt.Sprintf("%+v", credentials)}
+	}
+
+	values := make([]string, 0, value.NumField())
+	for i := range value.NumField() {
+		field := value.Field(i)
+		if !value.Type().Field(i).IsExported() {
+			continue
+		}
+		if field.Kind() == reflect.String {
+			values = append(values, field.String())
+			continue
+		}
+		data, err := json.Marshal(field.Interface())
+		if err != nil {
+			data = []byte(fmt.Sprintf("%+v", field.Interface()))
+		}
+		values = append(values, string(data))
+	}
+	return values
+}
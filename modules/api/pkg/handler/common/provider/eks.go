@@ -32,6 +32,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	awsprovider "k8c.io/dashboard/v2/pkg/provider/cloud/aws"
 	eksprovider "k8c.io/dashboard/v2/pkg/provider/cloud/eks"
+	"k8c.io/dashboard/v2/pkg/providercache"
 	"k8c.io/kubermatic/v2/pkg/resources"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -106,90 +107,99 @@ func ListEKSClusters(ctx context.Context, projectProvider provider.ProjectProvid
 	return clusters, nil
 }
 
-func ListEKSSubnetIDs(ctx context.Context, cred resources.EKSCredential, vpcId string) (apiv2.EKSSubnetList, error) {
-	subnets := apiv2.EKSSubnetList{}
+func ListEKSSubnetIDs(ctx context.Context, cache *providercache.Cache, cred resources.EKSCredential, vpcId string) (apiv2.EKSSubnetList, error) {
+	key := providercache.Key{Provider: "eks", Datacenter: cred.Region, Resource: "subnets", Credentials: credentialsKey(cred), Params: []interface{}{vpcId}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv2.EKSSubnetList, error) {
+		subnets := apiv2.EKSSubnetList{}
 
-	subnetResults, err := awsprovider.GetSubnets(ctx, cred.AccessKeyID, cred.SecretAccessKey, cred.AssumeRoleARN, cred.AssumeRoleExternalID, cred.Region, vpcId)
-	if err != nil {
-		return nil, err
-	}
-
-	azSubnetMap := make(map[string]string)
-	for _, subnetResult := range subnetResults {
-		var isDefault bool
-		// creating a list of subnets with unique availabilityZone
-		az := to.String(subnetResult.AvailabilityZone)
-		subnetId := to.String(subnetResult.SubnetId)
-		if _, ok := azSubnetMap[az]; !ok {
-			azSubnetMap[az] = subnetId
-			isDefault = true
+		subnetResults, err := awsprovider.GetSubnets(ctx, cred.AccessKeyID, cred.SecretAccessKey, cred.AssumeRoleARN, cred.AssumeRoleExternalID, cred.Region, vpcId)
+		if err != nil {
+			return nil, err
 		}
 
-		subnets = append(subnets, apiv2.EKSSubnet{
-			SubnetId:         subnetId,
-			VpcId:            to.String(subnetResult.VpcId),
-			AvailabilityZone: az,
-			Default:          isDefault,
-		})
-	}
+		azSubnetMap := make(map[string]string)
+		for _, subnetResult := range subnetResults {
+			var isDefault bool
+			// creating a list of subnets with unique availabilityZone
+			az := to.String(subnetResult.AvailabilityZone)
+			subnetId := to.String(subnetResult.SubnetId)
+			if _, ok := azSubnetMap[az]; !ok {
+				azSubnetMap[az] = subnetId
+				isDefault = true
+			}
+
+			subnets = append(subnets, apiv2.EKSSubnet{
+				SubnetId:         subnetId,
+				VpcId:            to.String(subnetResult.VpcId),
+				AvailabilityZone: az,
+				Default:          isDefault,
+			})
+		}
 
-	return subnets, nil
+		return subnets, nil
+	})
 }
 
-func ListEKSVPC(ctx context.Context, cred resources.EKSCredential) (apiv2.EKSVPCList, error) {
-	vpcs := apiv2.EKSVPCList{}
+func ListEKSVPC(ctx context.Context, cache *providercache.Cache, cred resources.EKSCredential) (apiv2.EKSVPCList, error) {
+	key := providercache.Key{Provider: "eks", Datacenter: cred.Region, Resource: "vpcs", Credentials: credentialsKey(cred)}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv2.EKSVPCList, error) {
+		vpcs := apiv2.EKSVPCList{}
 
-	vpcResults, err := awsprovider.GetVPCS(ctx, cred.AccessKeyID, cred.SecretAccessKey, cred.AssumeRoleARN, cred.AssumeRoleExternalID, cred.Region)
-	if err != nil {
-		return nil, err
-	}
+		vpcResults, err := awsprovider.GetVPCS(ctx, cred.AccessKeyID, cred.SecretAccessKey, cred.AssumeRoleARN, cred.AssumeRoleExternalID, cred.Region)
+		if err != nil {
+			return nil, err
+		}
 
-	for _, v := range vpcResults {
-		vpc := apiv2.EKSVPC{
-			ID:        to.String(v.VpcId),
-			IsDefault: to.Bool(v.IsDefault),
+		for _, v := range vpcResults {
+			vpc := apiv2.EKSVPC{
+				ID:        to.String(v.VpcId),
+				IsDefault: to.Bool(v.IsDefault),
+			}
+			vpcs = append(vpcs, vpc)
 		}
-		vpcs = append(vpcs, vpc)
-	}
 
-	return vpcs, nil
+		return vpcs, nil
+	})
 }
 
-func ListInstanceTypes(ctx context.Context, cred resources.EKSCredential, architecture string) (apiv2.EKSInstanceTypeList, error) {
-	instanceTypes := apiv2.EKSInstanceTypeList{}
+func ListInstanceTypes(ctx context.Context, cache *providercache.Cache, cred resources.EKSCredential, architecture string) (apiv2.EKSInstanceTypeList, error) {
+	key := providercache.Key{Provider: "eks", Datacenter: cred.Region, Resource: "instancetypes", Credentials: credentialsKey(cred), Params: []interface{}{architecture}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv2.EKSInstanceTypeList, error) {
+		instanceTypes := apiv2.EKSInstanceTypeList{}
 
-	if data == nil {
-		return nil, fmt.Errorf("AWS instance type data not initialized")
-	}
+		if data == nil {
+			return nil, fmt.Errorf("AWS instance type data not initialized")
+		}
 
-	instanceTypesResults, err := awsprovider.GetInstanceTypes(ctx, cred.AccessKeyID, cred.SecretAccessKey, cred.AssumeRoleARN, cred.AssumeRoleExternalID, cred.Region)
-	if err != nil {
-		return nil, err
-	}
+		instanceTypesResults, err := awsprovider.GetInstanceTypes(ctx, cred.AccessKeyID, cred.SecretAccessKey, cred.AssumeRoleARN, cred.AssumeRoleExternalID, cred.Region)
+		if err != nil {
+			return nil, err
+		}
 
-	for _, i := range *data {
-		for _, r := range instanceTypesResults {
-			if ec2types.InstanceType(i.InstanceType) == r.InstanceType {
-				if len(architecture) > 0 {
-					if len(i.Arch) == 0 || i.Arch[0] != architecture {
-						continue
+		for _, i := range *data {
+			for _, r := range instanceTypesResults {
+				if ec2types.InstanceType(i.InstanceType) == r.InstanceType {
+					if len(architecture) > 0 {
+						if len(i.Arch) == 0 || i.Arch[0] != architecture {
+							continue
+						}
 					}
-				}
 
-				instanceTypes = append(instanceTypes, apiv2.EKSInstanceType{
-					Name:         i.InstanceType,
-					PrettyName:   i.PrettyName,
-					Memory:       i.Memory,
-					VCPUs:        i.VCPU,
-					GPUs:         i.GPU,
-					Architecture: i.Arch[0],
-				})
-				break
+					instanceTypes = append(instanceTypes, apiv2.EKSInstanceType{
+						Name:         i.InstanceType,
+						PrettyName:   i.PrettyName,
+						Memory:       i.Memory,
+						VCPUs:        i.VCPU,
+						GPUs:         i.GPU,
+						Architecture: i.Arch[0],
+					})
+					break
+				}
 			}
 		}
-	}
 
-	return instanceTypes, nil
+		return instanceTypes, nil
+	})
 }
 
 func ListEKSRegions(ctx context.Context, cred resources.EKSCredential) (apiv2.EKSRegionList, error) {
@@ -31,13 +31,14 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/cloud/gcp"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/providercache"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
-func GCPSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID, zone string) (interface{}, error) {
+func GCPSizeWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID, zone string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
 	if err != nil {
@@ -77,10 +78,10 @@ func GCPSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter p
 	}
 
 	filter := handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
-	return ListGCPSizes(ctx, filter, sa, zone)
+	return ListGCPSizes(ctx, cache, filter, sa, zone)
 }
 
-func GCPZoneWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID string) (interface{}, error) {
+func GCPZoneWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
 	if err != nil {
@@ -104,10 +105,10 @@ func GCPZoneWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter p
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
-	return ListGCPZones(ctx, userInfo, sa, cluster.Spec.Cloud.DatacenterName, seedsGetter)
+	return ListGCPZones(ctx, cache, userInfo, sa, cluster.Spec.Cloud.DatacenterName, seedsGetter)
 }
 
-func GCPNetworkWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (interface{}, error) {
+func GCPNetworkWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
 	if err != nil {
@@ -127,10 +128,10 @@ func GCPNetworkWithClusterCredentialsEndpoint(ctx context.Context, userInfoGette
 	if err != nil {
 		return nil, err
 	}
-	return ListGCPNetworks(ctx, sa)
+	return ListGCPNetworks(ctx, cache, sa)
 }
 
-func GCPSubnetworkWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID, network string) (interface{}, error) {
+func GCPSubnetworkWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, projectID, clusterID, network string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
 	if err != nil {
@@ -154,10 +155,10 @@ func GCPSubnetworkWithClusterCredentialsEndpoint(ctx context.Context, userInfoGe
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
-	return ListGCPSubnetworks(ctx, userInfo, cluster.Spec.Cloud.DatacenterName, sa, network, seedsGetter)
+	return ListGCPSubnetworks(ctx, cache, userInfo, cluster.Spec.Cloud.DatacenterName, sa, network, seedsGetter)
 }
 
-func GCPDiskTypesWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, zone string) (interface{}, error) {
+func GCPDiskTypesWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, zone string) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 
 	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
@@ -179,38 +180,41 @@ func GCPDiskTypesWithClusterCredentialsEndpoint(ctx context.Context, userInfoGet
 		return nil, err
 	}
 
-	return ListGCPDiskTypes(ctx, sa, zone)
+	return ListGCPDiskTypes(ctx, cache, sa, zone)
 }
 
-func ListGCPDiskTypes(ctx context.Context, sa string, zone string) (apiv1.GCPDiskTypeList, error) {
-	diskTypes := apiv1.GCPDiskTypeList{}
-	// Currently accepted values: 'pd-standard', 'pd-ssd' or 'pd-balanced'
-	// Reference: https://pkg.go.dev/google.golang.org/api/container/v1#NodeConfig
-
-	excludedDiskTypes := sets.New("local-ssd", "pd-balanced")
-	computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
-	if err != nil {
-		return diskTypes, err
-	}
+func ListGCPDiskTypes(ctx context.Context, cache *providercache.Cache, sa string, zone string) (apiv1.GCPDiskTypeList, error) {
+	key := providercache.Key{Provider: "gcp", Datacenter: zone, Resource: "disktypes", Credentials: []string{sa}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv1.GCPDiskTypeList, error) {
+		diskTypes := apiv1.GCPDiskTypeList{}
+		// Currently accepted values: 'pd-standard', 'pd-ssd' or 'pd-balanced'
+		// Reference: https://pkg.go.dev/google.golang.org/api/container/v1#NodeConfig
+
+		excludedDiskTypes := sets.New("local-ssd", "pd-balanced")
+		computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
+		if err != nil {
+			return diskTypes, err
+		}
 
-	req := computeService.DiskTypes.List(project, zone)
-	err = req.Pages(ctx, func(page *compute.DiskTypeList) error {
-		for _, diskType := range page.Items {
-			if !excludedDiskTypes.Has(diskType.Name) {
-				dt := apiv1.GCPDiskType{
-					Name:        diskType.Name,
-					Description: diskType.Description,
+		req := computeService.DiskTypes.List(project, zone)
+		err = req.Pages(ctx, func(page *compute.DiskTypeList) error {
+			for _, diskType := range page.Items {
+				if !excludedDiskTypes.Has(diskType.Name) {
+					dt := apiv1.GCPDiskType{
+						Name:        diskType.Name,
+						Description: diskType.Description,
+					}
+					diskTypes = append(diskTypes, dt)
 				}
-				diskTypes = append(diskTypes, dt)
 			}
-		}
-		return nil
-	})
+			return nil
+		})
 
-	return diskTypes, err
+		return diskTypes, err
+	})
 }
 
-func ListGCPSubnetworks(ctx context.Context, userInfo *provider.UserInfo, datacenterName string, sa string, networkName string, seedsGetter provider.SeedsGetter) (apiv1.GCPSubnetworkList, error) {
+func ListGCPSubnetworks(ctx context.Context, cache *providercache.Cache, userInfo *provider.UserInfo, datacenterName string, sa string, networkName string, seedsGetter provider.SeedsGetter) (apiv1.GCPSubnetworkList, error) {
 	datacenter, err := dc.GetDatacenter(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, utilerrors.NewBadRequest("%v", err)
@@ -220,49 +224,55 @@ func ListGCPSubnetworks(ctx context.Context, userInfo *provider.UserInfo, datace
 		return nil, utilerrors.NewBadRequest("%s is not a GCP datacenter", datacenterName)
 	}
 
-	subnetworks := apiv1.GCPSubnetworkList{}
+	key := providercache.Key{Provider: "gcp", Datacenter: datacenterName, Resource: "subnetworks", Credentials: []string{sa}, Params: []interface{}{networkName}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv1.GCPSubnetworkList, error) {
+		subnetworks := apiv1.GCPSubnetworkList{}
 
-	computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
-	if err != nil {
-		return subnetworks, err
-	}
+		computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
+		if err != nil {
+			return subnetworks, err
+		}
 
-	req := computeService.Subnetworks.List(project, datacenter.Spec.GCP.Region)
-	err = req.Pages(ctx, func(page *compute.SubnetworkList) error {
-		for _, subnetwork := range page.Items {
-			// subnetworks.Network are a url e.g. https://www.googleapis.com/compute/v1/[...]/networks/default"
-			// we just get the path of the network, instead of the url
-			// therefore we can't use regular Filter function and need to check on our own
-			if strings.Contains(subnetwork.Network, networkName) {
-				subnetworks = append(subnetworks, gcp.ToGCPSubnetworkAPIModel(subnetwork))
+		req := computeService.Subnetworks.List(project, datacenter.Spec.GCP.Region)
+		err = req.Pages(ctx, func(page *compute.SubnetworkList) error {
+			for _, subnetwork := range page.Items {
+				// subnetworks.Network are a url e.g. https://www.googleapis.com/compute/v1/[...]/networks/default"
+				// we just get the path of the network, instead of the url
+				// therefore we can't use regular Filter function and need to check on our own
+				if strings.Contains(subnetwork.Network, networkName) {
+					subnetworks = append(subnetworks, gcp.ToGCPSubnetworkAPIModel(subnetwork))
+				}
 			}
-		}
-		return nil
-	})
+			return nil
+		})
 
-	return subnetworks, err
+		return subnetworks, err
+	})
 }
 
-func ListGCPNetworks(ctx context.Context, sa string) (apiv1.GCPNetworkList, error) {
-	networks := apiv1.GCPNetworkList{}
-
-	computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
-	if err != nil {
-		return networks, err
-	}
+func ListGCPNetworks(ctx context.Context, cache *providercache.Cache, sa string) (apiv1.GCPNetworkList, error) {
+	key := providercache.Key{Provider: "gcp", Resource: "networks", Credentials: []string{sa}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv1.GCPNetworkList, error) {
+		networks := apiv1.GCPNetworkList{}
 
-	req := computeService.Networks.List(project)
-	err = req.Pages(ctx, func(page *compute.NetworkList) error {
-		for _, network := range page.Items {
-			networks = append(networks, gcp.ToGCPNetworkAPIModel(network))
+		computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
+		if err != nil {
+			return networks, err
 		}
-		return nil
-	})
 
-	return networks, err
+		req := computeService.Networks.List(project)
+		err = req.Pages(ctx, func(page *compute.NetworkList) error {
+			for _, network := range page.Items {
+				networks = append(networks, gcp.ToGCPNetworkAPIModel(network))
+			}
+			return nil
+		})
+
+		return networks, err
+	})
 }
 
-func ListGCPZones(ctx context.Context, userInfo *provider.UserInfo, sa, datacenterName string, seedsGetter provider.SeedsGetter) (apiv1.GCPZoneList, error) {
+func ListGCPZones(ctx context.Context, cache *providercache.Cache, userInfo *provider.UserInfo, sa, datacenterName string, seedsGetter provider.SeedsGetter) (apiv1.GCPZoneList, error) {
 	datacenter, err := dc.GetDatacenter(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, utilerrors.NewBadRequest("%v", err)
@@ -272,58 +282,67 @@ func ListGCPZones(ctx context.Context, userInfo *provider.UserInfo, sa, datacent
 		return nil, utilerrors.NewBadRequest("the %s is not GCP datacenter", datacenterName)
 	}
 
-	computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
-	if err != nil {
-		return nil, err
-	}
+	key := providercache.Key{Provider: "gcp", Datacenter: datacenterName, Resource: "zones", Credentials: []string{sa}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv1.GCPZoneList, error) {
+		computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
+		if err != nil {
+			return nil, err
+		}
 
-	zones := apiv1.GCPZoneList{}
-	req := computeService.Zones.List(project)
-	err = req.Pages(ctx, func(page *compute.ZoneList) error {
-		for _, zone := range page.Items {
-			if strings.HasPrefix(zone.Name, datacenter.Spec.GCP.Region) {
-				apiZone := apiv1.GCPZone{Name: zone.Name}
-				zones = append(zones, apiZone)
+		zones := apiv1.GCPZoneList{}
+		req := computeService.Zones.List(project)
+		err = req.Pages(ctx, func(page *compute.ZoneList) error {
+			for _, zone := range page.Items {
+				if strings.HasPrefix(zone.Name, datacenter.Spec.GCP.Region) {
+					apiZone := apiv1.GCPZone{Name: zone.Name}
+					zones = append(zones, apiZone)
+				}
 			}
-		}
-		return nil
-	})
+			return nil
+		})
 
-	return zones, err
+		return zones, err
+	})
 }
 
-func ListGCPSizes(ctx context.Context, machineFilter kubermaticv1.MachineFlavorFilter, sa, zone string) (apiv1.GCPMachineSizeList, error) {
-	sizes := apiv1.GCPMachineSizeList{}
+func ListGCPSizes(ctx context.Context, cache *providercache.Cache, machineFilter kubermaticv1.MachineFlavorFilter, sa, zone string) (apiv1.GCPMachineSizeList, error) {
+	// the unfiltered sizes are cached, so that changes of the machine flavor filter apply immediately
+	key := providercache.Key{Provider: "gcp", Datacenter: zone, Resource: "sizes", Credentials: []string{sa}}
+	sizes, err := providercache.Fetch(ctx, cache, key, func(ctx context.Context) (apiv1.GCPMachineSizeList, error) {
+		sizes := apiv1.GCPMachineSizeList{}
 
-	computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
-	if err != nil {
-		return sizes, err
-	}
+		computeService, project, err := gcp.ConnectToComputeService(ctx, sa)
+		if err != nil {
+			return sizes, err
+		}
 
-	req := computeService.MachineTypes.List(project, zone)
-	err = req.Pages(ctx, func(page *compute.MachineTypeList) error {
-		for _, machineType := range page.Items {
-			// Extract accelerators
-			var accelerators []apiv1.GCPMachineAccelerator
-			for _, acc := range machineType.Accelerators {
-				if acc.GuestAcceleratorCount > 0 {
-					accelerators = append(accelerators, apiv1.GCPMachineAccelerator{
-						GuestAcceleratorType:  acc.GuestAcceleratorType,
-						GuestAcceleratorCount: acc.GuestAcceleratorCount,
-					})
+		req := computeService.MachineTypes.List(project, zone)
+		err = req.Pages(ctx, func(page *compute.MachineTypeList) error {
+			for _, machineType := range page.Items {
+				// Extract accelerators
+				var accelerators []apiv1.GCPMachineAccelerator
+				for _, acc := range machineType.Accelerators {
+					if acc.GuestAcceleratorCount > 0 {
+						accelerators = append(accelerators, apiv1.GCPMachineAccelerator{
+							GuestAcceleratorType:  acc.GuestAcceleratorType,
+							GuestAcceleratorCount: acc.GuestAcceleratorCount,
+						})
+					}
 				}
-			}
 
-			mt := apiv1.GCPMachineSize{
-				Name:         machineType.Name,
-				Description:  machineType.Description,
-				Memory:       machineType.MemoryMb,
-				VCPUs:        machineType.GuestCpus,
-				Accelerators: accelerators,
+				mt := apiv1.GCPMachineSize{
+					Name:         machineType.Name,
+					Description:  machineType.Description,
+					Memory:       machineType.MemoryMb,
+					VCPUs:        machineType.GuestCpus,
+					Accelerators: accelerators,
+				}
+				sizes = append(sizes, mt)
 			}
-			sizes = append(sizes, mt)
-		}
-		return nil
+			return nil
+		})
+
+		return sizes, err
 	})
 
 	return filterGCPByQuota(sizes, machineFilter), err
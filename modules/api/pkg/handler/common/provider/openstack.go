@@ -32,13 +32,14 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/cloud/openstack"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/providercache"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/machine-controller/sdk/providerconfig"
 )
 
-func OpenstackSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
+func OpenstackSizeWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, projectID, clusterID string, caBundle *x509.CertPool) (interface{}, error) {
 	cluster, err := getClusterForOpenstack(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID)
@@ -69,7 +70,7 @@ func OpenstackSizeWithClusterCredentialsEndpoint(ctx context.Context, userInfoGe
 	}
 
 	filter := handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
-	return GetOpenstackSizes(creds, datacenter, filter, caBundle)
+	return GetOpenstackSizes(cache, creds, datacenter, filter, caBundle)
 }
 
 func OpenstackTenantWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
@@ -94,7 +95,7 @@ func OpenstackTenantWithClusterCredentialsEndpoint(ctx context.Context, userInfo
 	return GetOpenstackProjects(userInfo, seedsGetter, creds, datacenterName, caBundle)
 }
 
-func OpenstackNetworkWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
+func OpenstackNetworkWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter, projectID, clusterID string, caBundle *x509.CertPool) (interface{}, error) {
 	cluster, err := getClusterForOpenstack(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID)
@@ -113,10 +114,10 @@ func OpenstackNetworkWithClusterCredentialsEndpoint(ctx context.Context, userInf
 	if err != nil {
 		return nil, err
 	}
-	return GetOpenstackNetworks(ctx, userInfo, seedsGetter, creds, datacenterName, caBundle)
+	return GetOpenstackNetworks(ctx, cache, userInfo, seedsGetter, creds, datacenterName, caBundle)
 }
 
-func OpenstackSecurityGroupWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
+func OpenstackSecurityGroupWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter, projectID, clusterID string, caBundle *x509.CertPool) (interface{}, error) {
 	cluster, err := getClusterForOpenstack(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID)
@@ -136,7 +137,7 @@ func OpenstackSecurityGroupWithClusterCredentialsEndpoint(ctx context.Context, u
 		return nil, err
 	}
 
-	return GetOpenstackSecurityGroups(ctx, userInfo, seedsGetter, creds, datacenterName, caBundle)
+	return GetOpenstackSecurityGroups(ctx, cache, userInfo, seedsGetter, creds, datacenterName, caBundle)
 }
 
 func OpenstackServerGroupWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
@@ -162,7 +163,7 @@ func OpenstackServerGroupWithClusterCredentialsEndpoint(ctx context.Context, use
 	return GetOpenstackServerGroups(ctx, userInfo, seedsGetter, creds, datacenterName, caBundle)
 }
 
-func OpenstackSubnetsWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
+func OpenstackSubnetsWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter, projectID, clusterID, networkID string, caBundle *x509.CertPool) (interface{}, error) {
 	cluster, err := getClusterForOpenstack(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID)
@@ -182,7 +183,7 @@ func OpenstackSubnetsWithClusterCredentialsEndpoint(ctx context.Context, userInf
 		return nil, err
 	}
 
-	return GetOpenstackSubnets(ctx, userInfo, seedsGetter, creds, networkID, datacenterName, caBundle)
+	return GetOpenstackSubnets(ctx, cache, userInfo, seedsGetter, creds, networkID, datacenterName, caBundle)
 }
 
 func OpenstackAvailabilityZoneWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
@@ -319,53 +320,59 @@ func filterOpenstackImagesByOS(images []apiv1.OpenstackImage, os providerconfig.
 	return filtered
 }
 
-func GetOpenstackSubnets(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, networkID, datacenterName string, caBundle *x509.CertPool) ([]apiv1.OpenstackSubnet, error) {
+func GetOpenstackSubnets(ctx context.Context, cache *providercache.Cache, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, networkID, datacenterName string, caBundle *x509.CertPool) ([]apiv1.OpenstackSubnet, error) {
 	authURL, region, err := getOpenstackAuthURLAndRegion(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, err
 	}
 
-	subnets, err := openstack.GetSubnets(ctx, authURL, region, networkID, credentials, caBundle)
-	if err != nil {
-		return nil, err
-	}
+	key := providercache.Key{Provider: "openstack", Datacenter: datacenterName, Resource: "subnets", Credentials: credentialsKey(credentials), Params: []interface{}{networkID}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) ([]apiv1.OpenstackSubnet, error) {
+		subnets, err := openstack.GetSubnets(ctx, authURL, region, networkID, credentials, caBundle)
+		if err != nil {
+			return nil, err
+		}
 
-	apiSubnetIDs := []apiv1.OpenstackSubnet{}
-	for _, subnet := range subnets {
-		apiSubnetIDs = append(apiSubnetIDs, apiv1.OpenstackSubnet{
-			ID:        subnet.ID,
-			Name:      subnet.Name,
-			IPVersion: subnet.IPVersion,
-			Tags:      subnet.Tags,
-		})
-	}
+		apiSubnetIDs := []apiv1.OpenstackSubnet{}
+		for _, subnet := range subnets {
+			apiSubnetIDs = append(apiSubnetIDs, apiv1.OpenstackSubnet{
+				ID:        subnet.ID,
+				Name:      subnet.Name,
+				IPVersion: subnet.IPVersion,
+				Tags:      subnet.Tags,
+			})
+		}
 
-	return apiSubnetIDs, nil
+		return apiSubnetIDs, nil
+	})
 }
 
-func GetOpenstackNetworks(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, datacenterName string, caBundle *x509.CertPool) ([]apiv1.OpenstackNetwork, error) {
+func GetOpenstackNetworks(ctx context.Context, cache *providercache.Cache, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, datacenterName string, caBundle *x509.CertPool) ([]apiv1.OpenstackNetwork, error) {
 	authURL, region, err := getOpenstackAuthURLAndRegion(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, err
 	}
 
-	networks, err := openstack.GetNetworks(ctx, authURL, region, credentials, caBundle)
-	if err != nil {
-		return nil, err
-	}
-
-	apiNetworks := []apiv1.OpenstackNetwork{}
-	for _, network := range networks {
-		apiNetwork := apiv1.OpenstackNetwork{
-			Name:     network.Name,
-			ID:       network.ID,
-			External: network.External,
+	key := providercache.Key{Provider: "openstack", Datacenter: datacenterName, Resource: "networks", Credentials: credentialsKey(credentials)}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) ([]apiv1.OpenstackNetwork, error) {
+		networks, err := openstack.GetNetworks(ctx, authURL, region, credentials, caBundle)
+		if err != nil {
+			return nil, err
 		}
 
-		apiNetworks = append(apiNetworks, apiNetwork)
-	}
+		apiNetworks := []apiv1.OpenstackNetwork{}
+		for _, network := range networks {
+			apiNetwork := apiv1.OpenstackNetwork{
+				Name:     network.Name,
+				ID:       network.ID,
+				External: network.External,
+			}
 
-	return apiNetworks, nil
+			apiNetworks = append(apiNetworks, apiNetwork)
+		}
+
+		return apiNetworks, nil
+	})
 }
 
 func GetOpenstackSubnetPools(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, datacenterName string, ipVersion int, caBundle *x509.CertPool) ([]apiv2.OpenstackSubnetPool, error) {
@@ -415,25 +422,37 @@ func GetOpenstackProjects(userInfo *provider.UserInfo, seedsGetter provider.Seed
 	return apiProjects, nil
 }
 
-func GetOpenstackSizes(credentials *resources.OpenstackCredentials, datacenter *kubermaticv1.Datacenter,
+func GetOpenstackSizes(cache *providercache.Cache, credentials *resources.OpenstackCredentials, datacenter *kubermaticv1.Datacenter,
 	machineFilter kubermaticv1.MachineFlavorFilter, caBundle *x509.CertPool) ([]apiv1.OpenstackSize, error) {
-	flavors, err := openstack.GetFlavors(datacenter.Spec.Openstack.AuthURL,
-		datacenter.Spec.Openstack.Region, credentials, caBundle)
+	// the flavors are cached before the filters of the datacenter are applied, so that changes apply immediately
+	key := providercache.Key{Provider: "openstack", Datacenter: datacenter.Spec.Openstack.Region, Resource: "sizes", Credentials: credentialsKey(credentials), Params: []interface{}{datacenter.Spec.Openstack.AuthURL}}
+	sizes, err := providercache.Fetch(context.Background(), cache, key, func(context.Context) ([]apiv1.OpenstackSize, error) {
+		flavors, err := openstack.GetFlavors(datacenter.Spec.Openstack.AuthURL,
+			datacenter.Spec.Openstack.Region, credentials, caBundle)
+		if err != nil {
+			return nil, err
+		}
+
+		sizes := []apiv1.OpenstackSize{}
+		for _, flavor := range flavors {
+			sizes = append(sizes, apiv1.OpenstackSize{
+				Slug:     flavor.Name,
+				Memory:   flavor.RAM,
+				VCPUs:    flavor.VCPUs,
+				Disk:     flavor.Disk,
+				Swap:     flavor.Swap,
+				Region:   datacenter.Spec.Openstack.Region,
+				IsPublic: flavor.IsPublic,
+			})
+		}
+		return sizes, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	apiSizes := []apiv1.OpenstackSize{}
-	for _, flavor := range flavors {
-		apiSize := apiv1.OpenstackSize{
-			Slug:     flavor.Name,
-			Memory:   flavor.RAM,
-			VCPUs:    flavor.VCPUs,
-			Disk:     flavor.Disk,
-			Swap:     flavor.Swap,
-			Region:   datacenter.Spec.Openstack.Region,
-			IsPublic: flavor.IsPublic,
-		}
+	for _, apiSize := range sizes {
 		if MeetsOpenstackNodeSizeRequirement(apiSize, datacenter.Spec.Openstack.NodeSizeRequirements) {
 			if IsFlavorEnabled(apiSize, datacenter.Spec.Openstack.EnabledFlavors) {
 				apiSizes = append(apiSizes, apiSize)
@@ -495,28 +514,31 @@ func IsFlavorEnabled(apiSize apiv1.OpenstackSize, enabledFlavors []string) bool
 	return false
 }
 
-func GetOpenstackSecurityGroups(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, datacenterName string, caBundle *x509.CertPool) ([]apiv1.OpenstackSecurityGroup, error) {
+func GetOpenstackSecurityGroups(ctx context.Context, cache *providercache.Cache, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, datacenterName string, caBundle *x509.CertPool) ([]apiv1.OpenstackSecurityGroup, error) {
 	authURL, region, err := getOpenstackAuthURLAndRegion(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, err
 	}
 
-	securityGroups, err := openstack.GetSecurityGroups(ctx, authURL, region, credentials, caBundle)
-	if err != nil {
-		return nil, err
-	}
-
-	apiSecurityGroups := []apiv1.OpenstackSecurityGroup{}
-	for _, securityGroup := range securityGroups {
-		apiSecurityGroup := apiv1.OpenstackSecurityGroup{
-			Name: securityGroup.Name,
-			ID:   securityGroup.ID,
+	key := providercache.Key{Provider: "openstack", Datacenter: datacenterName, Resource: "securitygroups", Credentials: credentialsKey(credentials)}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) ([]apiv1.OpenstackSecurityGroup, error) {
+		securityGroups, err := openstack.GetSecurityGroups(ctx, authURL, region, credentials, caBundle)
+		if err != nil {
+			return nil, err
 		}
 
-		apiSecurityGroups = append(apiSecurityGroups, apiSecurityGroup)
-	}
+		apiSecurityGroups := []apiv1.OpenstackSecurityGroup{}
+		for _, securityGroup := range securityGroups {
+			apiSecurityGroup := apiv1.OpenstackSecurityGroup{
+				Name: securityGroup.Name,
+				ID:   securityGroup.ID,
+			}
+
+			apiSecurityGroups = append(apiSecurityGroups, apiSecurityGroup)
+		}
 
-	return apiSecurityGroups, nil
+		return apiSecurityGroups, nil
+	})
 }
 
 func GetOpenstackServerGroups(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, credentials *resources.OpenstackCredentials, datacenterName string, caBundle *x509.CertPool) ([]apiv2.OpenstackServerGroup, error) {
@@ -30,10 +30,11 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/cloud/vsphere"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/providercache"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
-func VsphereNetworksWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
+func VsphereNetworksWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter, projectID, clusterID string, caBundle *x509.CertPool,
 ) (interface{}, error) {
@@ -42,10 +43,10 @@ func VsphereNetworksWithClusterCredentialsEndpoint(ctx context.Context, userInfo
 		return nil, err
 	}
 
-	return GetVsphereNetworks(ctx, userInfo, seedsGetter, username, password, datacenterName, caBundle)
+	return GetVsphereNetworks(ctx, cache, userInfo, seedsGetter, username, password, datacenterName, caBundle)
 }
 
-func VsphereFoldersWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
+func VsphereFoldersWithClusterCredentialsEndpoint(ctx context.Context, cache *providercache.Cache, userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	seedsGetter provider.SeedsGetter, projectID, clusterID string, caBundle *x509.CertPool,
 ) (interface{}, error) {
@@ -54,7 +55,7 @@ func VsphereFoldersWithClusterCredentialsEndpoint(ctx context.Context, userInfoG
 		return nil, err
 	}
 
-	return GetVsphereFolders(ctx, userInfo, seedsGetter, username, password, datacenterName, caBundle)
+	return GetVsphereFolders(ctx, cache, userInfo, seedsGetter, username, password, datacenterName, caBundle)
 }
 
 func VsphereVMGroupsWithClusterCredentialsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
@@ -93,28 +94,31 @@ func VSphereTagsForTagCategoryWithClusterCredentialsEndpoint(ctx context.Context
 	return GetVsphereTagsForTagCategory(ctx, userInfo, seedsGetter, username, password, datacenterName, tagCategory, caBundle)
 }
 
-func GetVsphereNetworks(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, datacenterName string, caBundle *x509.CertPool) ([]apiv1.VSphereNetwork, error) {
+func GetVsphereNetworks(ctx context.Context, cache *providercache.Cache, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, datacenterName string, caBundle *x509.CertPool) ([]apiv1.VSphereNetwork, error) {
 	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find Datacenter %q: %w", datacenterName, err)
 	}
 
-	networks, err := vsphere.GetNetworks(ctx, datacenter.Spec.VSphere, username, password, caBundle)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiNetworks []apiv1.VSphereNetwork
-	for _, net := range networks {
-		apiNetworks = append(apiNetworks, apiv1.VSphereNetwork{
-			Name:         net.Name,
-			Type:         net.Type,
-			RelativePath: net.RelativePath,
-			AbsolutePath: net.AbsolutePath,
-		})
-	}
-
-	return apiNetworks, nil
+	key := providercache.Key{Provider: "vsphere", Datacenter: datacenterName, Resource: "networks", Credentials: []string{username, password}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) ([]apiv1.VSphereNetwork, error) {
+		networks, err := vsphere.GetNetworks(ctx, datacenter.Spec.VSphere, username, password, caBundle)
+		if err != nil {
+			return nil, err
+		}
+
+		var apiNetworks []apiv1.VSphereNetwork
+		for _, net := range networks {
+			apiNetworks = append(apiNetworks, apiv1.VSphereNetwork{
+				Name:         net.Name,
+				Type:         net.Type,
+				RelativePath: net.RelativePath,
+				AbsolutePath: net.AbsolutePath,
+			})
+		}
+
+		return apiNetworks, nil
+	})
 }
 
 func GetVsphereTagCategories(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, datacenterName string, caBundle *x509.CertPool) ([]apiv2.VSphereTagCategory, error) {
@@ -165,23 +169,26 @@ func GetVsphereTagsForTagCategory(ctx context.Context, userInfo *provider.UserIn
 	return tags, nil
 }
 
-func GetVsphereFolders(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, datacenterName string, caBundle *x509.CertPool) ([]apiv1.VSphereFolder, error) {
+func GetVsphereFolders(ctx context.Context, cache *providercache.Cache, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, datacenterName string, caBundle *x509.CertPool) ([]apiv1.VSphereFolder, error) {
 	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find Datacenter %q: %w", datacenterName, err)
 	}
 
-	folders, err := vsphere.GetVMFolders(ctx, datacenter.Spec.VSphere, username, password, caBundle)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get folders: %w", err)
-	}
+	key := providercache.Key{Provider: "vsphere", Datacenter: datacenterName, Resource: "folders", Credentials: []string{username, password}}
+	return providercache.Fetch(ctx, cache, key, func(ctx context.Context) ([]apiv1.VSphereFolder, error) {
+		folders, err := vsphere.GetVMFolders(ctx, datacenter.Spec.VSphere, username, password, caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get folders: %w", err)
+		}
 
-	var apiFolders []apiv1.VSphereFolder
-	for _, folder := range folders {
-		apiFolders = append(apiFolders, apiv1.VSphereFolder{Path: folder.Path})
-	}
+		var apiFolders []apiv1.VSphereFolder
+		for _, folder := range folders {
+			apiFolders = append(apiFolders, apiv1.VSphereFolder{Path: folder.Path})
+		}
 
-	return apiFolders, nil
+		return apiFolders, nil
+	})
 }
 
 func GetVsphereDatastoreList(ctx context.Context, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password,
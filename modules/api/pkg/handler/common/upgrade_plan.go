@@ -0,0 +1,408 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
+	"k8c.io/kubermatic/v2/pkg/version"
+	clusterversion "k8c.io/kubermatic/v2/pkg/version/cluster"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	upgradeCheckTargetVersion        = "TargetVersion"
+	upgradeCheckVersionSkew          = "VersionSkew"
+	upgradeCheckRemovedAPIs          = "RemovedAPIs"
+	upgradeCheckPodDisruptionBudgets = "PodDisruptionBudgets"
+	upgradeCheckAddons               = "Addons"
+	upgradeCheckApplications         = "Applications"
+
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// removedAPI is a group version of a kind which is no longer served from a Kubernetes minor version on.
+type removedAPI struct {
+	groupVersion string
+	kind         string
+	// replacement is the group version the objects can still be read through in the current cluster,
+	// empty if the kind was removed entirely.
+	replacement string
+	removedIn   uint64
+}
+
+// removedAPIs are the APIs removed since the oldest Kubernetes version supported by KKP,
+// see https://kubernetes.io/docs/reference/using-api/deprecation-guide/.
+var removedAPIs = []removedAPI{
+	{groupVersion: "batch/v1beta1", kind: "CronJob", replacement: "batch/v1", removedIn: 25},
+	{groupVersion: "discovery.k8s.io/v1beta1", kind: "EndpointSlice", replacement: "discovery.k8s.io/v1", removedIn: 25},
+	{groupVersion: "events.k8s.io/v1beta1", kind: "Event", replacement: "events.k8s.io/v1", removedIn: 25},
+	{groupVersion: "autoscaling/v2beta1", kind: "HorizontalPodAutoscaler", replacement: "autoscaling/v2", removedIn: 25},
+	{groupVersion: "policy/v1beta1", kind: "PodDisruptionBudget", replacement: "policy/v1", removedIn: 25},
+	{groupVersion: "policy/v1beta1", kind: "PodSecurityPolicy", removedIn: 25},
+	{groupVersion: "node.k8s.io/v1beta1", kind: "RuntimeClass", replacement: "node.k8s.io/v1", removedIn: 25},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta1", kind: "FlowSchema", replacement: "flowcontrol.apiserver.k8s.io/v1beta2", removedIn: 26},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta1", kind: "PriorityLevelConfiguration", replacement: "flowcontrol.apiserver.k8s.io/v1beta2", removedIn: 26},
+	{groupVersion: "autoscaling/v2beta2", kind: "HorizontalPodAutoscaler", replacement: "autoscaling/v2", removedIn: 26},
+	{groupVersion: "storage.k8s.io/v1beta1", kind: "CSIStorageCapacity", replacement: "storage.k8s.io/v1", removedIn: 27},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta2", kind: "FlowSchema", replacement: "flowcontrol.apiserver.k8s.io/v1beta3", removedIn: 29},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta2", kind: "PriorityLevelConfiguration", replacement: "flowcontrol.apiserver.k8s.io/v1beta3", removedIn: 29},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta3", kind: "FlowSchema", replacement: "flowcontrol.apiserver.k8s.io/v1", removedIn: 32},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta3", kind: "PriorityLevelConfiguration", replacement: "flowcontrol.apiserver.k8s.io/v1", removedIn: 32},
+}
+
+// removedAPIsBetween returns the APIs removed by an upgrade from the current to the target version.
+func removedAPIsBetween(current, target *semverlib.Version) []removedAPI {
+	var result []removedAPI
+	for _, api := range removedAPIs {
+		if current.Major() == target.Major() && api.removedIn > current.Minor() && api.removedIn <= target.Minor() {
+			result = append(result, api)
+		}
+	}
+	return result
+}
+
+// usesRemovedAPI tells whether the object was last written through the removed group version, either
+// by a field manager or by kubectl apply. Objects of kinds without a replacement always use it.
+func usesRemovedAPI(object *metav1.PartialObjectMetadata, api removedAPI) bool {
+	if api.replacement == "" {
+		return true
+	}
+	for _, entry := range object.ManagedFields {
+		if entry.APIVersion == api.groupVersion {
+			return true
+		}
+	}
+	if lastApplied, ok := object.Annotations[lastAppliedConfigAnnotation]; ok {
+		applied := metav1.TypeMeta{}
+		if err := json.Unmarshal([]byte(lastApplied), &applied); err == nil && applied.APIVersion == api.groupVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// upgradePlanSteps orders the upgrades of the control plane and the machine deployments. Kubelets which
+// would be too old for the target version are upgraded to the current version before the control plane.
+func upgradePlanSteps(clusterName string, current, target *semverlib.Version, machineDeployments []clusterv1alpha1.MachineDeployment) ([]apiv1.UpgradePlanStep, []string, error) {
+	var before, after []apiv1.UpgradePlanStep
+	var skewed []string
+
+	sort.Slice(machineDeployments, func(i, j int) bool {
+		return machineDeployments[i].Name < machineDeployments[j].Name
+	})
+
+	for _, md := range machineDeployments {
+		kubeletVersion, err := semverlib.NewVersion(md.Spec.Template.Spec.Versions.Kubelet)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse the kubelet version of machine deployment %q: %w", md.Name, err)
+		}
+
+		from := kubeletVersion.String()
+		if err := nodeupdate.EnsureVersionCompatible(target, kubeletVersion); err != nil {
+			skewed = append(skewed, fmt.Sprintf("%s: kubelet %s", md.Name, kubeletVersion))
+			if !kubeletVersion.Equal(current) {
+				before = append(before, apiv1.UpgradePlanStep{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: md.Name, From: from, To: current.String()})
+				from = current.String()
+			}
+		}
+		if !kubeletVersion.Equal(target) {
+			after = append(after, apiv1.UpgradePlanStep{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: md.Name, From: from, To: target.String()})
+		}
+	}
+
+	steps := before
+	if !current.Equal(target) {
+		steps = append(steps, apiv1.UpgradePlanStep{Kind: apiv1.UpgradePlanStepControlPlane, Name: clusterName, From: current.String(), To: target.String()})
+	}
+	steps = append(steps, after...)
+
+	for i := range steps {
+		steps[i].Order = i + 1
+	}
+	return steps, skewed, nil
+}
+
+// upgradePlanVerdict returns the verdict for the worst result of the checks.
+func upgradePlanVerdict(checks []apiv1.UpgradePreflightCheck) string {
+	verdict := apiv1.UpgradePlanSafe
+	for _, check := range checks {
+		switch check.Status {
+		case apiv1.UpgradePreflightCheckFailed:
+			return apiv1.UpgradePlanBlocked
+		case apiv1.UpgradePreflightCheckWarning:
+			verdict = apiv1.UpgradePlanWarning
+		}
+	}
+	return verdict
+}
+
+// newUpgradePreflightCheck returns a passed check without details, and a check with the given status otherwise.
+func newUpgradePreflightCheck(name, status, passedMessage, message string, details []string) apiv1.UpgradePreflightCheck {
+	if len(details) == 0 {
+		return apiv1.UpgradePreflightCheck{Name: name, Status: apiv1.UpgradePreflightCheckPassed, Message: passedMessage}
+	}
+	sort.Strings(details)
+	return apiv1.UpgradePreflightCheck{Name: name, Status: status, Message: message, Details: details}
+}
+
+// GetUpgradePlanEndpoint runs the preflight checks of an upgrade of the cluster to the target version and
+// returns the ordered upgrade steps. Nothing is changed in the cluster.
+func GetUpgradePlanEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID, targetVersion string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, configGetter provider.KubermaticConfigurationGetter) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	target, err := semverlib.NewVersion(targetVersion)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid target version: %v", err)
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	current := cluster.Spec.Version.Semver()
+	if target.LessThan(current) {
+		return nil, utilerrors.NewBadRequest("the target version %s is older than the current version %s", target, current)
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	targetCheck, err := checkUpgradeTargetVersion(ctx, cluster, current, target, configGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, skewed, err := upgradePlanSteps(cluster.Name, current, target, machineDeployments.Items)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+
+	removedAPICheck, err := checkRemovedAPIs(ctx, client, current, target)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	pdbCheck, err := checkPodDisruptionBudgets(ctx, client)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	addonCheck, err := checkAddons(ctx, userInfoGetter, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	applicationCheck, err := checkApplications(ctx, client)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	checks := []apiv1.UpgradePreflightCheck{
+		targetCheck,
+		newUpgradePreflightCheck(upgradeCheckVersionSkew, apiv1.UpgradePreflightCheckWarning,
+			"All kubelets are compatible with the target version.",
+			fmt.Sprintf("Some kubelets are too old for %s and are upgraded to %s before the control plane.", target, current),
+			skewed),
+		removedAPICheck,
+		pdbCheck,
+		addonCheck,
+		applicationCheck,
+	}
+
+	return &apiv1.ClusterUpgradePlan{
+		CurrentVersion: current.String(),
+		TargetVersion:  target.String(),
+		Verdict:        upgradePlanVerdict(checks),
+		Checks:         checks,
+		Steps:          steps,
+	}, nil
+}
+
+func checkUpgradeTargetVersion(ctx context.Context, cluster *kubermaticv1.Cluster, current, target *semverlib.Version, configGetter provider.KubermaticConfigurationGetter) (apiv1.UpgradePreflightCheck, error) {
+	check := apiv1.UpgradePreflightCheck{Name: upgradeCheckTargetVersion}
+
+	if current.Equal(target) {
+		check.Status = apiv1.UpgradePreflightCheckPassed
+		check.Message = "The control plane already runs the target version."
+		return check, nil
+	}
+
+	providerName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
+	if err != nil {
+		return check, fmt.Errorf("failed to get the cloud provider name: %w", err)
+	}
+
+	config, err := configGetter(ctx)
+	if err != nil {
+		return check, err
+	}
+
+	versions, err := version.NewFromConfiguration(config).GetPossibleUpdates(current.String(), kubermaticv1.ProviderType(providerName), clusterversion.GetVersionConditions(&cluster.Spec)...)
+	if err != nil {
+		return check, err
+	}
+
+	for _, v := range versions {
+		if v.Version.Equal(target) {
+			check.Status = apiv1.UpgradePreflightCheckPassed
+			check.Message = fmt.Sprintf("The upgrade from %s to %s is allowed.", current, target)
+			return check, nil
+		}
+	}
+
+	check.Status = apiv1.UpgradePreflightCheckFailed
+	check.Message = fmt.Sprintf("The upgrade from %s to %s is not allowed for this cluster.", current, target)
+	for _, v := range versions {
+		check.Details = append(check.Details, v.Version.String())
+	}
+	return check, nil
+}
+
+// checkRemovedAPIs finds the objects in the user cluster which were written through an API that is
+// removed in the target version.
+func checkRemovedAPIs(ctx context.Context, client ctrlruntimeclient.Client, current, target *semverlib.Version) (apiv1.UpgradePreflightCheck, error) {
+	var details []string
+
+	for _, api := range removedAPIsBetween(current, target) {
+		listGroupVersion := api.replacement
+		if listGroupVersion == "" {
+			listGroupVersion = api.groupVersion
+		}
+		gv, err := schema.ParseGroupVersion(listGroupVersion)
+		if err != nil {
+			return apiv1.UpgradePreflightCheck{}, err
+		}
+
+		objects := &metav1.PartialObjectMetadataList{}
+		objects.SetGroupVersionKind(gv.WithKind(api.kind + "List"))
+		if err := client.List(ctx, objects); err != nil {
+			// the kind is not served, so there can't be any objects using it
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return apiv1.UpgradePreflightCheck{}, err
+		}
+
+		for i := range objects.Items {
+			if usesRemovedAPI(&objects.Items[i], api) {
+				name := objects.Items[i].Name
+				if objects.Items[i].Namespace != "" {
+					name = objects.Items[i].Namespace + "/" + name
+				}
+				details = append(details, fmt.Sprintf("%s %s uses %s", api.kind, name, api.groupVersion))
+			}
+		}
+	}
+
+	return newUpgradePreflightCheck(upgradeCheckRemovedAPIs, apiv1.UpgradePreflightCheckWarning,
+		"No objects use APIs removed in the target version.",
+		"Some objects are managed through APIs removed in the target version, their manifests need to be migrated.",
+		details), nil
+}
+
+// checkPodDisruptionBudgets finds the budgets which currently allow no disruptions and would block
+// the draining of nodes during the rollout of the machine deployments.
+func checkPodDisruptionBudgets(ctx context.Context, client ctrlruntimeclient.Client) (apiv1.UpgradePreflightCheck, error) {
+	pdbs := &policyv1.PodDisruptionBudgetList{}
+	if err := client.List(ctx, pdbs); err != nil {
+		return apiv1.UpgradePreflightCheck{}, err
+	}
+
+	var details []string
+	for _, pdb := range pdbs.Items {
+		if pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed == 0 {
+			details = append(details, fmt.Sprintf("%s/%s", pdb.Namespace, pdb.Name))
+		}
+	}
+
+	return newUpgradePreflightCheck(upgradeCheckPodDisruptionBudgets, apiv1.UpgradePreflightCheckWarning,
+		"No pod disruption budgets block the draining of nodes.",
+		"Some pod disruption budgets allow no disruptions and would block the draining of nodes.",
+		details), nil
+}
+
+func checkAddons(ctx context.Context, userInfoGetter provider.UserInfoGetter, cluster *kubermaticv1.Cluster, projectID string) (apiv1.UpgradePreflightCheck, error) {
+	addons, err := listAddons(ctx, userInfoGetter, cluster, projectID)
+	if err != nil {
+		return apiv1.UpgradePreflightCheck{}, err
+	}
+
+	var details []string
+	for _, addon := range addons {
+		for conditionType, condition := range addon.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				details = append(details, fmt.Sprintf("%s: %s is %s", addon.Name, conditionType, condition.Status))
+			}
+		}
+	}
+
+	return newUpgradePreflightCheck(upgradeCheckAddons, apiv1.UpgradePreflightCheckWarning,
+		"All addons are reconciled.",
+		"Some addons are not reconciled, fix them before the upgrade so they can be updated for the target version.",
+		details), nil
+}
+
+func checkApplications(ctx context.Context, client ctrlruntimeclient.Client) (apiv1.UpgradePreflightCheck, error) {
+	applications := &appskubermaticv1.ApplicationInstallationList{}
+	if err := client.List(ctx, applications); err != nil && !meta.IsNoMatchError(err) {
+		return apiv1.UpgradePreflightCheck{}, err
+	}
+
+	var details []string
+	for _, application := range applications.Items {
+		for conditionType, condition := range application.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				details = append(details, fmt.Sprintf("%s/%s: %s is %s", application.Namespace, application.Name, conditionType, condition.Status))
+			}
+		}
+	}
+
+	return newUpgradePreflightCheck(upgradeCheckApplications, apiv1.UpgradePreflightCheckWarning,
+		"All applications are installed and ready.",
+		"Some applications are not ready, check that their versions support the target version.",
+		details), nil
+}
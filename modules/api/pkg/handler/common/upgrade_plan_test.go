@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func machineDeploymentWithKubelet(name, kubelet string) clusterv1alpha1.MachineDeployment {
+	md := clusterv1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	md.Spec.Template.Spec.Versions.Kubelet = kubelet
+	return md
+}
+
+func TestUpgradePlanSteps(t *testing.T) {
+	current := semverlib.MustParse("1.30.5")
+	target := semverlib.MustParse("1.31.2")
+
+	steps, skewed, err := upgradePlanSteps("abc123", current, target, []clusterv1alpha1.MachineDeployment{
+		machineDeploymentWithKubelet("workers", "1.30.5"),
+		machineDeploymentWithKubelet("legacy", "1.27.0"),
+		machineDeploymentWithKubelet("canary", "1.31.2"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []apiv1.UpgradePlanStep{
+		{Order: 1, Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "legacy", From: "1.27.0", To: "1.30.5"},
+		{Order: 2, Kind: apiv1.UpgradePlanStepControlPlane, Name: "abc123", From: "1.30.5", To: "1.31.2"},
+		{Order: 3, Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "legacy", From: "1.30.5", To: "1.31.2"},
+		{Order: 4, Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "workers", From: "1.30.5", To: "1.31.2"},
+	}
+	if !reflect.DeepEqual(steps, expected) {
+		t.Errorf("expected steps\n%+v\ngot\n%+v", expected, steps)
+	}
+	if !reflect.DeepEqual(skewed, []string{"legacy: kubelet 1.27.0"}) {
+		t.Errorf("expected the legacy machine deployment to be skewed, got %v", skewed)
+	}
+
+	if _, _, err := upgradePlanSteps("abc123", current, target, []clusterv1alpha1.MachineDeployment{machineDeploymentWithKubelet("broken", "latest")}); err == nil {
+		t.Error("expected an error for an invalid kubelet version")
+	}
+}
+
+func TestRemovedAPIs(t *testing.T) {
+	apis := removedAPIsBetween(semverlib.MustParse("1.24.9"), semverlib.MustParse("1.25.0"))
+	if len(apis) != 7 {
+		t.Fatalf("expected the 7 APIs removed in 1.25, got %d", len(apis))
+	}
+	if apis := removedAPIsBetween(semverlib.MustParse("1.29.0"), semverlib.MustParse("1.29.3")); len(apis) != 0 {
+		t.Errorf("expected no removed APIs for a patch upgrade, got %v", apis)
+	}
+
+	cronJobs := removedAPI{groupVersion: "batch/v1beta1", kind: "CronJob", replacement: "batch/v1", removedIn: 25}
+
+	testCases := []struct {
+		name     string
+		object   metav1.PartialObjectMetadata
+		expected bool
+	}{
+		{
+			name: "managed through the removed version",
+			object: metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "helm", APIVersion: "batch/v1beta1"}},
+			}},
+			expected: true,
+		},
+		{
+			name: "applied through the removed version",
+			object: metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{lastAppliedConfigAnnotation: `{"apiVersion":"batch/v1beta1","kind":"CronJob"}`},
+			}},
+			expected: true,
+		},
+		{
+			name: "migrated",
+			object: metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "helm", APIVersion: "batch/v1"}},
+				Annotations:   map[string]string{lastAppliedConfigAnnotation: `{"apiVersion":"batch/v1","kind":"CronJob"}`},
+			}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := usesRemovedAPI(&tc.object, cronJobs); result != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestUpgradePlanVerdict(t *testing.T) {
+	passed := apiv1.UpgradePreflightCheck{Status: apiv1.UpgradePreflightCheckPassed}
+	warning := apiv1.UpgradePreflightCheck{Status: apiv1.UpgradePreflightCheckWarning}
+	failed := apiv1.UpgradePreflightCheck{Status: apiv1.UpgradePreflightCheckFailed}
+
+	if verdict := upgradePlanVerdict([]apiv1.UpgradePreflightCheck{passed, passed}); verdict != apiv1.UpgradePlanSafe {
+		t.Errorf("expected %s, got %s", apiv1.UpgradePlanSafe, verdict)
+	}
+	if verdict := upgradePlanVerdict([]apiv1.UpgradePreflightCheck{passed, warning}); verdict != apiv1.UpgradePlanWarning {
+		t.Errorf("expected %s, got %s", apiv1.UpgradePlanWarning, verdict)
+	}
+	if verdict := upgradePlanVerdict([]apiv1.UpgradePreflightCheck{warning, failed, passed}); verdict != apiv1.UpgradePlanBlocked {
+		t.Errorf("expected %s, got %s", apiv1.UpgradePlanBlocked, verdict)
+	}
+}
@@ -20,15 +20,20 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
 	"reflect"
+	"strconv"
+	"time"
 
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/kubermatic/v2/pkg/log"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
 const (
 	headerContentType = "Content-Type"
+	headerRetryAfter  = "Retry-After"
 
 	contentTypeJSON = "application/json"
 )
@@ -90,6 +95,12 @@ func ErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
 		},
 	}
 
+	// rejected calls tell the client when to retry
+	var retryErr interface{ RetryAfter() time.Duration }
+	if errors.As(err, &retryErr) {
+		w.Header().Set(headerRetryAfter, strconv.Itoa(int(math.Ceil(retryErr.RetryAfter().Seconds()))))
+	}
+
 	w.Header().Set(headerContentType, contentTypeJSON)
 	w.WriteHeader(errorCode)
 	err = EncodeJSON(ctx, w, e)
@@ -102,6 +113,16 @@ func ErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
 func EncodeJSON(c context.Context, w http.ResponseWriter, response interface{}) (err error) {
 	w.Header().Set(headerContentType, contentTypeJSON)
 
+	// Paginated lists carry their metadata in the response headers.
+	if list, ok := response.(common.ListResponse); ok {
+		for key, values := range list.Headers() {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+		response = list.Body
+	}
+
 	// As long as we pipe the response from the listers we need this.
 	// The listers might return a uninitialized slice in case it has no results.
 	// This results to "null" when marshaling to json.
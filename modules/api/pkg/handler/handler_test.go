@@ -19,9 +19,13 @@ package handler
 import (
 	"context"
 	"io"
+	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
 func TestEncodeJSON(t *testing.T) {
@@ -60,3 +64,27 @@ func TestEncodeJSON(t *testing.T) {
 		}
 	}
 }
+
+type retryAfterError struct {
+	utilerrors.HTTPError
+}
+
+func (e retryAfterError) Unwrap() error {
+	return e.HTTPError
+}
+
+func (e retryAfterError) RetryAfter() time.Duration {
+	return 1500 * time.Millisecond
+}
+
+func TestErrorEncoderRetryAfter(t *testing.T) {
+	writer := httptest.NewRecorder()
+	ErrorEncoder(context.Background(), retryAfterError{utilerrors.New(http.StatusTooManyRequests, "slow down")}, writer)
+
+	if writer.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status code %d, got %d", http.StatusTooManyRequests, writer.Code)
+	}
+	if retryAfter := writer.Header().Get("Retry-After"); retryAfter != "2" {
+		t.Fatalf("expected the Retry-After header to be rounded up to 2 seconds, got %q", retryAfter)
+	}
+}
@@ -21,16 +21,27 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-kit/kit/endpoint"
 	transporthttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/audit"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	"k8c.io/dashboard/v2/pkg/ratelimit"
+	"k8c.io/dashboard/v2/pkg/serviceaccount"
+	"k8c.io/dashboard/v2/pkg/tracing"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/log"
 	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
@@ -127,6 +138,9 @@ const (
 	// PrivilegedOperatingSystemProfileProviderContextKey key under which the current PrivilegedOperatingSystemProfileProvider is kept in the ctx.
 	PrivilegedOperatingSystemProfileProviderContextKey kubermaticcontext.Key = "privileged-operatingsystemprofile-provider"
 
+	// RequestInfoContextKey key under which the method and route of the current request are kept in the ctx.
+	RequestInfoContextKey kubermaticcontext.Key = "request-info"
+
 	UserCRContextKey                            = kubermaticcontext.UserCRContextKey
 	SeedsGetterContextKey kubermaticcontext.Key = "seeds-getter"
 )
@@ -262,6 +276,16 @@ func UserInfoUnauthorized(userProjectMapper provider.ProjectMemberMapper, userPr
 	}
 }
 
+// TokenOperation marks endpoints which grant write or admin access regardless of their HTTP method, so that
+// TokenVerifier rejects read-only tokens for them. It must precede TokenVerifier in the chain.
+func TokenOperation(operation authtypes.TokenOperation) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			return next(authtypes.WithTokenOperation(ctx, operation), request)
+		}
+	}
+}
+
 // TokenVerifier knows how to verify a token from the incoming request.
 func TokenVerifier(tokenVerifier authtypes.TokenVerifier, userProvider provider.UserProvider) endpoint.Middleware {
 	return func(next endpoint.Endpoint) endpoint.Endpoint {
@@ -376,6 +400,7 @@ func getAddonProvider(ctx context.Context, clusterProviderGetter provider.Cluste
 // TokenExtractor knows how to extract a token from the incoming request.
 func TokenExtractor(o authtypes.TokenExtractor) transporthttp.RequestFunc {
 	return func(ctx context.Context, r *http.Request) context.Context {
+		ctx = authtypes.WithTokenRequest(ctx, r)
 		token, err := o.Extract(r)
 		if err != nil {
 			return context.WithValue(ctx, noTokenFoundKey, err)
@@ -473,6 +498,241 @@ func checkBlockedTokens(ctx context.Context, email, token string, userProvider p
 	return nil
 }
 
+// Paginate is a middleware that applies the list options of the request to the slice returned by the endpoint.
+// The request must implement common.ListOptionsGetter. Responses of requests without any list options are returned unchanged.
+func Paginate() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			getter, ok := request.(common.ListOptionsGetter)
+			if !ok {
+				return nil, utilerrors.New(http.StatusInternalServerError, "you can only use Paginate for endpoints that accept list options")
+			}
+
+			response, err = next(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+
+			options := getter.GetListOptions()
+			if !options.IsSet() {
+				return response, nil
+			}
+
+			page, err := common.PaginateList(options, response)
+			if err != nil {
+				return nil, err
+			}
+
+			return common.ListResponse{
+				Body:       page.Items,
+				Continue:   page.Continue,
+				TotalCount: page.TotalCount,
+			}, nil
+		}
+	}
+}
+
+// RequestInfo describes the HTTP request served by an endpoint.
+type RequestInfo struct {
+	Method string
+	// Route is the path template of the matched route, e.g. /api/v1/projects/{project_id}.
+	Route      string
+	Path       string
+	RemoteAddr string
+	UserAgent  string
+}
+
+// SetRequestInfo injects the RequestInfo of the current request into the ctx.
+func SetRequestInfo() transporthttp.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		info := RequestInfo{
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			RemoteAddr: r.RemoteAddr,
+			UserAgent:  r.UserAgent(),
+		}
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				info.Route = template
+			}
+		}
+		return context.WithValue(ctx, RequestInfoContextKey, info)
+	}
+}
+
+// StartEndpointSpan starts a span covering the decoding, the endpoint and the encoding of the response. The span is
+// named after the route, so it must run after SetRequestInfo. It is ended by EndEndpointSpan.
+func StartEndpointSpan() transporthttp.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		info, _ := ctx.Value(RequestInfoContextKey).(RequestInfo)
+		name := info.Route
+		if name == "" {
+			name = "unknown route"
+		}
+
+		ctx, _ = tracing.Tracer().Start(ctx, "endpoint "+name,
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("http.route", info.Route),
+			),
+		)
+		return ctx
+	}
+}
+
+// EndEndpointSpan ends the span started by StartEndpointSpan and records the status code of the response.
+func EndEndpointSpan() transporthttp.ServerFinalizerFunc {
+	return func(ctx context.Context, code int, _ *http.Request) {
+		span := trace.SpanFromContext(ctx)
+		span.SetAttributes(attribute.Int("http.response.status_code", code))
+		if code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(code))
+		}
+		span.End()
+	}
+}
+
+// SetProviderCacheRefresh makes the provider discovery endpoints bypass their cache when the request
+// carries the "Cache-Control: no-cache" header. The fresh responses replace the cached ones.
+func SetProviderCacheRefresh() transporthttp.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		for _, directive := range strings.Split(r.Header.Get("Cache-Control"), ",") {
+			if strings.EqualFold(strings.TrimSpace(directive), "no-cache") {
+				return providercache.WithRefresh(ctx)
+			}
+		}
+		return ctx
+	}
+}
+
+// Audit is a middleware that records every mutating call in the audit log. It must be placed after UserSaver
+// so that the authenticated user is known. Calls are recorded no matter whether they succeed, together with the
+// objects changed by the clients created with audit.NewRecordingClient.
+func Audit(auditLogger *audit.Logger, userInfoGetter provider.UserInfoGetter) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		if auditLogger == nil {
+			return next
+		}
+
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			info, _ := ctx.Value(RequestInfoContextKey).(RequestInfo)
+			switch info.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next(ctx, request)
+			}
+
+			ctx, changes := auditLogger.RecordChanges(ctx)
+			start := Now()
+			response, err = next(ctx, request)
+
+			entry := &audit.Entry{
+				Method:     info.Method,
+				Route:      info.Route,
+				Path:       info.Path,
+				RemoteAddr: info.RemoteAddr,
+				UserAgent:  info.UserAgent,
+				Changes:    changes(),
+				Outcome:    auditOutcome(err),
+				Duration:   Now().Sub(start).Milliseconds(),
+			}
+			if user, ok := ctx.Value(AuthenticatedUserContextKey).(apiv1.User); ok {
+				entry.User = user.Email
+				entry.Groups = user.Groups
+			}
+			if getter, ok := request.(common.ProjectIDGetter); ok {
+				entry.ProjectID = getter.GetProjectID()
+			}
+			if getter, ok := request.(seedClusterGetter); ok {
+				entry.ClusterID = getter.GetSeedCluster().ClusterID
+			}
+			// the membership may be gone after the call, e.g. when the project was deleted
+			if userInfo, uErr := userInfoGetter(ctx, entry.ProjectID); uErr == nil {
+				entry.Admin = userInfo.IsAdmin
+				entry.ImpersonatedGroups = userInfo.Groups
+			}
+			auditLogger.Log(ctx, entry)
+
+			return response, err
+		}
+	}
+}
+
+func auditOutcome(err error) audit.Outcome {
+	if err == nil {
+		return audit.Outcome{Code: http.StatusOK}
+	}
+
+	var httpErr utilerrors.HTTPError
+	if errors.As(err, &httpErr) {
+		return audit.Outcome{Code: httpErr.StatusCode(), Error: httpErr.Error()}
+	}
+	return audit.Outcome{Code: http.StatusInternalServerError, Error: err.Error()}
+}
+
+// RateLimit is a middleware that rejects calls exceeding the rate or concurrency limits of the user, the service
+// account token or the project. It must be placed after TokenVerifier so that the caller is known.
+func RateLimit(limiter *ratelimit.Limiter) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		if limiter == nil {
+			return next
+		}
+
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			info, _ := ctx.Value(RequestInfoContextKey).(RequestInfo)
+
+			release, err := limiter.Acquire(ratelimit.Classify(info.Method, info.Route), rateLimitKeys(ctx, request)...)
+			if err != nil {
+				var rejected *ratelimit.RejectedError
+				if errors.As(err, &rejected) {
+					return nil, &rateLimitError{HTTPError: utilerrors.New(http.StatusTooManyRequests, rejected.Error()), retryAfter: rejected.RetryAfter}
+				}
+				return nil, err
+			}
+			defer release()
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// rateLimitKeys returns the keys of the caller and the project. Calls authenticated with a service account token are
+// limited per token, so that each token of a service account gets its own bucket.
+func rateLimitKeys(ctx context.Context, request interface{}) []ratelimit.Key {
+	var keys []ratelimit.Key
+
+	if user, ok := ctx.Value(AuthenticatedUserContextKey).(apiv1.User); ok {
+		key := ratelimit.UserKey(user.Email)
+		if kubermaticv1helper.IsProjectServiceAccount(user.Email) {
+			// the token was verified by the TokenVerifier already
+			if token, ok := ctx.Value(RawTokenContextKey).(string); ok {
+				if _, claims, err := serviceaccount.ParseUnverified(token); err == nil && claims.TokenID != "" {
+					key = ratelimit.TokenKey(claims.TokenID)
+				}
+			}
+		}
+		keys = append(keys, key)
+	}
+	if getter, ok := request.(common.ProjectIDGetter); ok {
+		keys = append(keys, ratelimit.ProjectKey(getter.GetProjectID()))
+	}
+
+	return keys
+}
+
+// rateLimitError is returned for rejected calls, it tells the ErrorEncoder when the call can be retried.
+type rateLimitError struct {
+	utilerrors.HTTPError
+	retryAfter time.Duration
+}
+
+func (e *rateLimitError) Unwrap() error {
+	return e.HTTPError
+}
+
+func (e *rateLimitError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
 // SetSeedsGetter injects the current SeedsGetter into the ctx.
 func SetSeedsGetter(seedsGetter provider.SeedsGetter) transporthttp.RequestFunc {
 	return func(ctx context.Context, r *http.Request) context.Context {
@@ -52,6 +52,22 @@ func (r Routing) RegisterV1Admin(mux *mux.Router) {
 		Path("/admin/settings").
 		Handler(r.patchKubermaticSettings())
 
+	mux.Methods(http.MethodGet).
+		Path("/admin/audit").
+		Handler(r.listAuditEntries())
+
+	mux.Methods(http.MethodDelete).
+		Path("/admin/providers/cache").
+		Handler(r.invalidateProviderCache())
+
+	mux.Methods(http.MethodGet).
+		Path("/admin/terminal/recordings").
+		Handler(r.listTerminalRecordings())
+
+	mux.Methods(http.MethodGet).
+		Path("/admin/terminal/recordings/{cluster_id}/{recording_name}").
+		Handler(r.getTerminalRecording())
+
 	// Defines a set of HTTP endpoints for the admission plugins
 	mux.Methods(http.MethodGet).
 		Path("/admin/admission/plugins").
@@ -152,6 +168,7 @@ func (r Routing) getKubermaticSettings() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.KubermaticSettingsEndpoint(r.settingsProvider)),
 		common.DecodeEmptyReq,
@@ -197,7 +214,9 @@ func (r Routing) patchKubermaticSettings() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.UpdateKubermaticSettingsEndpoint(r.userInfoGetter, r.settingsProvider)),
 		admin.DecodePatchKubermaticSettingsReq,
 		EncodeJSON,
@@ -221,6 +240,7 @@ func (r Routing) getAdmins() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.GetAdminEndpoint(r.userInfoGetter, r.adminProvider)),
 		common.DecodeEmptyReq,
@@ -249,7 +269,9 @@ func (r Routing) setAdmin() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.SetAdminEndpoint(r.userInfoGetter, r.adminProvider)),
 		admin.DecodeSetAdminReq,
 		EncodeJSON,
@@ -257,6 +279,110 @@ func (r Routing) setAdmin() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/admin/audit admin listAuditEntries
+//
+//	Returns the recent audit log entries of mutating API calls, newest first.
+//
+//	The entries are kept in the memory of the API replica serving the request only. The result is best-effort: it only contains the calls served by that replica and starts over when the replica restarts. The complete audit log is written to the configured sinks.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []AuditEntry
+//	  401: empty
+//	  403: empty
+func (r Routing) listAuditEntries() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ListAuditEntriesEndpoint(r.userInfoGetter, r.auditLogger)),
+		admin.DecodeListAuditEntriesReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v1/admin/providers/cache admin invalidateProviderCache
+//
+//	Removes the cached responses of the provider discovery endpoints, e.g. after the quotas or the
+//	networks of a provider changed.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProviderCacheInvalidation
+//	  401: empty
+//	  403: empty
+func (r Routing) invalidateProviderCache() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(admin.InvalidateProviderCacheEndpoint(r.userInfoGetter, r.seedsGetter, r.presetProvider, r.providerCache)),
+		admin.DecodeInvalidateProviderCacheReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/admin/terminal/recordings admin listTerminalRecordings
+//
+//	Lists the recorded web terminal sessions.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []TerminalRecording
+//	  401: empty
+//	  403: empty
+func (r Routing) listTerminalRecordings() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ListTerminalRecordingsEndpoint(r.userInfoGetter, r.recordingStore)),
+		admin.DecodeListTerminalRecordingsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/admin/terminal/recordings/{cluster_id}/{recording_name} admin getTerminalRecording
+//
+//	Downloads a recorded web terminal session in the asciinema v2 format.
+//
+//	Produces:
+//	- application/x-asciicast
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: TerminalRecordingContent
+//	  401: empty
+//	  403: empty
+func (r Routing) getTerminalRecording() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(admin.GetTerminalRecordingEndpoint(r.userInfoGetter, r.recordingStore)),
+		admin.DecodeGetTerminalRecordingReq,
+		admin.EncodeTerminalRecording,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/admin/admission/plugins admin listAdmissionPlugins
 //
 //	Returns all admission plugins from the CRDs.
@@ -273,6 +399,7 @@ func (r Routing) listAdmissionPlugins() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.ListAdmissionPluginEndpoint(r.userInfoGetter, r.admissionPluginProvider)),
 		common.DecodeEmptyReq,
@@ -297,6 +424,7 @@ func (r Routing) getAdmissionPlugin() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.GetAdmissionPluginEndpoint(r.userInfoGetter, r.admissionPluginProvider)),
 		admin.DecodeAdmissionPluginReq,
@@ -321,7 +449,9 @@ func (r Routing) deleteAdmissionPlugin() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.DeleteAdmissionPluginEndpoint(r.userInfoGetter, r.admissionPluginProvider)),
 		admin.DecodeAdmissionPluginReq,
 		EncodeJSON,
@@ -348,7 +478,9 @@ func (r Routing) updateAdmissionPlugin() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.UpdateAdmissionPluginEndpoint(r.userInfoGetter, r.admissionPluginProvider)),
 		admin.DecodeUpdateAdmissionPluginReq,
 		EncodeJSON,
@@ -372,7 +504,9 @@ func (r Routing) createSeed() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.CreateSeedEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
 		admin.DecodeCreateSeedReq,
 		EncodeJSON,
@@ -396,6 +530,7 @@ func (r Routing) listSeeds() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.ListSeedEndpoint(r.userInfoGetter, r.seedsGetter)),
 		common.DecodeEmptyReq,
@@ -420,6 +555,7 @@ func (r Routing) getSeed() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.GetSeedEndpoint(r.userInfoGetter, r.seedsGetter)),
 		admin.DecodeSeedReq,
@@ -447,7 +583,9 @@ func (r Routing) updateSeed() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.UpdateSeedEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
 		admin.DecodeUpdateSeedReq,
 		EncodeJSON,
@@ -471,7 +609,9 @@ func (r Routing) deleteSeed() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.DeleteSeedEndpoint(r.userInfoGetter, r.seedsGetter, r.masterClient)),
 		admin.DecodeSeedReq,
 		EncodeJSON,
@@ -495,7 +635,9 @@ func (r Routing) deleteBackupDestination() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.DeleteBackupDestinationEndpoint(r.userInfoGetter, r.seedsGetter, r.masterClient)),
 		admin.DecodeBackupDestinationReq,
 		EncodeJSON,
@@ -519,7 +661,9 @@ func (r Routing) createOrUpdateMeteringCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.CreateOrUpdateMeteringCredentials(r.userInfoGetter, r.seedsGetter, r.seedsClientGetter)),
 		admin.DecodeMeteringSecretReq,
 		EncodeJSON,
@@ -543,7 +687,9 @@ func (r Routing) createOrUpdateMeteringConfigurations() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.CreateOrUpdateMeteringConfigurations(r.userInfoGetter, r.seedsGetter, r.masterClient)),
 		admin.DecodeMeteringConfigurationsReq,
 		EncodeJSON,
@@ -567,6 +713,7 @@ func (r Routing) GetMeteringReportConfiguration() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.GetMeteringReportConfigurationEndpoint(r.userInfoGetter, r.seedsGetter)),
 		admin.DecodeGetMeteringReportConfigurationReq,
@@ -591,6 +738,7 @@ func (r Routing) ListMeteringReportConfigurations() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.ListMeteringReportConfigurationsEndpoint(r.userInfoGetter, r.seedsGetter)),
 		common.DecodeEmptyReq,
@@ -618,7 +766,9 @@ func (r Routing) CreateMeteringReportConfiguration() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.CreateMeteringReportConfigurationEndpoint(r.userInfoGetter, r.seedsGetter, r.masterClient)),
 		admin.DecodeCreateMeteringReportConfigurationReq,
 		SetStatusCreatedHeader(EncodeJSON),
@@ -645,7 +795,9 @@ func (r Routing) UpdateMeteringReportConfiguration() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.UpdateMeteringReportConfigurationEndpoint(r.userInfoGetter, r.seedsGetter, r.masterClient)),
 		admin.DecodeUpdateMeteringReportConfigurationReq,
 		EncodeJSON,
@@ -669,7 +821,9 @@ func (r Routing) DeleteMeteringReportConfiguration() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.DeleteMeteringReportConfigurationEndpoint(r.userInfoGetter, r.seedsGetter, r.masterClient)),
 		admin.DecodeDeleteMeteringReportConfigurationReq,
 		EncodeJSON,
@@ -693,6 +847,7 @@ func (r Routing) listMeteringReports() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.ListMeteringReportsEndpoint(r.userInfoGetter, r.seedsGetter, r.seedsClientGetter)),
 		admin.DecodeListMeteringReportReq,
@@ -718,6 +873,7 @@ func (r Routing) getMeteringReport() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(admin.GetMeteringReportEndpoint(r.userInfoGetter, r.seedsGetter, r.seedsClientGetter)),
 		admin.DecodeGetMeteringReportReq,
@@ -726,6 +882,34 @@ func (r Routing) getMeteringReport() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/admin/metering/export metering reports exportMeteringReports
+//
+// Aggregates the metering reports of a configuration in a date range by project, cluster, label or period. The aggregation is returned as JSON or downloaded as a CSV or Parquet file with a column per dimension and value. Only available in Kubermatic Enterprise Edition
+//
+// Produces:
+// - application/json
+// - text/csv
+// - application/vnd.apache.parquet
+//
+// Responses:
+//
+//	default: errorResponse
+//	200: MeteringReportAggregation
+//	401: empty
+//	403: empty
+func (r Routing) exportMeteringReports() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ExportMeteringReportsEndpoint(r.userInfoGetter, r.seedsGetter, r.seedsClientGetter)),
+		admin.DecodeExportMeteringReportReq,
+		admin.EncodeMeteringReportExport,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route DELETE /api/v1/admin/metering/reports/{report_name} metering report deleteMeteringReport
 //
 // Removes a specific metering report. Only available in Kubermatic Enterprise Edition
@@ -743,7 +927,9 @@ func (r Routing) deleteMeteringReport() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(admin.DeleteMeteringReportEndpoint(r.userInfoGetter, r.seedsGetter, r.seedsClientGetter)),
 		admin.DecodeDeleteMeteringReportReq,
 		EncodeJSON,
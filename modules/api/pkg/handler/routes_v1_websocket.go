@@ -18,10 +18,12 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"sync"
 	"time"
 
@@ -31,10 +33,12 @@ import (
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/sse"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	wsh "k8c.io/dashboard/v2/pkg/handler/websocket"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/recording"
 	"k8c.io/dashboard/v2/pkg/watcher"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/log"
@@ -79,9 +83,10 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-type WebsocketSettingsWriter func(ctx context.Context, providers watcher.Providers, ws *websocket.Conn)
-type WebsocketUserWriter func(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, userEmail string)
-type WebsocketTerminalWriter func(ctx context.Context, ws *websocket.Conn, client, seedClient ctrlruntimeclient.Client, k8sClient kubernetes.Interface, cfg *rest.Config, userEmailID string, cluster *kubermaticv1.Cluster, options *kubermaticv1.WebTerminalOptions, oidcIssuerVerifier authtypes.OIDCIssuerVerifier, kubeconfigSecret *corev1.Secret, overwriteRegistry string)
+type WebsocketSettingsWriter func(ctx context.Context, providers watcher.Providers, ws wsh.Conn, lastEventID string)
+type WebsocketUserWriter func(ctx context.Context, providers watcher.Providers, ws wsh.Conn, userEmail, lastEventID string)
+type WebsocketProjectWriter func(ctx context.Context, providers watcher.Providers, ws wsh.Conn, projectID, resourceVersion string, checkAccess wsh.ProjectAccessChecker)
+type WebsocketTerminalWriter func(ctx context.Context, ws *websocket.Conn, client, seedClient ctrlruntimeclient.Client, k8sClient kubernetes.Interface, cfg *rest.Config, userEmailID string, cluster *kubermaticv1.Cluster, options *kubermaticv1.WebTerminalOptions, oidcIssuerVerifier authtypes.OIDCIssuerVerifier, kubeconfigSecret *corev1.Secret, overwriteRegistry string, recorder *recording.Recorder, shared *wsh.SharedSession)
 
 const (
 	maxNumberOfTerminalActiveConnectionsPerUser = 5
@@ -91,16 +96,29 @@ const (
 
 func (r Routing) RegisterV1Websocket(mux *mux.Router, overwriteRegistry string) {
 	providers := getProviders(r)
+	// owners and guests of shared sessions count against the same limit of terminal connections
+	terminalConnections := newTerminalConnections(terminalActiveConnectionsMemoryDuration)
+	// shared sessions only live on the replica of their owner, their IDs name the replica
+	replica, err := os.Hostname()
+	if err != nil {
+		log.Logger.Warnw("Failed to get the hostname for the shared terminal sessions", "error", err)
+	}
+	sharedSessions := wsh.NewSharedSessions(replica)
 
 	mux.HandleFunc("/ws/admin/settings", getSettingsWatchHandler(wsh.WriteSettings, providers, r))
 	mux.HandleFunc("/ws/me", getUserWatchHandler(wsh.WriteUser, providers, r))
-	mux.HandleFunc("/ws/projects/{project_id}/clusters/{cluster_id}/terminal", getTerminalWatchHandler(wsh.Terminal, providers, r, maxNumberOfTerminalActiveConnectionsPerUser, terminalActiveConnectionsMemoryDuration, overwriteRegistry))
+	mux.HandleFunc("/ws/projects/{project_id}/watch", getProjectWatchHandler(wsh.WriteProjectEvents, providers, r))
+	mux.HandleFunc("/ws/projects/{project_id}/clusters/{cluster_id}/terminal", getTerminalWatchHandler(wsh.Terminal, providers, r, terminalConnections, maxNumberOfTerminalActiveConnectionsPerUser, sharedSessions, overwriteRegistry))
+	mux.HandleFunc("/ws/projects/{project_id}/clusters/{cluster_id}/terminal/sessions/{session_id}", getSharedTerminalHandler(providers, r, terminalConnections, maxNumberOfTerminalActiveConnectionsPerUser, sharedSessions))
+	mux.HandleFunc("/ws/projects/{project_id}/clusters/{cluster_id}/namespaces/{namespace}/pods/{pod}/exec", getPodExecHandler(providers, r))
+	mux.HandleFunc("/ws/projects/{project_id}/clusters/{cluster_id}/namespaces/{namespace}/pods/{pod}/logs", getPodLogsHandler(providers, r))
 }
 
 func getProviders(r Routing) watcher.Providers {
 	return watcher.Providers{
 		SettingsProvider:          r.settingsProvider,
 		SettingsWatcher:           r.settingsWatcher,
+		ProjectWatcher:            r.projectWatcher,
 		UserProvider:              r.userProvider,
 		UserWatcher:               r.userWatcher,
 		MemberMapper:              r.userProjectMapper,
@@ -114,41 +132,129 @@ func getProviders(r Routing) watcher.Providers {
 	}
 }
 
+// openWatchConn opens the connection for a watch stream. Every watch route supports both transports and the
+// client selects one per request: websocket upgrade requests get a websocket, requests accepting text/event-stream
+// get a Server-Sent Events stream. The returned function blocks until the client disconnected.
+func openWatchConn(w http.ResponseWriter, req *http.Request) (wsh.Conn, func(), error) {
+	if !websocket.IsWebSocketUpgrade(req) && sse.IsEventStreamRequest(req) {
+		stream, err := sse.NewStream(w, sse.DefaultHeartbeatInterval)
+		if err != nil {
+			return nil, nil, err
+		}
+		return stream, func() { stream.Wait(req.Context()) }, nil
+	}
+
+	ws, err := upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ws, func() { requestLoggingReader(ws) }, nil
+}
+
+// runWatch runs the writer of a watch stream until the client disconnected. The context of the writer is cancelled
+// then and the handler only returns once the writer returned as well, so the writer never uses the connection or
+// the response after the handler returned.
+func runWatch(ctx context.Context, writer func(ctx context.Context), wait func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		writer(ctx)
+	}()
+
+	wait()
+	cancel()
+	<-done
+}
+
 func getSettingsWatchHandler(writer WebsocketSettingsWriter, providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
 	return func(w http.ResponseWriter, req *http.Request) {
-		_, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors)
+		_, err := verifyAuthorizationToken(req, "", routing.tokenVerifiers, routing.tokenExtractors)
 		if err != nil {
 			log.Logger.Debug(err)
 			return
 		}
 
-		ws, err := upgrader.Upgrade(w, req, nil)
+		ws, wait, err := openWatchConn(w, req)
 		if err != nil {
 			log.Logger.Debug(err)
 			return
 		}
 
-		go writer(req.Context(), providers, ws)
-		requestLoggingReader(ws)
+		lastEventID := req.Header.Get(sse.LastEventIDHeader)
+		runWatch(req.Context(), func(ctx context.Context) { writer(ctx, providers, ws, lastEventID) }, wait)
 	}
 }
 
 func getUserWatchHandler(writer WebsocketUserWriter, providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
 	return func(w http.ResponseWriter, req *http.Request) {
-		user, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors)
+		user, err := verifyAuthorizationToken(req, "", routing.tokenVerifiers, routing.tokenExtractors)
 		if err != nil {
 			log.Logger.Debug(err)
 			return
 		}
 
-		ws, err := upgrader.Upgrade(w, req, nil)
+		ws, wait, err := openWatchConn(w, req)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		lastEventID := req.Header.Get(sse.LastEventIDHeader)
+		runWatch(req.Context(), func(ctx context.Context) { writer(ctx, providers, ws, user.Email, lastEventID) }, wait)
+	}
+}
+
+// getProjectWatchHandler streams the changes of the project clusters, machine deployments, nodes and events.
+// Clients can pass the resourceVersion of the last received event to resume an interrupted watch.
+func getProjectWatchHandler(writer WebsocketProjectWriter, providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		authenticatedUser, err := verifyAuthorizationToken(req, "", routing.tokenVerifiers, routing.tokenExtractors)
 		if err != nil {
 			log.Logger.Debug(err)
+			ErrorEncoder(ctx, utilerrors.NewNotAuthorized(), w)
 			return
 		}
 
-		go writer(req.Context(), providers, ws, user.Email)
-		requestLoggingReader(ws)
+		projectReq, err := common.DecodeProjectRequest(ctx, req)
+		if err != nil {
+			ErrorEncoder(ctx, err, w)
+			return
+		}
+		projectID := projectReq.(common.ProjectReq).ProjectID
+
+		user, err := providers.UserProvider.UserByEmail(ctx, authenticatedUser.Email)
+		if err != nil {
+			ErrorEncoder(ctx, common.KubernetesErrorToHTTPError(err), w)
+			return
+		}
+		ctx = context.WithValue(ctx, kubermaticcontext.UserCRContextKey, user)
+
+		// The same check as for the REST endpoints, only members of the project and admins can watch it.
+		checkAccess := func(ctx context.Context) error {
+			_, err := common.GetProject(ctx, providers.UserInfoGetter, providers.ProjectProvider, providers.PrivilegedProjectProvider, projectID, nil)
+			return err
+		}
+		if err := checkAccess(ctx); err != nil {
+			ErrorEncoder(ctx, common.KubernetesErrorToHTTPError(err), w)
+			return
+		}
+
+		// Server-Sent Events clients pass the resource version of the last received event in the Last-Event-ID header.
+		resourceVersion := req.URL.Query().Get("resourceVersion")
+		if resourceVersion == "" {
+			resourceVersion = req.Header.Get(sse.LastEventIDHeader)
+		}
+
+		ws, wait, err := openWatchConn(w, req)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		runWatch(ctx, func(ctx context.Context) { writer(ctx, providers, ws, projectID, resourceVersion, checkAccess) }, wait)
 	}
 }
 
@@ -201,7 +307,7 @@ func (l *connections) releaseMemory() {
 	l.mutex.Unlock()
 }
 
-func getTerminalWatchHandler(writer WebsocketTerminalWriter, providers watcher.Providers, routing Routing, maxNumberOfConnections int, memoryDuration time.Duration, overwriteRegistry string) func(w http.ResponseWriter, req *http.Request) {
+func newTerminalConnections(memoryDuration time.Duration) *connections {
 	connectionsPerUser := newConnections()
 
 	// Cleaning the map from time to time to release the memory
@@ -212,28 +318,39 @@ func getTerminalWatchHandler(writer WebsocketTerminalWriter, providers watcher.P
 		}
 	}()
 
-	return func(w http.ResponseWriter, req *http.Request) {
-		ctx := req.Context()
+	return connectionsPerUser
+}
 
-		// Check if the Web Terminal is enabled
-		settings, err := providers.SettingsProvider.GetGlobalSettings(ctx)
-		if err != nil {
-			log.Logger.Debug(utilerrors.New(http.StatusInternalServerError, "could not read global settings"))
-			return
-		}
+func terminalConnectionKey(projectID, clusterID, email string) string {
+	return fmt.Sprintf("%s-%s-%s", projectID, clusterID, email)
+}
 
-		// Check if Web Terminal is enabled via WebTerminalOptions
-		webTerminalEnabled := false
-		if settings.Spec.WebTerminalOptions != nil && settings.Spec.WebTerminalOptions.Enabled != nil {
-			webTerminalEnabled = *settings.Spec.WebTerminalOptions.Enabled
-		}
+// isWebTerminalEnabled checks if the Web Terminal is enabled via WebTerminalOptions in the global settings.
+func isWebTerminalEnabled(ctx context.Context, providers watcher.Providers) (*kubermaticv1.KubermaticSetting, bool) {
+	settings, err := providers.SettingsProvider.GetGlobalSettings(ctx)
+	if err != nil {
+		log.Logger.Debug(utilerrors.New(http.StatusInternalServerError, "could not read global settings"))
+		return nil, false
+	}
+
+	if settings.Spec.WebTerminalOptions == nil || settings.Spec.WebTerminalOptions.Enabled == nil || !*settings.Spec.WebTerminalOptions.Enabled {
+		log.Logger.Debug(utilerrors.New(http.StatusForbidden, "Web Terminal is disabled by the global settings"))
+		return settings, false
+	}
 
-		if !webTerminalEnabled {
-			log.Logger.Debug(utilerrors.New(http.StatusForbidden, "Web Terminal is disabled by the global settings"))
+	return settings, true
+}
+
+func getTerminalWatchHandler(writer WebsocketTerminalWriter, providers watcher.Providers, routing Routing, connectionsPerUser *connections, maxNumberOfConnections int, sharedSessions *wsh.SharedSessions, overwriteRegistry string) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		settings, enabled := isWebTerminalEnabled(ctx, providers)
+		if !enabled {
 			return
 		}
 
-		authenticatedUser, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors)
+		authenticatedUser, err := verifyAuthorizationToken(req, authtypes.TerminalTokenOperation, routing.tokenVerifiers, routing.tokenExtractors)
 		if err != nil {
 			log.Logger.Debug(err)
 			return
@@ -302,7 +419,7 @@ func getTerminalWatchHandler(writer WebsocketTerminalWriter, providers watcher.P
 		defer ws.Close()
 
 		// Checking user active connections for project cluster
-		userProjectClusterUniqueKey := fmt.Sprintf("%s-%s-%s", projectID, clusterID, authenticatedUser.Email)
+		userProjectClusterUniqueKey := terminalConnectionKey(projectID, clusterID, authenticatedUser.Email)
 		if connectionsPerUser.getActiveConnections(userProjectClusterUniqueKey) >= maxNumberOfConnections {
 			log.Logger.Debug("reached the maximum number of terminal active connections for the user")
 			_ = wsh.SendMessage(ws, string(wsh.ConnectionPoolExceeded))
@@ -327,10 +444,269 @@ func getTerminalWatchHandler(writer WebsocketTerminalWriter, providers watcher.P
 			return
 		}
 
-		writer(ctx, ws, client, seedClient, k8sClient, cfg, userEmailID, cluster, settings.Spec.WebTerminalOptions, oidcIssuerVerifier, kubeconfigSecret, overwriteRegistry)
+		// Sessions are only opened if they can be recorded, when the recording is enabled.
+		var recorder *recording.Recorder
+		if routing.recordingStore != nil {
+			recorder, err = recording.Start(ctx, routing.recordingStore, recording.Identity{
+				User:      authenticatedUser.Email,
+				ProjectID: projectID,
+				ClusterID: clusterID,
+			})
+			if err != nil {
+				log.Logger.Warnw("Failed to start the terminal recording", "cluster", clusterID, "error", err)
+				_ = wsh.SendMessage(ws, string(wsh.RecordingFailed))
+				return
+			}
+			defer func() {
+				if err := recorder.Close(); err != nil {
+					log.Logger.Warnw("Failed to save the terminal recording", "cluster", clusterID, "error", err)
+				}
+			}()
+		}
+
+		// Members of the project can join the session once the owner invited them.
+		shared, err := sharedSessions.Start(authenticatedUser.Email, projectID, clusterID, recorder)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		defer shared.Close()
+		if err := ws.WriteJSON(wsh.TerminalMessage{Op: "session", Data: shared.ID}); err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		writer(ctx, ws, client, seedClient, k8sClient, cfg, userEmailID, cluster, settings.Spec.WebTerminalOptions, oidcIssuerVerifier, kubeconfigSecret, overwriteRegistry, recorder, shared)
 	}
 }
 
+// getSharedTerminalHandler lets invited project members join the web terminal session of another member.
+// Guests see the output of the session and read-write guests can type into it. Sessions live on the replica of the
+// API server serving the owner, joining them through another replica fails with 421 Misdirected Request.
+func getSharedTerminalHandler(providers watcher.Providers, routing Routing, connectionsPerUser *connections, maxNumberOfConnections int, sharedSessions *wsh.SharedSessions) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		if _, enabled := isWebTerminalEnabled(ctx, providers); !enabled {
+			return
+		}
+
+		authenticatedUser, err := verifyAuthorizationToken(req, authtypes.TerminalTokenOperation, routing.tokenVerifiers, routing.tokenExtractors)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		clusterID, err := common.DecodeClusterID(ctx, req)
+		if err != nil {
+			return
+		}
+
+		projectReq, err := common.DecodeProjectRequest(ctx, req)
+		if err != nil {
+			return
+		}
+		projectID := projectReq.(common.ProjectReq).ProjectID
+
+		clusterProvider, ctx, err := middleware.GetClusterProvider(ctx, terminalReq{ClusterID: clusterID}, providers.SeedsGetter, providers.ClusterProviderGetter)
+		if err != nil {
+			return
+		}
+
+		user, err := providers.UserProvider.UserByEmail(ctx, authenticatedUser.Email)
+		if err != nil {
+			return
+		}
+		ctx = context.WithValue(ctx, middleware.ClusterProviderContextKey, clusterProvider)
+		ctx = context.WithValue(ctx, middleware.PrivilegedClusterProviderContextKey, clusterProvider.(provider.PrivilegedClusterProvider))
+		ctx = context.WithValue(ctx, kubermaticcontext.UserCRContextKey, user)
+
+		// only members of the project can join, even if they were invited
+		if _, err := handlercommon.GetCluster(ctx, providers.ProjectProvider, providers.PrivilegedProjectProvider, providers.UserInfoGetter, projectID, clusterID, nil); err != nil {
+			return
+		}
+
+		// the session is looked up before the upgrade, so clients and proxies see why the guest cannot join
+		shared, err := sharedSessions.Get(clusterID, mux.Vars(req)["session_id"])
+		switch {
+		case errors.Is(err, wsh.ErrSharedSessionOtherReplica):
+			http.Error(w, err.Error(), http.StatusMisdirectedRequest)
+			return
+		case err != nil || shared.ProjectID != projectID:
+			http.Error(w, wsh.ErrSharedSessionNotFound.Error(), http.StatusNotFound)
+			return
+		}
+		if err := shared.CanJoin(authenticatedUser.Email); err != nil {
+			status := http.StatusNotFound
+			if errors.Is(err, wsh.ErrSharedSessionForbidden) {
+				status = http.StatusForbidden
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		defer ws.Close()
+
+		userProjectClusterUniqueKey := terminalConnectionKey(projectID, clusterID, authenticatedUser.Email)
+		if connectionsPerUser.getActiveConnections(userProjectClusterUniqueKey) >= maxNumberOfConnections {
+			log.Logger.Debug("reached the maximum number of terminal active connections for the user")
+			_ = wsh.SendMessage(ws, string(wsh.ConnectionPoolExceeded))
+			return
+		}
+		connectionsPerUser.increaseActiveConnections(userProjectClusterUniqueKey)
+		defer connectionsPerUser.decreaseActiveConnections(userProjectClusterUniqueKey)
+
+		if err := shared.Join(ws, authenticatedUser.Email); err != nil {
+			log.Logger.Debug(err)
+			switch {
+			case errors.Is(err, wsh.ErrSharedSessionForbidden):
+				_ = wsh.SendMessage(ws, string(wsh.SharedSessionForbidden))
+			case errors.Is(err, wsh.ErrSharedSessionNotFound):
+				_ = wsh.SendMessage(ws, string(wsh.SharedSessionNotFound))
+			}
+		}
+	}
+}
+
+// getPodExecHandler executes a command in a container of a workload pod, like kubectl exec. The session uses the
+// impersonated client of the user, so the RBAC of the user cluster decides who can exec into which pod.
+func getPodExecHandler(providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		options, err := wsh.DecodePodExecOptions(req)
+		if err != nil {
+			ErrorEncoder(req.Context(), err, w)
+			return
+		}
+
+		ctx, pod, cfg, err := getUserClusterPod(req, authtypes.PodExecTokenOperation, providers, routing)
+		if err != nil {
+			ErrorEncoder(req.Context(), err, w)
+			return
+		}
+
+		if options.Container, err = wsh.SelectContainer(pod, options.Container); err != nil {
+			ErrorEncoder(ctx, err, w)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		defer ws.Close()
+
+		if err := wsh.PodExec(ctx, ws, cfg, pod, options); err != nil {
+			log.Logger.Debug(err)
+		}
+	}
+}
+
+// getPodLogsHandler streams the logs of a container of a workload pod, like kubectl logs.
+func getPodLogsHandler(providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		options, err := wsh.DecodePodLogOptions(req)
+		if err != nil {
+			ErrorEncoder(req.Context(), err, w)
+			return
+		}
+
+		ctx, pod, cfg, err := getUserClusterPod(req, "", providers, routing)
+		if err != nil {
+			ErrorEncoder(req.Context(), err, w)
+			return
+		}
+
+		if options.Container, err = wsh.SelectContainer(pod, options.Container); err != nil {
+			ErrorEncoder(ctx, err, w)
+			return
+		}
+
+		k8sClient, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			ErrorEncoder(ctx, err, w)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+		defer ws.Close()
+
+		if err := wsh.PodLogs(ctx, ws, k8sClient, pod, options); err != nil {
+			log.Logger.Debug(err)
+			_ = wsh.SendMessage(ws, err.Error())
+		}
+	}
+}
+
+// getUserClusterPod authenticates the request for the operation and returns the pod of the path together with the
+// client config of the user for its cluster. The pod is read with the impersonated client of the user, which checks
+// the access.
+func getUserClusterPod(req *http.Request, operation authtypes.TokenOperation, providers watcher.Providers, routing Routing) (context.Context, *corev1.Pod, *rest.Config, error) {
+	ctx := req.Context()
+
+	authenticatedUser, err := verifyAuthorizationToken(req, operation, routing.tokenVerifiers, routing.tokenExtractors)
+	if err != nil {
+		log.Logger.Debug(err)
+		return nil, nil, nil, utilerrors.NewNotAuthorized()
+	}
+
+	clusterID, err := common.DecodeClusterID(ctx, req)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	projectReq, err := common.DecodeProjectRequest(ctx, req)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	projectID := projectReq.(common.ProjectReq).ProjectID
+
+	namespace := mux.Vars(req)["namespace"]
+	podName := mux.Vars(req)["pod"]
+	if namespace == "" || podName == "" {
+		return nil, nil, nil, utilerrors.NewBadRequest("the namespace and the name of the pod are required")
+	}
+
+	clusterProvider, ctx, err := middleware.GetClusterProvider(ctx, terminalReq{ClusterID: clusterID}, providers.SeedsGetter, providers.ClusterProviderGetter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	user, err := providers.UserProvider.UserByEmail(ctx, authenticatedUser.Email)
+	if err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+	ctx = context.WithValue(ctx, middleware.ClusterProviderContextKey, clusterProvider)
+	ctx = context.WithValue(ctx, middleware.PrivilegedClusterProviderContextKey, clusterProvider.(provider.PrivilegedClusterProvider))
+	ctx = context.WithValue(ctx, kubermaticcontext.UserCRContextKey, user)
+
+	client, err := handlercommon.GetClusterClientWithClusterID(ctx, providers.UserInfoGetter, providers.ProjectProvider, providers.PrivilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	pod := &corev1.Pod{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: podName}, pod); err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	cfg, err := handlercommon.GetClusterClientConfigWithClusterID(ctx, providers.UserInfoGetter, providers.ProjectProvider, providers.PrivilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return ctx, pod, cfg, nil
+}
+
 type terminalReq struct {
 	ClusterID string
 }
@@ -341,13 +717,16 @@ func (req terminalReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
-func verifyAuthorizationToken(req *http.Request, tokenVerifier authtypes.TokenVerifier, tokenExtractor authtypes.TokenExtractor) (*apiv1.User, error) {
+// verifyAuthorizationToken verifies the token of a websocket request. The operation is set for websockets which grant
+// write or admin access although they are opened with GET, so that read-only tokens are rejected for them.
+func verifyAuthorizationToken(req *http.Request, operation authtypes.TokenOperation, tokenVerifier authtypes.TokenVerifier, tokenExtractor authtypes.TokenExtractor) (*apiv1.User, error) {
 	token, err := tokenExtractor.Extract(req)
 	if err != nil {
 		return nil, err
 	}
 
-	claims, err := tokenVerifier.Verify(req.Context(), token)
+	ctx := authtypes.WithTokenOperation(authtypes.WithTokenRequest(req.Context(), req), operation)
+	claims, err := tokenVerifier.Verify(ctx, token)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/handler/auth"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/serviceaccount"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestVerifyAuthorizationTokenScope(t *testing.T) {
+	const signingKey = "eyJhbGciOiJIUzI1NeyJhbGciOiJIUzI1N"
+
+	tokenGenerator, err := serviceaccount.JWTTokenGenerator([]byte(signingKey))
+	if err != nil {
+		t.Fatalf("failed to create the token generator: %v", err)
+	}
+	genToken := func(id string, scope *serviceaccount.TokenScope) (string, *corev1.Secret) {
+		token, err := tokenGenerator.Generate(serviceaccount.ScopedClaims("serviceaccount-1@sa.kubermatic.io", "project", id, 0, scope))
+		if err != nil {
+			t.Fatalf("failed to generate the token: %v", err)
+		}
+		return token, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sa-token-" + id, Namespace: "kubermatic"},
+			Data:       map[string][]byte{"token": []byte(token)},
+		}
+	}
+	readOnlyToken, readOnlySecret := genToken("read-only", &serviceaccount.TokenScope{ReadOnly: true})
+	fullToken, fullSecret := genToken("full", nil)
+
+	client := fake.NewClientBuilder().WithObjects(readOnlySecret, fullSecret).Build()
+	tokenProvider, err := kubernetes.NewServiceAccountTokenProvider(func(restclient.ImpersonationConfig) (ctrlruntimeclient.Client, error) { return client, nil }, client)
+	if err != nil {
+		t.Fatalf("failed to create the token provider: %v", err)
+	}
+	saClient := auth.NewServiceAccountAuthClient(auth.NewHeaderBearerTokenExtractor("Authorization"), serviceaccount.JWTTokenAuthenticator([]byte(signingKey)), tokenProvider)
+
+	testcases := []struct {
+		name          string
+		token         string
+		operation     authtypes.TokenOperation
+		expectedError bool
+	}{
+		{
+			name:  "scenario 1: a read-only token can watch",
+			token: readOnlyToken,
+		},
+		{
+			name:          "scenario 2: a read-only token can not open the web terminal",
+			token:         readOnlyToken,
+			operation:     authtypes.TerminalTokenOperation,
+			expectedError: true,
+		},
+		{
+			name:          "scenario 3: a read-only token can not exec into pods",
+			token:         readOnlyToken,
+			operation:     authtypes.PodExecTokenOperation,
+			expectedError: true,
+		},
+		{
+			name:      "scenario 4: an unscoped token can open the web terminal",
+			token:     fullToken,
+			operation: authtypes.TerminalTokenOperation,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/ws/projects/project/clusters/cluster/terminal", nil)
+			req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tc.token))
+
+			_, err := verifyAuthorizationToken(req, tc.operation, saClient, saClient)
+			if tc.expectedError != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
@@ -29,9 +29,18 @@ import (
 	prometheusapi "github.com/prometheus/client_golang/api"
 	"go.uber.org/zap"
 
+	"k8c.io/dashboard/v2/pkg/audit"
+	"k8c.io/dashboard/v2/pkg/credentials"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	upgradeworkflow "k8c.io/dashboard/v2/pkg/handler/v2/upgrade_workflow"
+	"k8c.io/dashboard/v2/pkg/healthhistory"
+	"k8c.io/dashboard/v2/pkg/presethealth"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	"k8c.io/dashboard/v2/pkg/ratelimit"
+	"k8c.io/dashboard/v2/pkg/recording"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	"k8c.io/dashboard/v2/pkg/watcher"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -47,6 +56,7 @@ type Routing struct {
 	logger                                log.Logger
 	versions                              kubermatic.Versions
 	presetProvider                        provider.PresetProvider
+	credentialResolver                    *credentials.Resolver
 	masterClient                          ctrlruntimeclient.Client
 	seedsGetter                           provider.SeedsGetter
 	seedsClientGetter                     provider.SeedClientGetter
@@ -76,10 +86,16 @@ type Routing struct {
 	exposeStrategy                        kubermaticv1.ExposeStrategy
 	userInfoGetter                        provider.UserInfoGetter
 	settingsProvider                      provider.SettingsProvider
+	chargebackSchemaProvider              provider.ChargebackSchemaProvider
 	adminProvider                         provider.AdminProvider
 	admissionPluginProvider               provider.AdmissionPluginsProvider
 	settingsWatcher                       watcher.SettingsWatcher
 	userWatcher                           watcher.UserWatcher
+	projectWatcher                        watcher.ProjectWatcher
+	auditLogger                           *audit.Logger
+	rateLimiter                           *ratelimit.Limiter
+	providerCache                         *providercache.Cache
+	recordingStore                        recording.Store
 	caBundle                              *x509.CertPool
 	features                              features.FeatureGate
 	seedProvider                          provider.SeedProvider
@@ -93,6 +109,7 @@ func NewRouting(routingParams RoutingParams, masterClient ctrlruntimeclient.Clie
 		log:                                   routingParams.Log,
 		logger:                                log.NewLogfmtLogger(os.Stderr),
 		presetProvider:                        routingParams.PresetProvider,
+		credentialResolver:                    routingParams.CredentialResolver,
 		masterClient:                          masterClient,
 		seedsGetter:                           routingParams.SeedsGetter,
 		seedsClientGetter:                     routingParams.SeedsClientGetter,
@@ -122,10 +139,16 @@ func NewRouting(routingParams RoutingParams, masterClient ctrlruntimeclient.Clie
 		exposeStrategy:                        routingParams.ExposeStrategy,
 		userInfoGetter:                        routingParams.UserInfoGetter,
 		settingsProvider:                      routingParams.SettingsProvider,
+		chargebackSchemaProvider:              routingParams.ChargebackSchemaProvider,
 		adminProvider:                         routingParams.AdminProvider,
 		admissionPluginProvider:               routingParams.AdmissionPluginProvider,
 		settingsWatcher:                       routingParams.SettingsWatcher,
 		userWatcher:                           routingParams.UserWatcher,
+		projectWatcher:                        routingParams.ProjectWatcher,
+		auditLogger:                           routingParams.AuditLogger,
+		rateLimiter:                           routingParams.RateLimiter,
+		providerCache:                         routingParams.ProviderCache,
+		recordingStore:                        routingParams.RecordingStore,
 		versions:                              routingParams.Versions,
 		caBundle:                              routingParams.CABundle,
 		features:                              routingParams.Features,
@@ -161,21 +184,28 @@ func (r Routing) defaultServerOptions() []httptransport.ServerOption {
 	provider := func() *http.Request {
 		return req
 	}
+	resolver := r.credentialResolver
 
 	return []httptransport.ServerOption{
 		httptransport.ServerBefore(func(c context.Context, r *http.Request) context.Context {
 			req = r
-			return c
+			// the credentials read during the request are resolved where they are used
+			return credentials.WithResolver(c, resolver)
 		}),
 		httptransport.ServerErrorHandler(NewRequestErrorHandler(r.log, provider)),
 		httptransport.ServerErrorEncoder(ErrorEncoder),
 		httptransport.ServerBefore(middleware.TokenExtractor(r.tokenExtractors)),
+		httptransport.ServerBefore(middleware.SetRequestInfo()),
+		httptransport.ServerBefore(middleware.StartEndpointSpan()),
+		httptransport.ServerFinalizer(middleware.EndEndpointSpan()),
+		httptransport.ServerBefore(middleware.SetProviderCacheRefresh()),
 	}
 }
 
 type RoutingParams struct {
 	Log                                            *zap.SugaredLogger
 	PresetProvider                                 provider.PresetProvider
+	CredentialResolver                             *credentials.Resolver
 	SeedsGetter                                    provider.SeedsGetter
 	SeedsClientGetter                              provider.SeedClientGetter
 	KubermaticConfigurationGetter                  provider.KubermaticConfigurationGetter
@@ -204,10 +234,20 @@ type RoutingParams struct {
 	ExposeStrategy                                 kubermaticv1.ExposeStrategy
 	UserInfoGetter                                 provider.UserInfoGetter
 	SettingsProvider                               provider.SettingsProvider
+	ChargebackSchemaProvider                       provider.ChargebackSchemaProvider
 	AdminProvider                                  provider.AdminProvider
 	AdmissionPluginProvider                        provider.AdmissionPluginsProvider
 	SettingsWatcher                                watcher.SettingsWatcher
 	UserWatcher                                    watcher.UserWatcher
+	ProjectWatcher                                 watcher.ProjectWatcher
+	AuditLogger                                    *audit.Logger
+	RateLimiter                                    *ratelimit.Limiter
+	HealthHistory                                  *healthhistory.History
+	PresetHealthChecker                            *presethealth.Checker
+	UpgradeWorkflows                               *upgradeworkflow.Workflows
+	PriceCatalog                                   pricing.Source
+	ProviderCache                                  *providercache.Cache
+	RecordingStore                                 recording.Store
 	ExternalClusterProvider                        provider.ExternalClusterProvider
 	PrivilegedExternalClusterProvider              provider.PrivilegedExternalClusterProvider
 	FeatureGatesProvider                           provider.FeatureGatesProvider
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sse implements a Server-Sent Events transport for the watch streams,
+// for clients which cannot use websockets, e.g. behind proxies stripping the upgrade.
+package sse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// ContentType is the media type of a Server-Sent Events stream.
+	ContentType = "text/event-stream"
+
+	// LastEventIDHeader is sent by clients reconnecting to a stream with the ID of the last received event.
+	LastEventIDHeader = "Last-Event-ID"
+
+	// DefaultHeartbeatInterval is the interval in which comments are sent to keep idle connections open.
+	DefaultHeartbeatInterval = 15 * time.Second
+)
+
+// ErrClosed is returned when writing to a closed stream.
+var ErrClosed = errors.New("stream is closed")
+
+// IsEventStreamRequest returns true if the client asked for a Server-Sent Events stream.
+func IsEventStreamRequest(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(accept, ",") {
+			mediaType, _, _ = strings.Cut(mediaType, ";")
+			if strings.EqualFold(strings.TrimSpace(mediaType), ContentType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Stream is a Server-Sent Events connection. It provides the subset of the websocket connection
+// methods used by the watch writers, so the writers do not have to know about the transport.
+type Stream struct {
+	w          http.ResponseWriter
+	flusher    http.Flusher
+	controller *http.ResponseController
+
+	lock         sync.Mutex
+	closed       bool
+	disconnected bool
+	closeHandler func(code int, text string) error
+	done         chan struct{}
+}
+
+// NewStream starts a Server-Sent Events response and sends a heartbeat comment in the given interval.
+func NewStream(w http.ResponseWriter, heartbeatInterval time.Duration) (*Stream, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming is not supported by the response writer")
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", ContentType)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// Disable response buffering in nginx based proxies.
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	s := &Stream{
+		w:          w,
+		flusher:    flusher,
+		controller: http.NewResponseController(w),
+		done:       make(chan struct{}),
+	}
+
+	if heartbeatInterval > 0 {
+		go s.heartbeat(heartbeatInterval)
+	}
+
+	return s, nil
+}
+
+func (s *Stream) heartbeat(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.write([]byte(": heartbeat\n\n")); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// WriteMessage sends the data as a single event without an ID.
+func (s *Stream) WriteMessage(messageType int, data []byte) error {
+	return s.WriteEvent("", data)
+}
+
+// WriteEvent sends the data as a single event. The client sends the ID back in the Last-Event-ID
+// header when it reconnects, so the stream can be resumed.
+func (s *Stream) WriteEvent(id string, data []byte) error {
+	if strings.ContainsAny(id, "\r\n") {
+		return fmt.Errorf("invalid event ID %q", id)
+	}
+
+	var buf bytes.Buffer
+	if id != "" {
+		fmt.Fprintf(&buf, "id: %s\n", id)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(bytes.TrimSuffix(line, []byte("\r")))
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+
+	return s.write(buf.Bytes())
+}
+
+// WriteControl only handles close messages, which end the stream. Pings are not needed as the stream
+// is kept open by the heartbeats.
+func (s *Stream) WriteControl(messageType int, data []byte, deadline time.Time) error {
+	if messageType == websocket.CloseMessage {
+		return s.Close()
+	}
+	return nil
+}
+
+// SetWriteDeadline sets the deadline for writing the following events.
+func (s *Stream) SetWriteDeadline(t time.Time) error {
+	if err := s.controller.SetWriteDeadline(t); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+// SetCloseHandler sets the handler which is called once the client disconnected. If the client already
+// disconnected, the handler is called right away, so that writers which subscribe after the client left
+// still release their subscriptions.
+func (s *Stream) SetCloseHandler(h func(code int, text string) error) {
+	s.lock.Lock()
+	if !s.disconnected {
+		s.closeHandler = h
+		s.lock.Unlock()
+		return
+	}
+	s.lock.Unlock()
+
+	if h != nil {
+		_ = h(websocket.CloseGoingAway, "")
+	}
+}
+
+// Close ends the stream.
+func (s *Stream) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+	return nil
+}
+
+// Wait blocks until the client disconnected or the stream was closed and calls the close handler.
+// The response must not be used after Wait returned.
+func (s *Stream) Wait(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-s.done:
+	}
+
+	_ = s.Close()
+
+	s.lock.Lock()
+	s.disconnected = true
+	closeHandler := s.closeHandler
+	s.closeHandler = nil
+	s.lock.Unlock()
+
+	if closeHandler != nil {
+		_ = closeHandler(websocket.CloseGoingAway, "")
+	}
+}
+
+func (s *Stream) write(data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	if _, err := s.w.Write(data); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sse_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/handler/sse"
+)
+
+func TestIsEventStreamRequest(t *testing.T) {
+	testcases := []struct {
+		name     string
+		accept   []string
+		expected bool
+	}{
+		{
+			name:     "scenario 1: event stream",
+			accept:   []string{"text/event-stream"},
+			expected: true,
+		},
+		{
+			name:     "scenario 2: event stream within a list with parameters",
+			accept:   []string{"application/json;q=0.9, Text/Event-Stream;q=1"},
+			expected: true,
+		},
+		{
+			name:     "scenario 3: no event stream",
+			accept:   []string{"application/json"},
+			expected: false,
+		},
+		{
+			name:     "scenario 4: no accept header",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/ws/me", nil)
+			for _, accept := range tc.accept {
+				req.Header.Add("Accept", accept)
+			}
+			if got := sse.IsEventStreamRequest(req); got != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	closed := make(chan int, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stream, err := sse.NewStream(w, 10*time.Millisecond)
+		if err != nil {
+			t.Errorf("failed to create stream: %v", err)
+			return
+		}
+		stream.SetCloseHandler(func(code int, text string) error {
+			closed <- code
+			return nil
+		})
+
+		if err := stream.WriteEvent("abc.1", []byte("{\"a\":1}\n{\"b\":2}")); err != nil {
+			t.Errorf("failed to write event: %v", err)
+		}
+		if err := stream.WriteMessage(1, []byte("plain")); err != nil {
+			t.Errorf("failed to write message: %v", err)
+		}
+		if err := stream.WriteEvent("invalid\nid", nil); err == nil {
+			t.Error("expected an error for an event ID with a line break")
+		}
+
+		stream.Wait(r.Context())
+
+		if err := stream.WriteMessage(1, []byte("after close")); err == nil {
+			t.Error("expected an error when writing to a closed stream")
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", sse.ContentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != sse.ContentType {
+		t.Fatalf("expected content type %q, got %q", sse.ContentType, contentType)
+	}
+
+	expected := []string{
+		"id: abc.1",
+		"data: {\"a\":1}",
+		"data: {\"b\":2}",
+		"",
+		"data: plain",
+		"",
+		": heartbeat",
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for _, line := range expected {
+		if !scanner.Scan() {
+			t.Fatalf("stream ended early: %v", scanner.Err())
+		}
+		if got := scanner.Text(); got != line {
+			t.Fatalf("expected line %q, got %q", line, got)
+		}
+	}
+
+	cancel()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("close handler was not called after the client disconnected")
+	}
+}
+
+func TestStreamHeartbeatStopsAfterClose(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	stream, err := sse.NewStream(recorder, time.Millisecond)
+	if err != nil {
+		t.Fatalf("failed to create stream: %v", err)
+	}
+
+	if err := stream.Close(); err != nil {
+		t.Fatalf("failed to close stream: %v", err)
+	}
+	stream.Wait(context.Background())
+
+	time.Sleep(10 * time.Millisecond)
+	if strings.Contains(recorder.Body.String(), "heartbeat") {
+		t.Fatal("expected no heartbeat after the stream was closed immediately")
+	}
+}
+
+func TestStreamCloseHandlerAfterDisconnect(t *testing.T) {
+	stream, err := sse.NewStream(httptest.NewRecorder(), 0)
+	if err != nil {
+		t.Fatalf("failed to create stream: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream.Wait(ctx)
+
+	// Writers set the handler once they subscribed, which can be after the client already left.
+	called := 0
+	stream.SetCloseHandler(func(code int, text string) error {
+		called++
+		return nil
+	})
+	if called != 1 {
+		t.Fatalf("expected the close handler to be called once, got %d", called)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/gorilla/securecookie"
 	"golang.org/x/oauth2"
@@ -34,6 +35,8 @@ const (
 	// AuthorizationCode represents a shared secret used by IssuerVerifier
 	// TODO: consider injecting it into IssuerVerifier.
 	AuthorizationCode = "fakeCode"
+	// DeviceCode is the device code of an approved device authorization.
+	DeviceCode = "fakeDeviceCode"
 	// IDToken represents a shared fake token.
 	IDToken       = "fakeTokenId"
 	IDViewerToken = "fakeViewerTokenId"
@@ -151,6 +154,29 @@ func (o *IssuerVerifier) RefreshAccessToken(ctx context.Context, refToken string
 	}, nil
 }
 
+// DeviceAuth simulates starting a device authorization.
+func (o *IssuerVerifier) DeviceAuth(ctx context.Context, offlineAsScope bool, scopes ...string) (authtypes.DeviceAuthorization, error) {
+	return authtypes.DeviceAuthorization{
+		DeviceCode:      DeviceCode,
+		UserCode:        "FAKE-CODE",
+		VerificationURI: IssuerURL + "/device",
+		Expiry:          time.Now().Add(5 * time.Minute),
+		Interval:        5,
+	}, nil
+}
+
+// DeviceAccessToken simulates an approved device authorization.
+func (o *IssuerVerifier) DeviceAccessToken(ctx context.Context, deviceCode string) (authtypes.OIDCToken, error) {
+	if deviceCode != DeviceCode {
+		return authtypes.OIDCToken{}, &authtypes.DeviceAuthorizationError{Code: "expired_token"}
+	}
+
+	return authtypes.OIDCToken{
+		IDToken:      IDToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
 // Verify parses a raw ID Token, verifies it's been signed by the provider, performs
 // any additional checks depending on the Config, and returns the payload as TokenClaims.
 func (o *IssuerVerifier) Verify(ctx context.Context, token string) (authtypes.TokenClaims, error) {
@@ -26,6 +26,8 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	v2 "k8c.io/dashboard/v2/pkg/handler/v2"
+	upgradeworkflow "k8c.io/dashboard/v2/pkg/handler/v2/upgrade_workflow"
+	"k8c.io/dashboard/v2/pkg/presethealth"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
@@ -162,6 +164,8 @@ func NewTestRouting(
 		PrivilegedIPAMPoolProviderGetter:               privilegedIPAMPoolProviderGetter,
 		PrivilegedOperatingSystemProfileProviderGetter: privilegedOperatingSystemProfileProviderGetter,
 		OIDCIssuerVerifierProviderGetter:               fakeOIDCVerifierIssuerGetter,
+		UpgradeWorkflows:                               upgradeworkflow.NewWorkflows(masterClient, "kubermatic"),
+		PresetHealthChecker:                            presethealth.NewChecker(presetProvider, seedsGetter, certificates.NewFakeCABundle().CertPool()),
 	}
 
 	r := handler.NewRouting(routingParams, masterClient)
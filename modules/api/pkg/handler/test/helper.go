@@ -389,7 +389,7 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		return nil, fmt.Errorf("can not find clusterprovider for cluster %q", seed.Name)
 	}
 
-	credentialsManager, err := kubernetes.NewPresetProvider(fakeMasterClient)
+	credentialsManager, err := kubernetes.NewPresetProvider(fakeMasterClient, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/audit"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// swagger:parameters listAuditEntries
+type listAuditEntriesReq struct {
+	// in: query
+	User string `json:"user,omitempty"`
+	// in: query
+	ProjectID string `json:"project_id,omitempty"`
+	// in: query
+	ClusterID string `json:"cluster_id,omitempty"`
+	// in: query
+	Method string `json:"method,omitempty"`
+	// Since returns only entries recorded at or after the given RFC 3339 timestamp
+	// in: query
+	Since string `json:"since,omitempty"`
+	// Failed returns only calls which did not succeed
+	// in: query
+	Failed bool `json:"failed,omitempty"`
+	// Limit is the maximum number of entries returned
+	// in: query
+	Limit int `json:"limit,omitempty"`
+
+	since time.Time
+}
+
+func (req listAuditEntriesReq) queryOptions() audit.QueryOptions {
+	return audit.QueryOptions{
+		User:       req.User,
+		ProjectID:  req.ProjectID,
+		ClusterID:  req.ClusterID,
+		Method:     req.Method,
+		Since:      req.since,
+		FailedOnly: req.Failed,
+		Limit:      req.Limit,
+	}
+}
+
+func DecodeListAuditEntriesReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req listAuditEntriesReq
+	query := r.URL.Query()
+
+	req.User = query.Get("user")
+	req.ProjectID = query.Get("project_id")
+	req.ClusterID = query.Get("cluster_id")
+	req.Method = strings.ToUpper(query.Get("method"))
+
+	if since := query.Get("since"); since != "" {
+		parsed, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for 'since': %v", err)
+		}
+		req.Since = since
+		req.since = parsed
+	}
+	if failed := query.Get("failed"); failed != "" {
+		parsed, err := strconv.ParseBool(failed)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for 'failed': %v", err)
+		}
+		req.Failed = parsed
+	}
+	if limit := query.Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed < 0 {
+			return nil, utilerrors.NewBadRequest("invalid value for 'limit': %q", limit)
+		}
+		req.Limit = parsed
+	}
+
+	return req, nil
+}
+
+// ListAuditEntriesEndpoint returns the recent audit log entries, newest first.
+func ListAuditEntriesEndpoint(userInfoGetter provider.UserInfoGetter, auditLogger *audit.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(listAuditEntriesReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !userInfo.IsAdmin {
+			return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+
+		result := []apiv1.AuditEntry{}
+		if auditLogger == nil {
+			return result, nil
+		}
+		for _, entry := range auditLogger.Query(req.queryOptions()) {
+			result = append(result, convertAuditEntry(entry))
+		}
+		return result, nil
+	}
+}
+
+func convertAuditEntry(entry audit.Entry) apiv1.AuditEntry {
+	return apiv1.AuditEntry{
+		ID:                 entry.ID,
+		Timestamp:          entry.Timestamp,
+		User:               entry.User,
+		Groups:             entry.Groups,
+		ImpersonatedGroups: entry.ImpersonatedGroups,
+		Admin:              entry.Admin,
+		ProjectID:          entry.ProjectID,
+		ClusterID:          entry.ClusterID,
+		Method:             entry.Method,
+		Route:              entry.Route,
+		Path:               entry.Path,
+		RemoteAddr:         entry.RemoteAddr,
+		UserAgent:          entry.UserAgent,
+		Changes:            convertAuditChanges(entry.Changes),
+		Code:               entry.Outcome.Code,
+		Error:              entry.Outcome.Error,
+		DurationMs:         entry.Duration,
+	}
+}
+
+func convertAuditChanges(changes []audit.Change) []apiv1.AuditChange {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	result := make([]apiv1.AuditChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, apiv1.AuditChange{
+			Kind:      change.Kind,
+			Namespace: change.Namespace,
+			Name:      change.Name,
+			Before:    change.Before,
+			After:     change.After,
+		})
+	}
+	return result
+}
@@ -19,6 +19,7 @@ package admin
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/go-kit/kit/endpoint"
 
@@ -223,3 +224,23 @@ func DeleteMeteringReportEndpoint(userInfoGetter provider.UserInfoGetter, seedsG
 		return nil, nil
 	}
 }
+
+// ExportMeteringReportsEndpoint aggregates the reports of a configuration.
+func ExportMeteringReportsEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		if !userInfo.IsAdmin {
+			return nil, apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+		}
+
+		return exportMeteringReports(ctx, req, seedsGetter, seedClientGetter)
+	}
+}
+
+// EncodeMeteringReportExport writes the aggregated reports as JSON or as a CSV or Parquet file download.
+func EncodeMeteringReportExport(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	return encodeMeteringReportExport(w, response)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// cachedProviders are the providers whose discovery responses are cached, by the name of their field in the preset spec.
+var cachedProviders = []string{"azure", "eks", "gcp", "openstack", "vsphere"}
+
+// swagger:parameters invalidateProviderCache
+type invalidateProviderCacheReq struct {
+	// Provider limits the invalidation to the responses of the provider, e.g. gcp
+	// in: query
+	Provider string `json:"provider,omitempty"`
+	// Datacenter limits the invalidation to the responses for the datacenter, including its region and zones, or
+	// for a region or zone
+	// in: query
+	Datacenter string `json:"datacenter,omitempty"`
+	// Credential limits the invalidation to the responses fetched with the credentials of the preset
+	// in: query
+	Credential string `json:"credential,omitempty"`
+}
+
+func DecodeInvalidateProviderCacheReq(c context.Context, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+
+	return invalidateProviderCacheReq{
+		Provider:   query.Get("provider"),
+		Datacenter: query.Get("datacenter"),
+		Credential: query.Get("credential"),
+	}, nil
+}
+
+// InvalidateProviderCacheEndpoint removes the cached responses of the provider discovery endpoints.
+func InvalidateProviderCacheEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider, cache *providercache.Cache) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(invalidateProviderCacheReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !userInfo.IsAdmin {
+			return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+
+		result := apiv1.ProviderCacheInvalidation{}
+		if cache == nil {
+			return result, nil
+		}
+
+		filter := providercache.Filter{Provider: req.Provider}
+		if req.Datacenter != "" {
+			filter.Datacenters = datacenterNames(userInfo, seedsGetter, req.Datacenter)
+		}
+		if req.Credential != "" {
+			filter.Credentials, err = presetCredentials(ctx, userInfo, presetProvider, req.Credential, req.Provider)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			// the preset has no credentials for the cached providers, so none of the responses were fetched with it
+			if len(filter.Credentials) == 0 {
+				return result, nil
+			}
+		}
+
+		result.Removed = cache.Invalidate(filter)
+		return result, nil
+	}
+}
+
+// datacenterNames returns the name of the datacenter together with the region and zones the responses for it are
+// cached by. Names which are not a datacenter, e.g. the region of an EKS cluster, are returned as they are.
+func datacenterNames(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, name string) []string {
+	names := []string{name}
+
+	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, name)
+	if err != nil {
+		return names
+	}
+	switch {
+	case datacenter.Spec.GCP != nil:
+		names = append(names, datacenter.Spec.GCP.Region)
+		for _, suffix := range datacenter.Spec.GCP.ZoneSuffixes {
+			names = append(names, fmt.Sprintf("%s-%s", datacenter.Spec.GCP.Region, suffix))
+		}
+	case datacenter.Spec.Openstack != nil:
+		names = append(names, datacenter.Spec.Openstack.Region)
+	case datacenter.Spec.Azure != nil:
+		names = append(names, datacenter.Spec.Azure.Location)
+	}
+	return names
+}
+
+// presetCredentials returns the credential values of the preset for the provider, or for all cached providers if
+// the provider is empty.
+func presetCredentials(ctx context.Context, userInfo *provider.UserInfo, presetProvider provider.PresetProvider, presetName, providerName string) ([]string, error) {
+	preset, err := presetProvider.GetPreset(ctx, userInfo, nil, presetName)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := cachedProviders
+	if providerName != "" {
+		providers = []string{providerName}
+	}
+
+	var values []string
+	for _, providerName := range providers {
+		resolved, err := presetProvider.ResolveCredentials(ctx, preset, providerName)
+		if err != nil {
+			return nil, err
+		}
+		providerPreset := reflect.ValueOf(&resolved.Spec).Elem().FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(name, providerName)
+		})
+		if !providerPreset.IsValid() || providerPreset.Kind() != reflect.Pointer || providerPreset.IsNil() {
+			continue
+		}
+		providerPreset = providerPreset.Elem()
+		for i := range providerPreset.NumField() {
+			if field := providerPreset.Field(i); field.Kind() == reflect.String && field.String() != "" {
+				values = append(values, field.String())
+			}
+		}
+	}
+	return values, nil
+}
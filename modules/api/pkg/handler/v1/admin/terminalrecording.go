@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/recording"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// swagger:parameters listTerminalRecordings
+type listTerminalRecordingsReq struct {
+	// ClusterID limits the list to the recordings of the cluster
+	// in: query
+	ClusterID string `json:"cluster_id,omitempty"`
+}
+
+func DecodeListTerminalRecordingsReq(c context.Context, r *http.Request) (interface{}, error) {
+	return listTerminalRecordingsReq{ClusterID: r.URL.Query().Get("cluster_id")}, nil
+}
+
+// swagger:parameters getTerminalRecording
+type getTerminalRecordingReq struct {
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+	// in: path
+	// required: true
+	RecordingName string `json:"recording_name"`
+}
+
+func DecodeGetTerminalRecordingReq(c context.Context, r *http.Request) (interface{}, error) {
+	req := getTerminalRecordingReq{
+		ClusterID:     mux.Vars(r)["cluster_id"],
+		RecordingName: mux.Vars(r)["recording_name"],
+	}
+	if err := recording.ValidateName(path.Join(req.ClusterID, req.RecordingName)); err != nil {
+		return nil, utilerrors.NewBadRequest(err.Error())
+	}
+	return req, nil
+}
+
+// ListTerminalRecordingsEndpoint lists the recorded web terminal sessions.
+func ListTerminalRecordingsEndpoint(userInfoGetter provider.UserInfoGetter, store recording.Store) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(listTerminalRecordingsReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		if err := verifyTerminalRecordingAccess(ctx, userInfoGetter, store); err != nil {
+			return nil, err
+		}
+
+		recordings, err := store.List(ctx, req.ClusterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list terminal recordings: %w", err)
+		}
+
+		result := make([]apiv1.TerminalRecording, 0, len(recordings))
+		for _, info := range recordings {
+			result = append(result, apiv1.TerminalRecording{
+				Name:         strings.TrimPrefix(info.Name, info.ClusterID+"/"),
+				ClusterID:    info.ClusterID,
+				Size:         info.Size,
+				LastModified: info.LastModified,
+			})
+		}
+		return result, nil
+	}
+}
+
+// terminalRecordingResponse is the content of a recording, it is written by EncodeTerminalRecording.
+type terminalRecordingResponse struct {
+	name    string
+	content io.ReadCloser
+}
+
+// GetTerminalRecordingEndpoint returns a recorded web terminal session.
+func GetTerminalRecordingEndpoint(userInfoGetter provider.UserInfoGetter, store recording.Store) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getTerminalRecordingReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		if err := verifyTerminalRecordingAccess(ctx, userInfoGetter, store); err != nil {
+			return nil, err
+		}
+
+		content, err := store.Open(ctx, path.Join(req.ClusterID, req.RecordingName))
+		if errors.Is(err, recording.ErrNotFound) {
+			return nil, utilerrors.NewNotFound("terminal recording", req.RecordingName)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to open terminal recording: %w", err)
+		}
+
+		return &terminalRecordingResponse{name: req.RecordingName, content: content}, nil
+	}
+}
+
+// EncodeTerminalRecording writes the recording as a file download.
+func EncodeTerminalRecording(c context.Context, w http.ResponseWriter, response interface{}) (err error) {
+	rsp := response.(*terminalRecordingResponse)
+	defer rsp.content.Close()
+
+	w.Header().Set("Content-Type", recording.ContentType)
+	w.Header().Set("Content-disposition", fmt.Sprintf("attachment; filename=%s", rsp.name))
+	w.Header().Add("Cache-Control", "no-cache")
+
+	_, err = io.Copy(w, rsp.content)
+	return err
+}
+
+func verifyTerminalRecordingAccess(ctx context.Context, userInfoGetter provider.UserInfoGetter, store recording.Store) error {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin {
+		return utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+	}
+	if store == nil {
+		return utilerrors.New(http.StatusNotFound, "the recording of terminal sessions is not enabled")
+	}
+	return nil
+}
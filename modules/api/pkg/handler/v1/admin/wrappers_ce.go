@@ -20,6 +20,7 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
@@ -69,6 +70,15 @@ func deleteMeteringReport(_ context.Context, _ interface{}, _ provider.SeedsGett
 	return nil
 }
 
+func exportMeteringReports(_ context.Context, _ interface{}, _ provider.SeedsGetter, _ provider.SeedClientGetter) (interface{}, error) {
+	return nil, nil
+}
+
+func encodeMeteringReportExport(w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(response)
+}
+
 func DecodeGetMeteringReportConfigurationReq(_ context.Context, r *http.Request) (interface{}, error) {
 	return nil, nil
 }
@@ -104,3 +114,7 @@ func DecodeMeteringConfigurationsReq(_ context.Context, r *http.Request) (interf
 func DecodeDeleteMeteringReportReq(_ context.Context, r *http.Request) (interface{}, error) {
 	return nil, nil
 }
+
+func DecodeExportMeteringReportReq(_ context.Context, r *http.Request) (interface{}, error) {
+	return nil, nil
+}
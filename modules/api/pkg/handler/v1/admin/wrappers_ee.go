@@ -69,6 +69,14 @@ func deleteMeteringReport(ctx context.Context, request interface{}, seedsGetter
 	return metering.DeleteReport(ctx, request, seedsGetter, seedClientGetter)
 }
 
+func exportMeteringReports(ctx context.Context, request interface{}, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) (interface{}, error) {
+	return metering.ExportReports(ctx, request, seedsGetter, seedClientGetter)
+}
+
+func encodeMeteringReportExport(w http.ResponseWriter, response interface{}) error {
+	return metering.EncodeReportExport(w, response)
+}
+
 func DecodeGetMeteringReportConfigurationReq(_ context.Context, r *http.Request) (interface{}, error) {
 	return metering.DecodeGetMeteringReportConfigurationReq(r)
 }
@@ -104,3 +112,7 @@ func DecodeMeteringConfigurationsReq(_ context.Context, r *http.Request) (interf
 func DecodeDeleteMeteringReportReq(_ context.Context, r *http.Request) (interface{}, error) {
 	return metering.DecodeDeleteMeteringReportReq(r)
 }
+
+func DecodeExportMeteringReportReq(_ context.Context, r *http.Request) (interface{}, error) {
+	return metering.DecodeExportMeteringReportReq(r)
+}
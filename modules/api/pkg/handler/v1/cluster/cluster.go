@@ -54,6 +54,7 @@ func CreateEndpoint(
 	caBundle *x509.CertPool,
 	configGetter provider.KubermaticConfigurationGetter,
 	features features.FeatureGate,
+	chargebackSchemaProvider provider.ChargebackSchemaProvider,
 ) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreateReq)
@@ -68,7 +69,7 @@ func CreateEndpoint(
 			return nil, utilerrors.NewBadRequest("%v", err)
 		}
 
-		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, projectProvider, privilegedProjectProvider, seedsGetter, credentialManager, exposeStrategy, userInfoGetter, caBundle, configGetter, features, settingsProvider)
+		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, projectProvider, privilegedProjectProvider, seedsGetter, credentialManager, exposeStrategy, userInfoGetter, caBundle, configGetter, features, settingsProvider, chargebackSchemaProvider)
 	}
 }
 
@@ -111,7 +112,7 @@ func ListAllEndpoint(
 	configGetter provider.KubermaticConfigurationGetter,
 ) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(common.GetProjectRq)
+		req := request.(ListAllReq)
 		allClusters := make([]*apiv1.Cluster, 0)
 
 		seeds, err := seedsGetter()
@@ -303,6 +304,7 @@ func DecodeCreateReq(c context.Context, r *http.Request) (interface{}, error) {
 // swagger:parameters listClusters
 type ListReq struct {
 	common.DCReq
+	common.ListOptionsReq
 }
 
 func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
@@ -314,6 +316,35 @@ func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
 	}
 	req.DCReq = dcr.(common.DCReq)
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ListAllReq defines HTTP request for listClustersForProject endpoint
+// swagger:parameters listClustersForProject
+type ListAllReq struct {
+	common.ProjectReq
+	common.ListOptionsReq
+}
+
+func DecodeListAllReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req ListAllReq
+
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
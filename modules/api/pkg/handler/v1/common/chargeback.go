@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"k8c.io/dashboard/v2/pkg/chargeback"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// ValidateChargebackLabels validates the labels of a project or cluster against the chargeback schema. The
+// inherited labels are the labels of the project of a cluster.
+func ValidateChargebackLabels(ctx context.Context, schemaProvider provider.ChargebackSchemaProvider, scope string, labels, inherited map[string]string) error {
+	if schemaProvider == nil {
+		return nil
+	}
+
+	schema, err := schemaProvider.Get(ctx)
+	if err != nil {
+		return KubernetesErrorToHTTPError(err)
+	}
+	if err := chargeback.ValidateLabels(schema, scope, labels, inherited); err != nil {
+		return utilerrors.NewBadRequest("%v", err)
+	}
+	return nil
+}
@@ -295,6 +295,24 @@ func GetClusterClient(ctx context.Context, userInfoGetter provider.UserInfoGette
 	return clusterProvider.GetClientForUserCluster(ctx, userInfo, cluster)
 }
 
+// GetClusterClientConfig returns the client config of the cluster, admins get the admin config, all other
+// users a config impersonating them.
+func GetClusterClientConfig(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string) (*rest.Config, error) {
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user information: %w", err)
+	}
+	if adminUserInfo.IsAdmin {
+		return clusterProvider.GetAdminClientConfigForUserCluster(ctx, cluster)
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user information: %w", err)
+	}
+	return clusterProvider.GetClientConfigForUserCluster(ctx, userInfo, cluster)
+}
+
 // checks whether a user is global admin, project admin or has valid roles to modify a project.
 func ValidateUserCanModifyProject(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string) error {
 	userInfo, err := userInfoGetter(ctx, projectID)
@@ -0,0 +1,368 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// ContinueHeader is the response header which carries the continue token for the next page.
+	ContinueHeader = "X-Continue"
+
+	// TotalCountHeader is the response header which carries the number of items matching the filters.
+	TotalCountHeader = "X-Total-Count"
+
+	// MaxListLimit is the maximum page size that can be requested.
+	MaxListLimit = 1000
+
+	listItemIDField   = "id"
+	listItemNameField = "name"
+	listItemSlugField = "slug"
+	listItemLabels    = "labels"
+)
+
+// ListOptionsReq defines the pagination, sorting and filtering options shared by list endpoints.
+type ListOptionsReq struct {
+	// Limit is the maximum number of items returned in a single page. When omitted all items are returned.
+	// in: query
+	Limit int `json:"limit,omitempty"`
+	// Continue is the opaque token returned in the X-Continue header of the previous page.
+	// in: query
+	Continue string `json:"continue,omitempty"`
+	// Sort is the field the items are ordered by, e.g. `name` or `-creationTimestamp` for descending order.
+	// in: query
+	Sort string `json:"sort,omitempty"`
+	// LabelSelector restricts the list to items whose labels match the given Kubernetes label selector.
+	// in: query
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// FieldSelector restricts the list to items whose fields match the given selector, e.g. `spec.version=1.31.1`.
+	// in: query
+	FieldSelector string `json:"fieldSelector,omitempty"`
+}
+
+// GetListOptions returns the list options of the request.
+func (r ListOptionsReq) GetListOptions() ListOptionsReq {
+	return r
+}
+
+// IsSet returns true if any of the list options was given.
+func (r ListOptionsReq) IsSet() bool {
+	return r != ListOptionsReq{}
+}
+
+// ListOptionsGetter knows how to get the list options from the request.
+type ListOptionsGetter interface {
+	GetListOptions() ListOptionsReq
+}
+
+// DecodeListOptionsReq decodes the pagination, sorting and filtering query parameters.
+func DecodeListOptionsReq(r *http.Request) (ListOptionsReq, error) {
+	query := r.URL.Query()
+
+	req := ListOptionsReq{
+		Continue:      query.Get("continue"),
+		Sort:          query.Get("sort"),
+		LabelSelector: query.Get("labelSelector"),
+		FieldSelector: query.Get("fieldSelector"),
+	}
+
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		limit, err := strconv.Atoi(rawLimit)
+		if err != nil {
+			return req, utilerrors.NewBadRequest("invalid value for limit: %v", err)
+		}
+		if limit < 0 || limit > MaxListLimit {
+			return req, utilerrors.NewBadRequest("limit must be between 0 and %d", MaxListLimit)
+		}
+		req.Limit = limit
+	}
+
+	if _, err := labels.Parse(req.LabelSelector); err != nil {
+		return req, utilerrors.NewBadRequest("invalid label selector: %v", err)
+	}
+	if _, err := fields.ParseSelector(req.FieldSelector); err != nil {
+		return req, utilerrors.NewBadRequest("invalid field selector: %v", err)
+	}
+
+	return req, nil
+}
+
+// ListResponse wraps the response of a list endpoint together with its pagination metadata.
+// The body is encoded unchanged, the metadata is sent as response headers.
+type ListResponse struct {
+	Body       interface{}
+	Continue   string
+	TotalCount int
+}
+
+// Headers implements the go-kit Headerer interface.
+func (r ListResponse) Headers() http.Header {
+	headers := http.Header{}
+	headers.Set(TotalCountHeader, strconv.Itoa(r.TotalCount))
+	if r.Continue != "" {
+		headers.Set(ContinueHeader, r.Continue)
+	}
+	return headers
+}
+
+// ListPage is a single page of a paginated list.
+type ListPage struct {
+	// Items has the same type as the slice passed to PaginateList.
+	Items      interface{}
+	Continue   string
+	TotalCount int
+}
+
+// continueToken is the decoded form of the opaque continue token. The token does not
+// point at an offset but at the last returned item, so that pages stay stable when
+// the list is assembled from several seeds in a different order on every request.
+type continueToken struct {
+	Sort          string `json:"s,omitempty"`
+	LabelSelector string `json:"l,omitempty"`
+	FieldSelector string `json:"f,omitempty"`
+	Key           string `json:"k,omitempty"`
+	ID            string `json:"i"`
+}
+
+func (t continueToken) encode() (string, error) {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeContinueToken(opts ListOptionsReq) (*continueToken, error) {
+	if opts.Continue == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(opts.Continue)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid continue token")
+	}
+
+	token := &continueToken{}
+	if err := json.Unmarshal(raw, token); err != nil {
+		return nil, utilerrors.NewBadRequest("invalid continue token")
+	}
+
+	if token.Sort != opts.Sort || token.LabelSelector != opts.LabelSelector || token.FieldSelector != opts.FieldSelector {
+		return nil, utilerrors.NewBadRequest("the continue token was issued for different sort or selector parameters")
+	}
+
+	return token, nil
+}
+
+// listItem holds the flattened representation of a single list element.
+type listItem struct {
+	index  int
+	id     string
+	key    string
+	labels labels.Set
+	fields fields.Set
+}
+
+// PaginateList filters, sorts and pages the given slice according to the list options.
+// Items are matched by their JSON representation, so the field selector and the sort
+// key refer to JSON field paths like `spec.cloud.dc` or `creationTimestamp`.
+func PaginateList(opts ListOptionsReq, items interface{}) (*ListPage, error) {
+	list := reflect.ValueOf(items)
+	if list.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("expected a slice, got %T", items)
+	}
+
+	labelSelector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid label selector: %v", err)
+	}
+	fieldSelector, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid field selector: %v", err)
+	}
+	token, err := decodeContinueToken(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sortField, descending := strings.CutPrefix(opts.Sort, "-")
+
+	matching := make([]listItem, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		item, err := flattenListItem(list.Index(i), sortField)
+		if err != nil {
+			return nil, err
+		}
+		item.index = i
+
+		if !labelSelector.Matches(item.labels) || !fieldSelector.Matches(item.fields) {
+			continue
+		}
+		matching = append(matching, item)
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		return lessListItem(matching[i].key, matching[i].id, matching[j].key, matching[j].id, descending)
+	})
+
+	start := 0
+	if token != nil {
+		start = sort.Search(len(matching), func(i int) bool {
+			return lessListItem(token.Key, token.ID, matching[i].key, matching[i].id, descending)
+		})
+	}
+
+	end := len(matching)
+	if opts.Limit > 0 && start+opts.Limit < end {
+		end = start + opts.Limit
+	}
+
+	result := reflect.MakeSlice(list.Type(), 0, end-start)
+	for _, item := range matching[start:end] {
+		result = reflect.Append(result, list.Index(item.index))
+	}
+
+	page := &ListPage{
+		Items:      result.Interface(),
+		TotalCount: len(matching),
+	}
+
+	if end < len(matching) {
+		last := matching[end-1]
+		page.Continue, err = continueToken{
+			Sort:          opts.Sort,
+			LabelSelector: opts.LabelSelector,
+			FieldSelector: opts.FieldSelector,
+			Key:           last.key,
+			ID:            last.id,
+		}.encode()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// lessListItem orders items by their sort key and uses the item ID as tie breaker,
+// which keeps the order stable across requests.
+func lessListItem(keyA, idA, keyB, idB string, descending bool) bool {
+	if keyA != keyB {
+		if descending {
+			return compareListKeys(keyB, keyA) < 0
+		}
+		return compareListKeys(keyA, keyB) < 0
+	}
+	return idA < idB
+}
+
+// compareListKeys orders the keys totally: numeric keys come first and are compared by value, all other keys follow
+// and are compared lexically. Numeric keys of the same value, like 1 and 1.0, are compared lexically as well.
+func compareListKeys(a, b string) int {
+	numA, errA := strconv.ParseFloat(a, 64)
+	numB, errB := strconv.ParseFloat(b, 64)
+	switch {
+	case errA == nil && errB == nil:
+		if c := cmp.Compare(numA, numB); c != 0 {
+			return c
+		}
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func flattenListItem(value reflect.Value, sortField string) (listItem, error) {
+	// Some API types implement json.Marshaler on the pointer receiver only.
+	if value.Kind() != reflect.Pointer && value.CanAddr() {
+		value = value.Addr()
+	}
+
+	raw, err := json.Marshal(value.Interface())
+	if err != nil {
+		return listItem{}, fmt.Errorf("failed to marshal list item: %w", err)
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return listItem{}, fmt.Errorf("failed to unmarshal list item: %w", err)
+	}
+
+	item := listItem{
+		fields: fields.Set{},
+		labels: labels.Set{},
+	}
+	flattenListFields("", object, item.fields)
+
+	if rawLabels, ok := object[listItemLabels].(map[string]interface{}); ok {
+		for k, v := range rawLabels {
+			if s, ok := v.(string); ok {
+				item.labels[k] = s
+			}
+		}
+	}
+
+	for _, idField := range []string{listItemIDField, listItemNameField, listItemSlugField} {
+		if id := item.fields[idField]; id != "" {
+			item.id = id
+			break
+		}
+	}
+
+	if sortField == "" {
+		sortField = listItemNameField
+	}
+	item.key = item.fields[sortField]
+
+	return item, nil
+}
+
+func flattenListFields(prefix string, object map[string]interface{}, result fields.Set) {
+	for key, value := range object {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flattenListFields(path, v, result)
+		case string:
+			result[path] = v
+		case float64:
+			result[path] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			result[path] = strconv.FormatBool(v)
+		}
+	}
+}
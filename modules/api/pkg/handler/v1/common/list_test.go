@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+)
+
+func genListProject(id, name string, created time.Time, labels map[string]string) apiv1.Project {
+	return apiv1.Project{
+		ObjectMeta: apiv1.ObjectMeta{
+			ID:                id,
+			Name:              name,
+			CreationTimestamp: apiv1.NewTime(created),
+		},
+		Status: "Active",
+		Labels: labels,
+	}
+}
+
+func projectIDs(projects []apiv1.Project) []string {
+	ids := []string{}
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestPaginateList(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	projects := []apiv1.Project{
+		genListProject("p3", "charlie", now.Add(1*time.Hour), map[string]string{"team": "a"}),
+		genListProject("p1", "alpha", now.Add(3*time.Hour), map[string]string{"team": "b"}),
+		genListProject("p2", "bravo", now.Add(2*time.Hour), map[string]string{"team": "a"}),
+		genListProject("p4", "alpha", now, nil),
+	}
+
+	testcases := []struct {
+		name        string
+		options     common.ListOptionsReq
+		expectedIDs []string
+		expectedErr bool
+	}{
+		{
+			name:        "scenario 1: default sort by name with ID as tie breaker",
+			expectedIDs: []string{"p1", "p4", "p2", "p3"},
+		},
+		{
+			name:        "scenario 2: descending sort by creation timestamp",
+			options:     common.ListOptionsReq{Sort: "-creationTimestamp"},
+			expectedIDs: []string{"p1", "p2", "p3", "p4"},
+		},
+		{
+			name:        "scenario 3: label selector",
+			options:     common.ListOptionsReq{LabelSelector: "team=a"},
+			expectedIDs: []string{"p2", "p3"},
+		},
+		{
+			name:        "scenario 4: field selector",
+			options:     common.ListOptionsReq{FieldSelector: "name=alpha"},
+			expectedIDs: []string{"p1", "p4"},
+		},
+		{
+			name:        "scenario 5: invalid continue token",
+			options:     common.ListOptionsReq{Continue: "not-a-token"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := common.PaginateList(tc.options, projects)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedIDs, projectIDs(page.Items.([]apiv1.Project))); diff != "" {
+				t.Fatalf("unexpected items (-want +got):\n%s", diff)
+			}
+			if page.TotalCount != len(tc.expectedIDs) {
+				t.Fatalf("expected total count %d, got %d", len(tc.expectedIDs), page.TotalCount)
+			}
+		})
+	}
+}
+
+func TestPaginateListContinue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	seedA := []apiv1.Project{
+		genListProject("p1", "alpha", now, nil),
+		genListProject("p4", "delta", now, nil),
+		genListProject("p5", "echo", now, nil),
+	}
+	seedB := []apiv1.Project{
+		genListProject("p3", "charlie", now, nil),
+		genListProject("p2", "bravo", now, nil),
+	}
+
+	options := common.ListOptionsReq{Limit: 2}
+	collected := []string{}
+
+	// The second request sees the seeds in a different order, the token
+	// must still continue right after the last returned item.
+	requests := [][]apiv1.Project{
+		slices.Concat(seedA, seedB),
+		slices.Concat(seedB, seedA),
+		slices.Concat(seedA, seedB),
+	}
+	for _, items := range requests {
+		page, err := common.PaginateList(options, items)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		collected = append(collected, projectIDs(page.Items.([]apiv1.Project))...)
+		options.Continue = page.Continue
+		if page.Continue == "" {
+			break
+		}
+	}
+
+	if diff := cmp.Diff([]string{"p1", "p2", "p3", "p4", "p5"}, collected); diff != "" {
+		t.Fatalf("unexpected items (-want +got):\n%s", diff)
+	}
+
+	options = common.ListOptionsReq{Limit: 2}
+	page, err := common.PaginateList(options, seedA)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := common.PaginateList(common.ListOptionsReq{Limit: 2, Sort: "-name", Continue: page.Continue}, seedA); err == nil {
+		t.Fatal("expected an error when reusing a continue token with a different sort order")
+	}
+}
+
+func TestPaginateListMixedKeys(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	projects := []apiv1.Project{
+		genListProject("p1", "10", now, nil),
+		genListProject("p2", "a", now, nil),
+		genListProject("p3", "9", now, nil),
+		genListProject("p4", "1.0", now, nil),
+		genListProject("p5", "1", now, nil),
+		genListProject("p6", "B", now, nil),
+	}
+
+	// Numeric names are ordered by value before all other names, so the
+	// order must not depend on the order of the items.
+	expected := []string{"p5", "p4", "p3", "p1", "p6", "p2"}
+	reversed := slices.Clone(projects)
+	slices.Reverse(reversed)
+	for _, items := range [][]apiv1.Project{projects, reversed} {
+		collected := []string{}
+		options := common.ListOptionsReq{Limit: 2}
+		for {
+			page, err := common.PaginateList(options, items)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			collected = append(collected, projectIDs(page.Items.([]apiv1.Project))...)
+			if page.Continue == "" {
+				break
+			}
+			options.Continue = page.Continue
+		}
+
+		if diff := cmp.Diff(expected, collected); diff != "" {
+			t.Fatalf("unexpected items (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestDecodeListOptionsReq(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		query       string
+		expected    common.ListOptionsReq
+		expectedErr bool
+	}{
+		{
+			name:     "scenario 1: all options",
+			query:    "limit=10&continue=abc&sort=-name&labelSelector=team%3Da&fieldSelector=status%3DActive",
+			expected: common.ListOptionsReq{Limit: 10, Continue: "abc", Sort: "-name", LabelSelector: "team=a", FieldSelector: "status=Active"},
+		},
+		{
+			name:        "scenario 2: limit out of range",
+			query:       "limit=100000",
+			expectedErr: true,
+		},
+		{
+			name:        "scenario 3: invalid label selector",
+			query:       "labelSelector=%21%21",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/projects?"+tc.query, nil)
+			options, err := common.DecodeListOptionsReq(req)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, options); diff != "" {
+				t.Fatalf("unexpected options (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/go-kit/kit/endpoint"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/chargeback"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
@@ -42,7 +43,7 @@ import (
 )
 
 // CreateEndpoint defines an HTTP endpoint that creates a new project in the system.
-func CreateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, settingsProvider provider.SettingsProvider, memberMapper provider.ProjectMemberMapper, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userProvider provider.UserProvider) endpoint.Endpoint {
+func CreateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, settingsProvider provider.SettingsProvider, memberMapper provider.ProjectMemberMapper, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userProvider provider.UserProvider, chargebackSchemaProvider provider.ChargebackSchemaProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		projectRq, ok := request.(projectReq)
 		if !ok {
@@ -53,6 +54,10 @@ func CreateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectP
 			return nil, utilerrors.NewBadRequest("the name of the project cannot be empty")
 		}
 
+		if err := common.ValidateChargebackLabels(ctx, chargebackSchemaProvider, chargeback.ScopeProject, projectRq.Body.Labels, nil); err != nil {
+			return nil, err
+		}
+
 		settings, err := settingsProvider.GetGlobalSettings(ctx)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
@@ -494,7 +499,7 @@ func deleteProjectByRegularUser(ctx context.Context, userInfoGetter provider.Use
 
 // UpdateEndpoint defines an HTTP endpoint that updates an existing project in the system
 // in the current implementation only project renaming is supported.
-func UpdateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, settingsProvider provider.SettingsProvider, memberProvider provider.ProjectMemberProvider, userProvider provider.UserProvider, userInfoGetter provider.UserInfoGetter, clusterProviderGetter provider.ClusterProviderGetter, seedsGetter provider.SeedsGetter) endpoint.Endpoint {
+func UpdateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, settingsProvider provider.SettingsProvider, memberProvider provider.ProjectMemberProvider, userProvider provider.UserProvider, userInfoGetter provider.UserInfoGetter, clusterProviderGetter provider.ClusterProviderGetter, seedsGetter provider.SeedsGetter, chargebackSchemaProvider provider.ChargebackSchemaProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(updateRq)
 		if !ok {
@@ -504,6 +509,9 @@ func UpdateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectP
 		if err != nil {
 			return nil, utilerrors.NewBadRequest("%v", err)
 		}
+		if err := common.ValidateChargebackLabels(ctx, chargebackSchemaProvider, chargeback.ScopeProject, req.Body.Labels, nil); err != nil {
+			return nil, err
+		}
 
 		settings, err := settingsProvider.GetGlobalSettings(ctx)
 		if err != nil {
@@ -673,6 +681,7 @@ func DecodeDelete(c context.Context, r *http.Request) (interface{}, error) {
 // ListReq defines HTTP request for listProjects endpoint
 // swagger:parameters listProjects
 type ListReq struct {
+	common.ListOptionsReq
 	// in: query
 	DisplayAll bool `json:"displayAll,omitempty"`
 	// in: query
@@ -696,6 +705,11 @@ func DecodeList(c context.Context, r *http.Request) (interface{}, error) {
 	req.DisplayAll = displayAll
 	req.Search = searchParam
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 	"unicode/utf8"
 
 	"github.com/go-kit/kit/endpoint"
@@ -69,7 +70,12 @@ func CreateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedPro
 
 		tokenID := rand.String(10)
 
-		token, err := tokenGenerator.Generate(serviceaccount.Claims(sa.Spec.Email, project.Name, tokenID))
+		ttl, err := parseTokenTTL(req.Body.TTL)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		token, err := tokenGenerator.Generate(serviceaccount.ScopedClaims(sa.Spec.Email, project.Name, tokenID, ttl, convertExternalScopeToInternal(req.Body.Scope)))
 		if err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError, "can not generate token data")
 		}
@@ -330,7 +336,9 @@ func updateEndpoint(ctx context.Context, projectProvider provider.ProjectProvide
 	}
 
 	if regenerateToken {
-		token, err := tokenGenerator.Generate(serviceaccount.Claims(sa.Spec.Email, project.Name, existingSecret.Name))
+		// the regenerated token keeps the TTL and scope chosen at creation
+		ttl, scope := tokenSettings(existingSecret)
+		token, err := tokenGenerator.Generate(serviceaccount.ScopedClaims(sa.Spec.Email, project.Name, existingSecret.Name, ttl, scope))
 		if err != nil {
 			return nil, fmt.Errorf("can not generate token data")
 		}
@@ -416,10 +424,28 @@ func (r addTokenReq) Validate() error {
 	if utf8.RuneCountInString(r.Body.Name) > 50 {
 		return fmt.Errorf("the name is too long, max 50 chars")
 	}
+	if _, err := parseTokenTTL(r.Body.TTL); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// parseTokenTTL parses the TTL of a new token, an empty TTL results in the default expiry.
+func parseTokenTTL(rawTTL string) (time.Duration, error) {
+	if rawTTL == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(rawTTL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ttl: %w", err)
+	}
+	if ttl < time.Minute || ttl > serviceaccount.MaxTokenTTL {
+		return 0, fmt.Errorf("the ttl must be between 1m and %v", serviceaccount.MaxTokenTTL)
+	}
+	return ttl, nil
+}
+
 // Validate validates commonTokenReq request.
 func (r commonTokenReq) Validate() error {
 	if len(r.ProjectID) == 0 || len(r.ServiceAccountID) == 0 {
@@ -630,7 +656,7 @@ func convertInternalTokenToPublicExternal(internal *corev1.Secret, authenticator
 
 	externalToken.CreationTimestamp = apiv1.NewTime(internal.CreationTimestamp.Time)
 
-	publicClaim, _, err := authenticator.Authenticate(string(token))
+	publicClaim, customClaim, err := authenticator.Authenticate(string(token))
 	// set invalidated flag to true if you can't authenticate token
 	// It will force the user to regenerate token
 	if err != nil {
@@ -639,6 +665,55 @@ func convertInternalTokenToPublicExternal(internal *corev1.Secret, authenticator
 	}
 
 	externalToken.Expiry = apiv1.NewTime(publicClaim.Expiry.Time())
+	externalToken.Scope = convertInternalScopeToExternal(customClaim.Scope)
 
 	return externalToken, nil
 }
+
+// tokenSettings returns the TTL and scope the token in the secret was created with.
+func tokenSettings(secret *corev1.Secret) (time.Duration, *serviceaccount.TokenScope) {
+	publicClaim, customClaim, err := serviceaccount.ParseUnverified(string(secret.Data["token"]))
+	if err != nil || publicClaim.Expiry == nil || publicClaim.IssuedAt == nil {
+		return 0, nil
+	}
+	return publicClaim.Expiry.Time().Sub(publicClaim.IssuedAt.Time()), customClaim.Scope
+}
+
+func convertExternalScopeToInternal(scope *apiv1.ServiceAccountTokenScope) *serviceaccount.TokenScope {
+	if scope == nil || (!scope.ReadOnly && len(scope.ClusterIDs) == 0) {
+		return nil
+	}
+	return &serviceaccount.TokenScope{
+		ReadOnly:   scope.ReadOnly,
+		ClusterIDs: scope.ClusterIDs,
+	}
+}
+
+func convertInternalScopeToExternal(scope *serviceaccount.TokenScope) *apiv1.ServiceAccountTokenScope {
+	if scope == nil {
+		return nil
+	}
+	return &apiv1.ServiceAccountTokenScope{
+		ReadOnly:   scope.ReadOnly,
+		ClusterIDs: scope.ClusterIDs,
+	}
+}
+
+// JWKSEndpoint returns the public keys used to verify service account tokens.
+func JWKSEndpoint(tokenAuthenticator serviceaccount.TokenAuthenticator) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		result := apiv1.JSONWebKeySet{Keys: []apiv1.JSONWebKey{}}
+		for _, key := range tokenAuthenticator.KeySet().Keys {
+			rawKey, err := json.Marshal(key)
+			if err != nil {
+				return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+			}
+			var externalKey apiv1.JSONWebKey
+			if err := json.Unmarshal(rawKey, &externalKey); err != nil {
+				return nil, utilerrors.New(http.StatusInternalServerError, err.Error())
+			}
+			result.Keys = append(result.Keys, externalKey)
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authflow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+)
+
+// The device authorization grant (RFC 8628) lets CLI tools and headless CI get a
+// dashboard token without a browser redirect to localhost: the tool starts the
+// authorization at /auth/device, the user approves it at the OIDC provider on any
+// device, and meanwhile the tool polls /auth/device/token until it gets the tokens.
+// The tool then sends the id_token as bearer token and refreshes it at /auth/refresh.
+
+const (
+	deviceCodeFormKey   = "device_code"
+	refreshTokenFormKey = "refresh_token"
+)
+
+// deviceAuthResponse is the response of /auth/device, the fields follow RFC 8628 section 3.2.
+type deviceAuthResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in,omitempty"`
+	Interval                int64  `json:"interval,omitempty"`
+}
+
+// deviceErrorResponse is the error response of /auth/device/token, see RFC 8628 section 3.5.
+type deviceErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// tokenResponse carries the tokens to clients without cookies.
+type tokenResponse struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresAt    int64  `json:"expires_at"`
+}
+
+func (a *authHandler) deviceAuthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		oidcConfig := a.oidcIssuerVerifier.OIDCConfig()
+
+		authorization, err := a.oidcIssuerVerifier.DeviceAuth(r.Context(), oidcConfig.OfflineAccessAsScope, loginScopes(oidcConfig)...)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to start device authorization: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		response := deviceAuthResponse{
+			DeviceCode:              authorization.DeviceCode,
+			UserCode:                authorization.UserCode,
+			VerificationURI:         authorization.VerificationURI,
+			VerificationURIComplete: authorization.VerificationURIComplete,
+			Interval:                authorization.Interval,
+		}
+		if !authorization.Expiry.IsZero() {
+			response.ExpiresIn = int64(time.Until(authorization.Expiry).Seconds())
+		}
+		writeJSON(w, http.StatusOK, response)
+	})
+}
+
+func (a *authHandler) deviceTokenHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 1. Read the device code, token requests are form encoded.
+		deviceCode := r.PostFormValue(deviceCodeFormKey)
+		if deviceCode == "" {
+			writeJSON(w, http.StatusBadRequest, deviceErrorResponse{
+				Error:            "invalid_request",
+				ErrorDescription: "missing device_code parameter",
+			})
+			return
+		}
+
+		// 2. Request the tokens once. While the user has not approved the authorization,
+		// the error of the OIDC provider is passed on and the client keeps polling.
+		oidcTokens, err := a.oidcIssuerVerifier.DeviceAccessToken(r.Context(), deviceCode)
+		if err != nil {
+			var authErr *authtypes.DeviceAuthorizationError
+			if errors.As(err, &authErr) {
+				writeJSON(w, http.StatusBadRequest, deviceErrorResponse{
+					Error:            authErr.Code,
+					ErrorDescription: authErr.Description,
+				})
+				return
+			}
+			http.Error(w, fmt.Sprintf("failed to request tokens: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		// 3. Verify id_token and check email claim.
+		claims, err := a.oidcIssuerVerifier.Verify(r.Context(), oidcTokens.IDToken)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to verify id_token: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		if claims.Email == "" {
+			http.Error(w, "email claim is missing from id_token", http.StatusBadRequest)
+			return
+		}
+
+		if time.Until(claims.Expiry.Time) <= 0 {
+			http.Error(w, "received an already expired id_token", http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, tokenResponse{
+			IDToken:      oidcTokens.IDToken,
+			RefreshToken: oidcTokens.RefreshToken,
+			ExpiresAt:    claims.Expiry.Unix(),
+		})
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	// tokens must not be stored by caches, see RFC 6749 section 5.1
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %v", err), http.StatusInternalServerError)
+	}
+}
@@ -29,6 +29,7 @@ import (
 
 	"golang.org/x/oauth2"
 
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/log"
 )
@@ -115,13 +116,8 @@ func (a *authHandler) loginHandler() http.Handler {
 
 		codeVerifier := oauth2.GenerateVerifier()
 
-		scopes := []string{"openid", "email", "profile", "groups"}
-		if oidcConfig.OfflineAccessAsScope {
-			scopes = append(scopes, "offline_access")
-		}
-
 		redirectURI := a.getCallbackURI(r)
-		authURL := a.oidcIssuerVerifier.AuthCodeURL(state, oidcConfig.OfflineAccessAsScope, redirectURI, scopes...)
+		authURL := a.oidcIssuerVerifier.AuthCodeURL(state, oidcConfig.OfflineAccessAsScope, redirectURI, loginScopes(oidcConfig)...)
 		u, err := url.Parse(authURL)
 		if err != nil {
 			http.Error(w, fmt.Sprintf("failed to parse auth URL: %v", err), http.StatusInternalServerError)
@@ -158,6 +154,15 @@ func (a *authHandler) loginHandler() http.Handler {
 	})
 }
 
+// loginScopes returns the scopes requested by the login and the device authorization.
+func loginScopes(oidcConfig *authtypes.OIDCConfiguration) []string {
+	scopes := []string{"openid", "email", "profile", "groups"}
+	if oidcConfig.OfflineAccessAsScope {
+		scopes = append(scopes, "offline_access")
+	}
+	return scopes
+}
+
 const (
 	idTokenCookieName = "token"
 	// Max characters per cookie (staying under the 4KB browser limit).
@@ -197,6 +202,12 @@ func (a *authHandler) callbackHandler() http.Handler {
 			return
 		}
 
+		// The code is only exchanged with the PKCE verifier, so that an intercepted code is useless.
+		if storedState.CodeVerifier == "" {
+			http.Error(w, "missing PKCE code verifier in state cookie", http.StatusBadRequest)
+			return
+		}
+
 		// Clear the state cookie — it is one-time use.
 		http.SetCookie(w, &http.Cookie{
 			Name:     oauthStateCookieName,
@@ -286,16 +297,24 @@ func (a *authHandler) refreshHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		oidcConfig := a.oidcIssuerVerifier.OIDCConfig()
 
-		// 1. Read refresh_token from cookie.
+		// 1. Read refresh_token from cookie. Clients of the device authorization, which
+		// have no cookies, send it as form value and get the new tokens in the response.
+		var refreshToken string
 		refreshCookie, err := r.Cookie(refreshTokenCookieName)
-		if err != nil {
+		fromCookie := err == nil
+		if fromCookie {
+			refreshToken = refreshCookie.Value
+		} else {
+			refreshToken = r.PostFormValue(refreshTokenFormKey)
+		}
+		if refreshToken == "" {
 			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
 			http.Error(w, "missing refresh_token cookie", http.StatusUnauthorized)
 			return
 		}
 
 		// 2. Refresh tokens.
-		oidcTokens, err := a.oidcIssuerVerifier.RefreshAccessToken(r.Context(), refreshCookie.Value)
+		oidcTokens, err := a.oidcIssuerVerifier.RefreshAccessToken(r.Context(), refreshToken)
 		if err != nil {
 			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
 			http.Error(w, "token refresh failed", http.StatusUnauthorized)
@@ -324,6 +343,15 @@ func (a *authHandler) refreshHandler() http.Handler {
 			return
 		}
 
+		if !fromCookie {
+			writeJSON(w, http.StatusOK, tokenResponse{
+				IDToken:      oidcTokens.IDToken,
+				RefreshToken: oidcTokens.RefreshToken,
+				ExpiresAt:    claims.Expiry.Unix(),
+			})
+			return
+		}
+
 		clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
 
 		tokenValue := oidcTokens.IDToken
@@ -43,6 +43,8 @@ const (
 	testRefreshURL  = "http://localhost/api/v2/auth/refresh"
 	testLogoutURL   = "http://localhost/api/v2/auth/logout"
 	testStatusURL   = "http://localhost/api/v2/auth/status"
+	testDeviceURL   = "http://localhost/api/v2/auth/device"
+	testDeviceToken = "http://localhost/api/v2/auth/device/token"
 )
 
 // fakeVerifier implements authtypes.OIDCIssuerVerifier with per-test behavior
@@ -55,9 +57,20 @@ type fakeVerifier struct {
 
 	exchangeToken authtypes.OIDCToken
 	exchangeErr   error
+	// exchangeVerifier records the PKCE verifier passed to Exchange.
+	exchangeVerifier string
 
 	refreshToken authtypes.OIDCToken
 	refreshErr   error
+	// refreshedToken records the refresh token passed to RefreshAccessToken.
+	refreshedToken string
+
+	deviceAuth     authtypes.DeviceAuthorization
+	deviceAuthErr  error
+	deviceToken    authtypes.OIDCToken
+	deviceTokenErr error
+	// deviceCode records the device code passed to DeviceAccessToken.
+	deviceCode string
 
 	verifyClaims authtypes.TokenClaims
 	verifyErr    error
@@ -86,14 +99,27 @@ func (f *fakeVerifier) AuthCodeURL(state string, _ bool, redirectURI string, sco
 	return "https://dex.example.com/auth?" + v.Encode()
 }
 
-func (f *fakeVerifier) Exchange(_ context.Context, _, _ string, _ ...string) (authtypes.OIDCToken, error) {
+func (f *fakeVerifier) Exchange(_ context.Context, _, _ string, codeVerifier ...string) (authtypes.OIDCToken, error) {
+	if len(codeVerifier) > 0 {
+		f.exchangeVerifier = codeVerifier[0]
+	}
 	return f.exchangeToken, f.exchangeErr
 }
 
-func (f *fakeVerifier) RefreshAccessToken(_ context.Context, _ string) (authtypes.OIDCToken, error) {
+func (f *fakeVerifier) RefreshAccessToken(_ context.Context, refreshToken string) (authtypes.OIDCToken, error) {
+	f.refreshedToken = refreshToken
 	return f.refreshToken, f.refreshErr
 }
 
+func (f *fakeVerifier) DeviceAuth(_ context.Context, _ bool, _ ...string) (authtypes.DeviceAuthorization, error) {
+	return f.deviceAuth, f.deviceAuthErr
+}
+
+func (f *fakeVerifier) DeviceAccessToken(_ context.Context, deviceCode string) (authtypes.OIDCToken, error) {
+	f.deviceCode = deviceCode
+	return f.deviceToken, f.deviceTokenErr
+}
+
 func (f *fakeVerifier) Verify(_ context.Context, _ string) (authtypes.TokenClaims, error) {
 	return f.verifyClaims, f.verifyErr
 }
@@ -527,6 +553,39 @@ func TestRefreshHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("refresh_token from the form returns the tokens without cookies", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			refreshToken: authtypes.OIDCToken{IDToken: "fakeRefreshedTokenId", RefreshToken: "fakeRotatedRefreshToken"},
+			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		req := httptest.NewRequest(http.MethodPost, testRefreshURL, strings.NewReader("refresh_token=fakeRefreshToken"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		h.refreshHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if verifier.refreshedToken != "fakeRefreshToken" {
+			t.Errorf("expected refresh with %q, got %q", "fakeRefreshToken", verifier.refreshedToken)
+		}
+
+		var resp tokenResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		expected := tokenResponse{IDToken: "fakeRefreshedTokenId", RefreshToken: "fakeRotatedRefreshToken", ExpiresAt: futureExpiry.Unix()}
+		if resp != expected {
+			t.Errorf("expected response %+v, got %+v", expected, resp)
+		}
+		if c := findSetCookie(rec.Result().Cookies(), idTokenCookieName); c != nil {
+			t.Errorf("expected no token cookie, got %+v", c)
+		}
+	})
+
 	t.Run("missing refresh_token cookie returns 401 and clears cookies", func(t *testing.T) {
 		verifier := &fakeVerifier{secureCookie: newTestSecureCookie()}
 		h := newTestHandler(verifier, nil, nil)
@@ -656,6 +715,20 @@ func TestCallbackHandler(t *testing.T) {
 		}
 		// The one-time state cookie must be cleared.
 		assertCookieCleared(t, cookies, oauthStateCookieName)
+		// The code must be exchanged with the PKCE verifier of the login.
+		if verifier.exchangeVerifier != "verifier-xyz" {
+			t.Errorf("expected code verifier %q in the exchange, got %q", "verifier-xyz", verifier.exchangeVerifier)
+		}
+	})
+
+	t.Run("state cookie without PKCE verifier returns 400", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		h := newTestHandler(&fakeVerifier{secureCookie: sc}, nil, nil)
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", rec.Code)
+		}
 	})
 
 	t.Run("missing state or code returns 400", func(t *testing.T) {
@@ -704,7 +777,7 @@ func TestCallbackHandler(t *testing.T) {
 			verifyClaims:  authtypes.TokenClaims{Email: "john@acme.com", Nonce: "wrong-nonce", Expiry: futureExpiry},
 		}
 		h := newTestHandler(verifier, nil, nil)
-		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", CodeVerifier: "verifier-xyz"})
 		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
 		if rec.Code != http.StatusBadRequest {
 			t.Fatalf("expected 400, got %d (body: %q)", rec.Code, rec.Body.String())
@@ -715,7 +788,7 @@ func TestCallbackHandler(t *testing.T) {
 		sc := newTestSecureCookie()
 		verifier := &fakeVerifier{secureCookie: sc, exchangeErr: errors.New("exchange failed")}
 		h := newTestHandler(verifier, nil, nil)
-		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", CodeVerifier: "verifier-xyz"})
 		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
 		if rec.Code != http.StatusInternalServerError {
 			t.Fatalf("expected 500, got %d", rec.Code)
@@ -730,7 +803,7 @@ func TestCallbackHandler(t *testing.T) {
 			verifyClaims:  authtypes.TokenClaims{Nonce: "nonce-abc", Expiry: futureExpiry}, // no email
 		}
 		h := newTestHandler(verifier, nil, nil)
-		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", CodeVerifier: "verifier-xyz"})
 		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
 		if rec.Code != http.StatusBadRequest {
 			t.Fatalf("expected 400, got %d", rec.Code)
@@ -745,7 +818,7 @@ func TestCallbackHandler(t *testing.T) {
 			verifyClaims:  authtypes.TokenClaims{Email: "john@acme.com", Nonce: "nonce-abc", Expiry: pastExpiry},
 		}
 		h := newTestHandler(verifier, nil, nil)
-		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", CodeVerifier: "verifier-xyz"})
 		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
 		if rec.Code != http.StatusInternalServerError {
 			t.Fatalf("expected 500, got %d", rec.Code)
@@ -766,7 +839,7 @@ func TestCallbackHandler(t *testing.T) {
 			},
 		}
 		h := newTestHandler(verifier, userProvider, nil)
-		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", CodeVerifier: "verifier-xyz"})
 		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
 
 		if rec.Code != http.StatusSeeOther {
@@ -802,7 +875,7 @@ func TestCallbackHandler(t *testing.T) {
 		}
 		h := newTestHandler(verifier, userProvider, nil)
 
-		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", CodeVerifier: "verifier-xyz"})
 		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
 
 		if rec.Code != http.StatusSeeOther {
@@ -825,7 +898,7 @@ func TestCallbackHandler(t *testing.T) {
 		userProvider := &fakeUserProvider{userByEmailErr: errors.New("lookup failed")}
 		h := newTestHandler(verifier, userProvider, nil)
 
-		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc"})
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", CodeVerifier: "verifier-xyz"})
 		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
 
 		if rec.Code != http.StatusSeeOther {
@@ -836,3 +909,154 @@ func TestCallbackHandler(t *testing.T) {
 		}
 	})
 }
+
+// -----------------------------------------------------------------------------
+// device authorization
+// -----------------------------------------------------------------------------
+
+// runDeviceToken posts the form to the device token handler, returning the recorded response.
+func runDeviceToken(h *authHandler, form string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, testDeviceToken, strings.NewReader(form))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	h.deviceTokenHandler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestDeviceAuthHandler(t *testing.T) {
+	t.Run("returns the codes of the OIDC provider", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			deviceAuth: authtypes.DeviceAuthorization{
+				DeviceCode:      "fakeDeviceCode",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://dex.example.com/device",
+				Expiry:          time.Now().Add(10 * time.Minute),
+				Interval:        5,
+			},
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		rec := httptest.NewRecorder()
+		h.deviceAuthHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, testDeviceURL, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var resp deviceAuthResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.DeviceCode != "fakeDeviceCode" || resp.UserCode != "ABCD-EFGH" || resp.VerificationURI != "https://dex.example.com/device" || resp.Interval != 5 {
+			t.Errorf("unexpected response %+v", resp)
+		}
+		if resp.ExpiresIn <= 0 || resp.ExpiresIn > 600 {
+			t.Errorf("expected expires_in within 10 minutes, got %d", resp.ExpiresIn)
+		}
+	})
+
+	t.Run("unsupported device authorization returns 500", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie:  newTestSecureCookie(),
+			deviceAuthErr: errors.New("the OpenID provider does not support the device authorization grant"),
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		rec := httptest.NewRecorder()
+		h.deviceAuthHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, testDeviceURL, nil))
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
+
+func TestDeviceTokenHandler(t *testing.T) {
+	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
+
+	t.Run("approved authorization returns the tokens", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			deviceToken:  authtypes.OIDCToken{IDToken: "fakeTokenId", RefreshToken: "fakeRefreshToken"},
+			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		rec := runDeviceToken(h, "device_code=fakeDeviceCode")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if verifier.deviceCode != "fakeDeviceCode" {
+			t.Errorf("expected device code %q, got %q", "fakeDeviceCode", verifier.deviceCode)
+		}
+		if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+			t.Errorf("expected Cache-Control no-store, got %q", got)
+		}
+
+		var resp tokenResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		expected := tokenResponse{IDToken: "fakeTokenId", RefreshToken: "fakeRefreshToken", ExpiresAt: futureExpiry.Unix()}
+		if resp != expected {
+			t.Errorf("expected response %+v, got %+v", expected, resp)
+		}
+	})
+
+	t.Run("pending authorization returns the error of the provider", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie:   newTestSecureCookie(),
+			deviceTokenErr: &authtypes.DeviceAuthorizationError{Code: "authorization_pending"},
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		rec := runDeviceToken(h, "device_code=fakeDeviceCode")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+		var resp deviceErrorResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Error != "authorization_pending" {
+			t.Errorf("expected error %q, got %q", "authorization_pending", resp.Error)
+		}
+	})
+
+	t.Run("missing device_code returns invalid_request", func(t *testing.T) {
+		h := newTestHandler(&fakeVerifier{secureCookie: newTestSecureCookie()}, nil, nil)
+
+		rec := runDeviceToken(h, "")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+		if !strings.Contains(rec.Body.String(), "invalid_request") {
+			t.Errorf("expected invalid_request error, got %q", rec.Body.String())
+		}
+	})
+
+	t.Run("token request failure returns 500", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie:   newTestSecureCookie(),
+			deviceTokenErr: errors.New("connection refused"),
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		if rec := runDeviceToken(h, "device_code=fakeDeviceCode"); rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+		}
+	})
+
+	t.Run("missing email claim returns 400", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			deviceToken:  authtypes.OIDCToken{IDToken: "fakeTokenId"},
+			verifyClaims: authtypes.TokenClaims{Expiry: futureExpiry},
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		if rec := runDeviceToken(h, "device_code=fakeDeviceCode"); rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+	})
+}
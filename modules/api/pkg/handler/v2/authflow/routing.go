@@ -53,6 +53,14 @@ func (a *authHandler) Install(router *mux.Router) {
 	router.Methods(http.MethodGet).
 		Path("/auth/status").
 		Handler(a.statusHandler())
+
+	router.Methods(http.MethodPost).
+		Path("/auth/device").
+		Handler(a.deviceAuthHandler())
+
+	router.Methods(http.MethodPost).
+		Path("/auth/device/token").
+		Handler(a.deviceTokenHandler())
 }
 
 // NewAuthHandler creates a new Handler for KKP dashboard authentication.
@@ -62,6 +70,8 @@ func NewAuthHandler(oidcIssuerVerifier authtypes.OIDCIssuerVerifier, userProvide
 		tokenExtractor: handlerauth.NewCombinedExtractor(
 			handlerauth.NewCookieHeaderBearerTokenExtractor(idTokenCookieName),
 			handlerauth.NewCookieHeaderBearerMultiTokenExtractor(idTokenCookieName),
+			// tokens of the device authorization are sent by CLI tools without cookies
+			handlerauth.NewHeaderBearerTokenExtractor("Authorization"),
 		),
 		userProvider:             userProvider,
 		kubermaticConfigProvider: kubermaticConfigProvider,
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chargeback
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/chargeback"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// updateSchemaReq defines HTTP request for updateChargebackSchema
+// swagger:parameters updateChargebackSchema
+type updateSchemaReq struct {
+	// in: body
+	// required: true
+	Body apiv2.ChargebackSchema
+}
+
+// attributesReq defines the attributes of the chargeback breakdowns
+// swagger:parameters getChargebackMetering getChargebackQuotas
+type attributesReq struct {
+	// Attributes are the comma separated keys of the attributes to break down by, all attributes of the schema
+	// by default.
+	// in: query
+	Attributes string `json:"attributes,omitempty"`
+
+	attributes []string
+}
+
+type meteringReq struct {
+	attributesReq
+
+	report interface{}
+}
+
+func GetSchemaEndpoint(schemaProvider provider.ChargebackSchemaProvider) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		chargebackSchema, err := schemaProvider.Get(ctx)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		return chargebackSchema, nil
+	}
+}
+
+func UpdateSchemaEndpoint(userInfoGetter provider.UserInfoGetter, schemaProvider provider.ChargebackSchemaProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(updateSchemaReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if err := chargeback.ValidateSchema(&req.Body); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+		chargebackSchema, err := schemaProvider.Update(ctx, userInfo, &req.Body)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		return chargebackSchema, nil
+	}
+}
+
+func MeteringEndpoint(userInfoGetter provider.UserInfoGetter, schemaProvider provider.ChargebackSchemaProvider,
+	seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(meteringReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		if err := verifyAdmin(ctx, userInfoGetter); err != nil {
+			return nil, err
+		}
+
+		attributes, err := selectAttributes(ctx, schemaProvider, req.attributes)
+		if err != nil {
+			return nil, err
+		}
+		return exportChargebackMetering(ctx, req.report, attributes, seedsGetter, seedClientGetter)
+	}
+}
+
+func QuotasEndpoint(userInfoGetter provider.UserInfoGetter, schemaProvider provider.ChargebackSchemaProvider,
+	quotaProvider provider.ResourceQuotaProvider, projectProvider provider.ProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(attributesReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		if err := verifyAdmin(ctx, userInfoGetter); err != nil {
+			return nil, err
+		}
+
+		attributes, err := selectAttributes(ctx, schemaProvider, req.attributes)
+		if err != nil {
+			return nil, err
+		}
+		return getChargebackQuotas(ctx, attributes, quotaProvider, projectProvider)
+	}
+}
+
+func verifyAdmin(ctx context.Context, userInfoGetter provider.UserInfoGetter) error {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return err
+	}
+	if !userInfo.IsAdmin {
+		return apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%s doesn't have admin rights", userInfo.Email))
+	}
+	return nil
+}
+
+// selectAttributes returns the requested attributes, or all attributes of the schema if none were requested.
+func selectAttributes(ctx context.Context, schemaProvider provider.ChargebackSchemaProvider, requested []string) ([]string, error) {
+	chargebackSchema, err := schemaProvider.Get(ctx)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	keys := chargeback.Keys(chargebackSchema)
+	if len(requested) == 0 {
+		return keys, nil
+	}
+	known := sets.New(keys...)
+	for _, attribute := range requested {
+		if !known.Has(attribute) {
+			return nil, utilerrors.NewBadRequest("unknown chargeback attribute %q", attribute)
+		}
+	}
+	return requested, nil
+}
+
+func DecodeUpdateSchemaReq(_ context.Context, r *http.Request) (interface{}, error) {
+	var req updateSchemaReq
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the chargeback schema: %v", err)
+	}
+	return req, nil
+}
+
+func decodeAttributesReq(r *http.Request) attributesReq {
+	req := attributesReq{Attributes: r.URL.Query().Get("attributes")}
+	for _, attribute := range strings.Split(req.Attributes, ",") {
+		if attribute = strings.TrimSpace(attribute); attribute != "" {
+			req.attributes = append(req.attributes, attribute)
+		}
+	}
+	return req
+}
+
+func DecodeQuotasReq(_ context.Context, r *http.Request) (interface{}, error) {
+	return decodeAttributesReq(r), nil
+}
+
+func DecodeMeteringReq(_ context.Context, r *http.Request) (interface{}, error) {
+	report, err := decodeMeteringReportReq(r)
+	if err != nil {
+		return nil, err
+	}
+	return meteringReq{attributesReq: decodeAttributesReq(r), report: report}, nil
+}
+
+// EncodeMetering writes the metering breakdown as JSON or as a CSV or Parquet file download.
+func EncodeMetering(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	return encodeChargebackMetering(w, response)
+}
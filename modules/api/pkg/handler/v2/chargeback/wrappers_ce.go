@@ -0,0 +1,45 @@
+//go:build !ee
+
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chargeback
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+)
+
+func exportChargebackMetering(_ context.Context, _ interface{}, _ []string, _ provider.SeedsGetter, _ provider.SeedClientGetter) (interface{}, error) {
+	return nil, nil
+}
+
+func encodeChargebackMetering(w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(response)
+}
+
+func decodeMeteringReportReq(_ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func getChargebackQuotas(_ context.Context, _ []string, _ provider.ResourceQuotaProvider, _ provider.ProjectProvider) (*apiv2.ChargebackQuotaBreakdown, error) {
+	return nil, nil
+}
@@ -0,0 +1,51 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package chargeback
+
+import (
+	"context"
+	"net/http"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/ee/metering"
+	resourcequota "k8c.io/dashboard/v2/pkg/ee/resource-quota"
+	"k8c.io/dashboard/v2/pkg/provider"
+)
+
+func exportChargebackMetering(ctx context.Context, request interface{}, attributes []string, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) (interface{}, error) {
+	return metering.ExportReportsByLabels(ctx, request, attributes, seedsGetter, seedClientGetter)
+}
+
+func encodeChargebackMetering(w http.ResponseWriter, response interface{}) error {
+	return metering.EncodeReportExport(w, response)
+}
+
+func decodeMeteringReportReq(r *http.Request) (interface{}, error) {
+	return metering.DecodeExportMeteringReportReq(r)
+}
+
+func getChargebackQuotas(ctx context.Context, attributes []string, quotaProvider provider.ResourceQuotaProvider, projectProvider provider.ProjectProvider) (*apiv2.ChargebackQuotaBreakdown, error) {
+	return resourcequota.GetChargebackBreakdown(ctx, attributes, quotaProvider, projectProvider)
+}
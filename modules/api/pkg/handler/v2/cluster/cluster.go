@@ -34,6 +34,7 @@ import (
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/healthhistory"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -54,6 +55,7 @@ func CreateEndpoint(
 	caBundle *x509.CertPool,
 	configGetter provider.KubermaticConfigurationGetter,
 	features features.FeatureGate,
+	chargebackSchemaProvider provider.ChargebackSchemaProvider,
 ) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreateClusterReq)
@@ -69,7 +71,7 @@ func CreateEndpoint(
 		}
 
 		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, projectProvider, privilegedProjectProvider,
-			seedsGetter, credentialManager, exposeStrategy, userInfoGetter, caBundle, configGetter, features, settingsProvider)
+			seedsGetter, credentialManager, exposeStrategy, userInfoGetter, caBundle, configGetter, features, settingsProvider, chargebackSchemaProvider)
 	}
 }
 
@@ -129,6 +131,21 @@ func ListEndpoint(
 			clusterList[idx] = *cluster
 		}
 
+		// Clusters from all seeds are merged before paging, the continue token
+		// therefore stays valid regardless of the order in which seeds are listed.
+		var page *common.ListPage
+		if req.ListOptionsReq.IsSet() {
+			page, err = common.PaginateList(req.ListOptionsReq, clusterList)
+			if err != nil {
+				return nil, err
+			}
+			clusterList = page.Items.(apiv1.ClusterList)
+		}
+
+		result := apiv2.ProjectClusterList{
+			Clusters: clusterList,
+		}
+
 		if len(brokenSeeds) > 0 {
 			errMsg := "Failed to fetch data for one or more seeds. Please contact an administrator."
 
@@ -141,15 +158,18 @@ func ListEndpoint(
 				errMsg = fmt.Sprintf("Failed to fetch data for following seeds: %s.", brokenSeedsAsStr)
 			}
 
-			return apiv2.ProjectClusterList{
-				Clusters:     clusterList,
-				ErrorMessage: &errMsg,
+			result.ErrorMessage = &errMsg
+		}
+
+		if page != nil {
+			return common.ListResponse{
+				Body:       result,
+				Continue:   page.Continue,
+				TotalCount: page.TotalCount,
 			}, nil
 		}
 
-		return apiv2.ProjectClusterList{
-			Clusters: clusterList,
-		}, nil
+		return result, nil
 	}
 }
 
@@ -190,6 +210,13 @@ func HealthEndpoint(projectProvider provider.ProjectProvider, privilegedProjectP
 	}
 }
 
+func HealthHistoryEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, history *healthhistory.History) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(HealthHistoryReq)
+		return handlercommon.HealthHistoryEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Windows, projectProvider, privilegedProjectProvider, history)
+	}
+}
+
 func MigrateEndpointToExternalCCM(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, configGetter provider.KubermaticConfigurationGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetClusterReq)
@@ -406,6 +433,44 @@ func DecodeGetClusterEvents(c context.Context, r *http.Request) (interface{}, er
 	return req, nil
 }
 
+// HealthHistoryReq defines HTTP request for getClusterHealthHistoryV2 endpoint
+// swagger:parameters getClusterHealthHistoryV2
+type HealthHistoryReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+
+	// Comma separated list of time windows ending now, e.g. 1h,24h,168h. Defaults to the last hour, day and week.
+	// in: query
+	Windows string `json:"windows,omitempty"`
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req HealthHistoryReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeHealthHistoryReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req HealthHistoryReq
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+	req.Windows = r.URL.Query().Get("windows")
+
+	return req, nil
+}
+
 // PatchReq defines HTTP request for patchCluster endpoint
 // swagger:parameters patchClusterV2
 type PatchReq struct {
@@ -522,6 +587,7 @@ func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 // swagger:parameters listClustersV2
 type ListClustersReq struct {
 	common.ProjectReq
+	common.ListOptionsReq
 
 	// in: query
 	ShowDeploymentMachineCount bool `json:"show_dm_count"`
@@ -536,6 +602,11 @@ func DecodeListClustersReq(c context.Context, r *http.Request) (interface{}, err
 	}
 	req.ProjectReq = pr.(common.ProjectReq)
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	showDeploymentMachineCount := r.URL.Query().Get("show_dm_count")
 	if strings.EqualFold(showDeploymentMachineCount, "true") {
 		req.ShowDeploymentMachineCount = true
@@ -26,6 +26,7 @@ import (
 	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -391,3 +392,79 @@ users:
   user:
     token: %s`, userName, clusterID, clusterID, userName, tokenID)
 }
+
+func TestGetMasterKubeconfigServiceAccountScope(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name       string
+		Scope      *serviceaccount.TokenScope
+		HTTPStatus int
+	}{
+		{
+			Name:       "scenario 1: an unscoped service account token gets the master kubeconfig",
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:       "scenario 2: a read-only service account token can not get the master kubeconfig",
+			Scope:      &serviceaccount.TokenScope{ReadOnly: true},
+			HTTPStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			sa := test.GenProjectServiceAccount("1", "test", "editors", "foo-ID")
+			tokenGenerator, err := serviceaccount.JWTTokenGenerator([]byte(test.TestServiceAccountHashKey))
+			if err != nil {
+				t.Fatalf("failed to create the token generator: %v", err)
+			}
+			token, err := tokenGenerator.Generate(serviceaccount.ScopedClaims(sa.Spec.Email, "foo-ID", "1", 0, tc.Scope))
+			if err != nil {
+				t.Fatalf("failed to generate the token: %v", err)
+			}
+			tokenSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "sa-token-1",
+					Namespace: "kubermatic",
+					Labels:    map[string]string{kubermaticv1.ProjectIDLabelKey: "foo-ID", "name": "ci"},
+				},
+				Data: map[string][]byte{"token": []byte(token)},
+				Type: "Opaque",
+			}
+
+			kubermaticObjs := []ctrlruntimeclient.Object{
+				test.GenTestSeed(),
+				test.GenProject("foo", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("foo-ID", sa.Spec.Email, "editors"),
+				sa,
+				test.GenCluster("cluster-foo", "cluster-foo", "foo-ID", test.DefaultCreationTimestamp()),
+			}
+			kubeObjs := []ctrlruntimeclient.Object{
+				tokenSecret,
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "cluster-cluster-foo",
+						Name:      "admin-kubeconfig",
+					},
+					Data: map[string][]byte{
+						"kubeconfig": []byte(test.GenerateTestKubeconfig("cluster-foo", test.IDToken)),
+					},
+				},
+			}
+			apiSA := apiv1.User{ObjectMeta: apiv1.ObjectMeta{Name: sa.Name}, Email: sa.Spec.Email}
+			ep, _, err := test.CreateTestEndpointAndGetClients(apiSA, nil, kubeObjs, []ctrlruntimeclient.Object{}, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/projects/foo-ID/clusters/cluster-foo/kubeconfig", nil)
+			req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+		})
+	}
+}
@@ -40,6 +40,16 @@ func GetUpgradesEndpoint(configGetter provider.KubermaticConfigurationGetter, pr
 	}
 }
 
+func GetUpgradePlanEndpoint(configGetter provider.KubermaticConfigurationGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(UpgradePlanReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, UpgradePlanReq{})
+		}
+		return handlercommon.GetUpgradePlanEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Version, projectProvider, privilegedProjectProvider, configGetter)
+	}
+}
+
 func UpgradeNodeDeploymentsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(UpgradeNodeDeploymentsReq)
@@ -88,3 +98,45 @@ func DecodeUpgradeNodeDeploymentsReq(c context.Context, r *http.Request) (interf
 
 	return req, nil
 }
+
+// UpgradePlanReq defines HTTP request for getClusterUpgradePlanV2 endpoint
+// swagger:parameters getClusterUpgradePlanV2
+type UpgradePlanReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+
+	// The Kubernetes version to plan the upgrade to.
+	// in: query
+	// required: true
+	Version string `json:"version"`
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req UpgradePlanReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeUpgradePlanReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req UpgradePlanReq
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	req.Version = r.URL.Query().Get("version")
+	if req.Version == "" {
+		return nil, utilerrors.NewBadRequest("the version query parameter is required")
+	}
+
+	return req, nil
+}
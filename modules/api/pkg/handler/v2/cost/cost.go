@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/pricing"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// estimateReq defines HTTP request for estimateClusterCost
+// swagger:parameters estimateClusterCost
+type estimateReq struct {
+	common.ProjectReq
+	// in: body
+	// required: true
+	Body apiv2.CostEstimationBody
+}
+
+// clusterReq defines HTTP request for getClusterCost
+// swagger:parameters getClusterCost
+type clusterReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req clusterReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+// projectReq defines HTTP request for getProjectCost
+// swagger:parameters getProjectCost
+type projectReq struct {
+	common.ProjectReq
+}
+
+func DecodeEstimateReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req estimateReq
+
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("invalid body: %v", err)
+	}
+	if req.Body.Datacenter == "" {
+		return nil, utilerrors.NewBadRequest("the datacenter is required")
+	}
+
+	return req, nil
+}
+
+func DecodeClusterReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req clusterReq
+
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	return req, nil
+}
+
+func DecodeProjectReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	return projectReq{ProjectReq: pr.(common.ProjectReq)}, nil
+}
+
+// EstimateEndpoint estimates the monthly cost of a cluster before it is created.
+func EstimateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, source pricing.Source) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(estimateReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, estimateReq{})
+		}
+		return handlercommon.EstimateClusterCostEndpoint(ctx, userInfoGetter, req.ProjectID, req.Body, projectProvider, privilegedProjectProvider, seedsGetter, source)
+	}
+}
+
+// ClusterEndpoint estimates the monthly cost of a running cluster.
+func ClusterEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, source pricing.Source) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(clusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, clusterReq{})
+		}
+		return handlercommon.ClusterCostEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider, source)
+	}
+}
+
+// ProjectEndpoint estimates the monthly cost of all clusters of a project.
+func ProjectEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, userInfoGetter provider.UserInfoGetter, source pricing.Source) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(projectReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, projectReq{})
+		}
+		return handlercommon.ProjectCostEndpoint(ctx, userInfoGetter, req.ProjectID, projectProvider, privilegedProjectProvider, seedsGetter, clusterProviderGetter, source)
+	}
+}
@@ -84,6 +84,7 @@ type AKSProjectCommonReq struct {
 // swagger:parameters listAKSVMSizes
 type AKSVMSizesReq struct {
 	AKSCommonReq
+	common.ListOptionsReq
 	// Location - Resource location
 	// in: header
 	// name: Location
@@ -101,6 +102,7 @@ type AKSProjectVMSizesReq struct {
 // swagger:parameters listAKSVMSizesNoCredentials
 type aksNoCredentialReq struct {
 	GetClusterReq
+	common.ListOptionsReq
 	// Location - Resource location
 	// in: header
 	// name: Location
@@ -117,6 +119,11 @@ func DecodeAKSVMSizesReq(c context.Context, r *http.Request) (interface{}, error
 	req.AKSCommonReq = commonReq.(AKSCommonReq)
 	req.Location = r.Header.Get("Location")
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -129,6 +136,11 @@ func DecodeAKSNoCredentialReq(c context.Context, r *http.Request) (interface{},
 	req.GetClusterReq = re.(GetClusterReq)
 	req.Location = r.Header.Get("Location")
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -430,7 +442,7 @@ func getAKSCredentialsFromReq(ctx context.Context, req AKSCommonReq, userInfoGet
 	}
 
 	if len(req.Credential) > 0 {
-		preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(projectID), req.Credential)
+		preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), req.Credential, string(kubermaticv1.AKSProviderType))
 		if err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 		}
@@ -568,12 +580,16 @@ func createOrImportAKSCluster(ctx context.Context, name string, userInfoGetter p
 	// If Spec is not nil, it is interpreted as create cluster on the provider.
 	isCreation := (spec != nil && spec.AKSClusterSpec != nil)
 
+	resolved := *cloud.AKS
+	if err := resolveCredentials(ctx, &resolved); err != nil {
+		return nil, err
+	}
 	if err := aks.ValidateCredentialsPermissions(ctx, resources.AKSCredentials{
-		TenantID:       cloud.AKS.TenantID,
-		ClientID:       cloud.AKS.ClientID,
-		SubscriptionID: cloud.AKS.SubscriptionID,
-		ClientSecret:   cloud.AKS.ClientSecret,
-	}, cloud.AKS.ResourceGroup, isCreation); err != nil {
+		TenantID:       resolved.TenantID,
+		ClientID:       resolved.ClientID,
+		SubscriptionID: resolved.SubscriptionID,
+		ClientSecret:   resolved.ClientSecret,
+	}, resolved.ResourceGroup, isCreation); err != nil {
 		return nil, err
 	}
 
@@ -581,7 +597,7 @@ func createOrImportAKSCluster(ctx context.Context, name string, userInfoGetter p
 		if err := checkCreateClusterReqValidity(cloud.AKS, spec.AKSClusterSpec); err != nil {
 			return nil, err
 		}
-		if err := createNewAKSCluster(ctx, spec.AKSClusterSpec, cloud.AKS); err != nil {
+		if err := createNewAKSCluster(ctx, spec.AKSClusterSpec, &resolved); err != nil {
 			return nil, err
 		}
 		isImported = resources.ExternalClusterIsImportedFalse
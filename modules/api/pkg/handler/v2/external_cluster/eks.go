@@ -35,6 +35,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	awsprovider "k8c.io/dashboard/v2/pkg/provider/cloud/aws"
 	eksprovider "k8c.io/dashboard/v2/pkg/provider/cloud/eks"
+	"k8c.io/dashboard/v2/pkg/providercache"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -307,7 +308,7 @@ func ListEKSClustersEndpoint(userInfoGetter provider.UserInfoGetter, projectProv
 	}
 }
 
-func ListEKSVPCEndpoint(userInfoGetter provider.UserInfoGetter, presetProvider provider.PresetProvider, withProject bool) endpoint.Endpoint {
+func ListEKSVPCEndpoint(userInfoGetter provider.UserInfoGetter, presetProvider provider.PresetProvider, withProject bool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		var (
 			req       EKSRegionReq
@@ -339,11 +340,11 @@ func ListEKSVPCEndpoint(userInfoGetter provider.UserInfoGetter, presetProvider p
 			return nil, err
 		}
 
-		return providercommon.ListEKSVPC(ctx, *credential)
+		return providercommon.ListEKSVPC(ctx, providerCache, *credential)
 	}
 }
 
-func ListEKSSubnetsEndpoint(userInfoGetter provider.UserInfoGetter, presetProvider provider.PresetProvider, withProject bool) endpoint.Endpoint {
+func ListEKSSubnetsEndpoint(userInfoGetter provider.UserInfoGetter, presetProvider provider.PresetProvider, withProject bool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		var (
 			req       EKSVPCReq
@@ -375,7 +376,7 @@ func ListEKSSubnetsEndpoint(userInfoGetter provider.UserInfoGetter, presetProvid
 			return nil, err
 		}
 
-		return providercommon.ListEKSSubnetIDs(ctx, *credential, req.VpcId)
+		return providercommon.ListEKSSubnetIDs(ctx, providerCache, *credential, req.VpcId)
 	}
 }
 
@@ -585,7 +586,7 @@ func getEKSCredentialsFromReq(ctx context.Context, req EKSRegionReq, userInfoGet
 
 	presetName := req.Credential
 	if len(presetName) > 0 {
-		preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(projectID), presetName)
+		preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), presetName, string(kubermaticv1.EKSProviderType))
 		if err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", presetName, userInfo.Email))
 		}
@@ -680,7 +681,11 @@ func createOrImportEKSCluster(ctx context.Context, name string, userInfoGetter p
 	}
 
 	if spec != nil && spec.EKSClusterSpec != nil {
-		if err := createNewEKSCluster(ctx, spec.EKSClusterSpec, cloud.EKS); err != nil {
+		resolved := *cloud.EKS
+		if err := resolveCredentials(ctx, &resolved); err != nil {
+			return nil, err
+		}
+		if err := createNewEKSCluster(ctx, spec.EKSClusterSpec, &resolved); err != nil {
 			return nil, err
 		}
 		isImported = resources.ExternalClusterIsImportedFalse
@@ -918,7 +923,7 @@ func deleteEKSNodeGroup(ctx context.Context, cluster *kubermaticv1.ExternalClust
 	return eksprovider.DeleteNodegroup(ctx, client, eksClusterCloudSpec.Name, nodeGroupName)
 }
 
-func EKSInstanceTypesWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func EKSInstanceTypesWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, settingsProvider provider.SettingsProvider, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(eksNoCredentialSizeReq)
 		if !ok {
@@ -956,11 +961,11 @@ func EKSInstanceTypesWithClusterCredentialsEndpoint(userInfoGetter provider.User
 			Region:               cloudSpec.Region,
 		}
 
-		return providercommon.ListInstanceTypes(ctx, credential, req.Architecture)
+		return providercommon.ListInstanceTypes(ctx, providerCache, credential, req.Architecture)
 	}
 }
 
-func EKSVPCsWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func EKSVPCsWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, settingsProvider provider.SettingsProvider, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(eksNoCredentialReq)
 		if !ok {
@@ -997,11 +1002,11 @@ func EKSVPCsWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGette
 			AssumeRoleExternalID: creds.AssumeRoleExternalID,
 			Region:               cloudSpec.Region,
 		}
-		return providercommon.ListEKSVPC(ctx, credential)
+		return providercommon.ListEKSVPC(ctx, providerCache, credential)
 	}
 }
 
-func EKSSubnetsWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func EKSSubnetsWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, settingsProvider provider.SettingsProvider, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(eksSubnetsNoCredentialReq)
 		if !ok {
@@ -1042,7 +1047,7 @@ func EKSSubnetsWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGe
 			AssumeRoleExternalID: creds.AssumeRoleExternalID,
 			Region:               cloudSpec.Region,
 		}
-		return providercommon.ListEKSSubnetIDs(ctx, credential, req.VpcId)
+		return providercommon.ListEKSSubnetIDs(ctx, providerCache, credential, req.VpcId)
 	}
 }
 
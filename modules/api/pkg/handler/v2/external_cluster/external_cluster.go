@@ -30,6 +30,7 @@ import (
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/credentials"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
@@ -185,6 +186,7 @@ func CreateEndpoint(
 		}
 		var preset *kubermaticv1.Preset
 		if len(req.Credential) > 0 {
+			// the cluster keeps the references to credentials in external stores of the preset
 			preset, err = presetProvider.GetPreset(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential)
 			if err != nil {
 				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
@@ -293,6 +295,15 @@ func CreateEndpoint(
 	}
 }
 
+// resolveCredentials resolves the references to credentials in external stores of the cloud spec of a provider in
+// place. It is called on copies of the spec to reach the provider, the cluster keeps the references.
+func resolveCredentials(ctx context.Context, spec interface{}) error {
+	if err := credentials.FromContext(ctx).ResolveFields(ctx, spec); err != nil {
+		return utilerrors.NewBadRequest("invalid credentials: %v", err)
+	}
+	return nil
+}
+
 func DeleteEndpoint(userInfoGetter provider.UserInfoGetter,
 	projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider,
@@ -452,6 +463,7 @@ func ListEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provid
 // swagger:parameters listExternalClusters
 type listClusterReq struct {
 	common.ProjectReq
+	common.ListOptionsReq
 }
 
 func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
@@ -463,6 +475,11 @@ func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
 	}
 	req.ProjectReq = pr.(common.ProjectReq)
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -532,7 +549,7 @@ func GetEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provide
 }
 
 // GetClusterReq defines HTTP request for getExternalCluster
-// swagger:parameters getExternalCluster getExternalClusterMetrics getExternalClusterUpgrades getExternalClusterKubeconfig listGKEClusterDiskTypes listGKEClusterSizes listGKEClusterZones listGKEClusterImages listAKSNodeVersionsNoCredentials
+// swagger:parameters getExternalCluster getExternalClusterMetrics getExternalClusterUpgrades getExternalClusterKubeconfig listGKEClusterDiskTypes listGKEClusterZones listGKEClusterImages listAKSNodeVersionsNoCredentials
 type GetClusterReq struct {
 	common.ProjectReq
 	// in: path
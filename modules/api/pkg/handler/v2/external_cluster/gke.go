@@ -167,7 +167,7 @@ func GKESizesWithClusterCredentialsEndpoint(userInfoGetter provider.UserInfoGett
 			return nil, utilerrors.New(http.StatusForbidden, "external cluster functionality is disabled")
 		}
 
-		req, ok := request.(GetClusterReq)
+		req, ok := request.(gkeSizesNoCredentialReq)
 		if !ok {
 			return nil, utilerrors.NewBadRequest("invalid request")
 		}
@@ -235,7 +235,11 @@ func createOrImportGKECluster(ctx context.Context, name string, userInfoGetter p
 	}
 
 	if spec != nil && spec.GKEClusterSpec != nil {
-		if err := createNewGKECluster(ctx, spec.GKEClusterSpec, cloud.GKE); err != nil {
+		resolved := *cloud.GKE
+		if err := resolveCredentials(ctx, &resolved); err != nil {
+			return nil, err
+		}
+		if err := createNewGKECluster(ctx, spec.GKEClusterSpec, &resolved); err != nil {
 			return nil, err
 		}
 		isImported = resources.ExternalClusterIsImportedFalse
@@ -740,7 +744,7 @@ func deleteGKECluster(ctx context.Context, secretKeySelector provider.SecretKeyS
 }
 
 // GKEVMReq represent a request for GKE VM.
-// swagger:parameters listGKEImages listGKEVMSizes listGKEDiskTypes
+// swagger:parameters listGKEImages listGKEDiskTypes
 type GKEVMReq struct {
 	GKECommonReq
 	// The zone name
@@ -750,12 +754,33 @@ type GKEVMReq struct {
 }
 
 // GKEProjectVMReq represents a request for GKE VM information in a project.
-// swagger:parameters listProjectGKEImages listProjectGKEVMSizes listProjectGKEDiskTypes
+// swagger:parameters listProjectGKEImages listProjectGKEDiskTypes
 type GKEProjectVMReq struct {
 	common.ProjectReq
 	GKEVMReq
 }
 
+// GKEVMSizesReq represent a request for GKE VM sizes.
+// swagger:parameters listGKEVMSizes
+type GKEVMSizesReq struct {
+	GKEVMReq
+	common.ListOptionsReq
+}
+
+// GKEProjectVMSizesReq represents a request for GKE VM sizes in a project.
+// swagger:parameters listProjectGKEVMSizes
+type GKEProjectVMSizesReq struct {
+	GKEProjectVMReq
+	common.ListOptionsReq
+}
+
+// gkeSizesNoCredentialReq represent a request for the GKE VM sizes of an external cluster.
+// swagger:parameters listGKEClusterSizes
+type gkeSizesNoCredentialReq struct {
+	GetClusterReq
+	common.ListOptionsReq
+}
+
 // GKEVersionsReq represent a request for GKE versions.
 // swagger:parameters listGKEVersions
 type GKEVersionsReq struct {
@@ -898,6 +923,57 @@ func DecodeGKEProjectVMReq(c context.Context, r *http.Request) (interface{}, err
 	}, nil
 }
 
+func DecodeGKEVMSizesReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req GKEVMSizesReq
+
+	vmReq, err := DecodeGKEVMReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GKEVMReq = vmReq.(GKEVMReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func DecodeGKEProjectVMSizesReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req GKEProjectVMSizesReq
+
+	vmReq, err := DecodeGKEProjectVMReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GKEProjectVMReq = vmReq.(GKEProjectVMReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func DecodeGKESizesNoCredentialReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req gkeSizesNoCredentialReq
+
+	clusterReq, err := DecodeGetReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = clusterReq.(GetClusterReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func DecodeGKEVersionsReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req GKEVersionsReq
 
@@ -1135,7 +1211,7 @@ func getSAFromPreset(ctx context.Context,
 	if err != nil {
 		return "", common.KubernetesErrorToHTTPError(err)
 	}
-	preset, err := presetProvider.GetPreset(ctx, userInfo, &projectID, presetName)
+	preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, &projectID, presetName, string(kubermaticv1.GKEProviderType))
 	if err != nil {
 		return "", utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", presetName, userInfo.Email))
 	}
@@ -1200,13 +1276,13 @@ func GKEVMSizesEndpoint(presetProvider provider.PresetProvider, userInfoGetter p
 		)
 
 		if !withProject {
-			vmReq, ok := request.(GKEVMReq)
+			vmReq, ok := request.(GKEVMSizesReq)
 			if !ok {
 				return nil, utilerrors.NewBadRequest("invalid request")
 			}
-			req = vmReq
+			req = vmReq.GKEVMReq
 		} else {
-			projectReq, ok := request.(GKEProjectVMReq)
+			projectReq, ok := request.(GKEProjectVMSizesReq)
 			if !ok {
 				return nil, utilerrors.NewBadRequest("invalid request")
 			}
@@ -77,6 +77,7 @@ func importKubeOneCluster(ctx context.Context, preset *kubermaticv1.Preset, user
 		cloud.KubeOne.CloudSpec = &apiv2.KubeOneCloudSpec{}
 	}
 	if preset != nil {
+		// the credential secret keeps the references to credentials in external stores of the preset
 		if cloud.KubeOne.CloudSpec, err = setKubeOneCloudCredentials(preset, newCluster.Spec.CloudSpec.KubeOne.ProviderName, *cloud.KubeOne.CloudSpec); err != nil {
 			return nil, err
 		}
@@ -255,6 +255,7 @@ func (req machineDeploymentNodesReq) GetSeedCluster() apiv1.SeedCluster {
 // swagger:parameters listMachineDeploymentNodes
 type machineDeploymentNodesReq struct {
 	common.ProjectReq
+	common.ListOptionsReq
 	// in: path
 	ClusterID string `json:"cluster_id"`
 	// in: path
@@ -289,6 +290,11 @@ func DecodeListMachineDeploymentNodes(c context.Context, r *http.Request) (inter
 		req.HideInitialConditions = true
 	}
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -303,6 +309,7 @@ func ListMachineDeploymentNodes(projectProvider provider.ProjectProvider, privil
 // swagger:parameters listNodesForCluster
 type listNodesForClusterReq struct {
 	common.ProjectReq
+	common.ListOptionsReq
 	// in: path
 	ClusterID string `json:"cluster_id"`
 	// in: query
@@ -333,6 +340,11 @@ func DecodeListNodesForCluster(c context.Context, r *http.Request) (interface{},
 
 	req.HideInitialConditions, _ = strconv.ParseBool(r.URL.Query().Get("hideInitialConditions"))
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -29,6 +29,7 @@ import (
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/common"
 	v1common "k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/presethealth"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
@@ -1088,6 +1089,88 @@ func GetPresetLinkages(presetProvider provider.PresetProvider, userInfoGetter pr
 	}
 }
 
+// presetHealthReq represents a request for the health of the credentials of a preset
+// swagger:parameters getPresetStatus validatePresetCredentials
+type presetHealthReq struct {
+	// in: path
+	// required: true
+	PresetName string `json:"preset_name"`
+}
+
+// Validate validates presetHealthReq request.
+func (r presetHealthReq) Validate() error {
+	if len(r.PresetName) == 0 {
+		return fmt.Errorf("preset name cannot be empty")
+	}
+	return nil
+}
+
+func DecodePresetHealth(_ context.Context, r *http.Request) (interface{}, error) {
+	var req presetHealthReq
+
+	req.PresetName = mux.Vars(r)["preset_name"]
+
+	return req, nil
+}
+
+// GetPresetStatus returns the results of the last checks of the credentials of the providers of a preset.
+func GetPresetStatus(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, checker *presethealth.Checker) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		preset, err := getAdminPreset(ctx, request, presetProvider, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		return checker.Health(preset), nil
+	}
+}
+
+// ValidatePresetCredentials checks the credentials of the providers of a preset with an authenticated call to each
+// provider and stores the results in the status of the preset.
+func ValidatePresetCredentials(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, checker *presethealth.Checker) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		preset, err := getAdminPreset(ctx, request, presetProvider, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		health, err := checker.Check(ctx, preset)
+		if err != nil {
+			return nil, v1common.KubernetesErrorToHTTPError(err)
+		}
+		return health, nil
+	}
+}
+
+func getAdminPreset(ctx context.Context, request interface{}, presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) (*kubermaticv1.Preset, error) {
+	req, ok := request.(presetHealthReq)
+	if !ok {
+		return nil, utilerrors.NewBadRequest("invalid request")
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, v1common.KubernetesErrorToHTTPError(err)
+	}
+
+	if !userInfo.IsAdmin {
+		return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+	}
+
+	preset, err := presetProvider.GetPreset(ctx, userInfo, nil, req.PresetName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, utilerrors.NewBadRequest("preset was not found.")
+		}
+		return nil, v1common.KubernetesErrorToHTTPError(err)
+	}
+	return preset, nil
+}
+
 func mergePresets(oldPreset *kubermaticv1.Preset, newPreset *kubermaticv1.Preset, providerType kubermaticv1.ProviderType) *kubermaticv1.Preset {
 	oldPreset = common.OverridePresetProvider(oldPreset, providerType, newPreset)
 	oldPreset.Spec.RequiredEmails = newPreset.Spec.RequiredEmails
@@ -34,9 +34,13 @@ import (
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+	"k8c.io/dashboard/v2/pkg/presethealth"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/test/diff"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -1537,3 +1541,366 @@ func TestPresetStats(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPresetStatus(t *testing.T) {
+	t.Parallel()
+	genPreset := func() *kubermaticv1.Preset {
+		return &kubermaticv1.Preset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "checked-preset",
+				Annotations: map[string]string{
+					presethealth.HealthAnnotation: `[{"provider":"hetzner","status":"Invalid","message":"unauthorized","lastChecked":"2026-01-01T12:00:00Z"}]`,
+				},
+			},
+			Spec: kubermaticv1.PresetSpec{
+				Hetzner: &kubermaticv1.Hetzner{Token: "token"},
+				GCP:     &kubermaticv1.GCP{ServiceAccount: "sa"},
+			},
+		}
+	}
+
+	testcases := []struct {
+		Name             string
+		PresetName       string
+		HTTPStatus       int
+		ExistingAPIUser  *apiv1.User
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: admin gets the health of the preset credentials",
+			PresetName:       "checked-preset",
+			HTTPStatus:       http.StatusOK,
+			ExistingAPIUser:  test.GenDefaultAdminAPIUser(),
+			ExpectedResponse: `{"presetName":"checked-preset","providers":[{"provider":"gcp","status":"Unknown","message":"the credentials were not checked yet","lastChecked":"0001-01-01T00:00:00Z"},{"provider":"hetzner","status":"Invalid","message":"unauthorized","lastChecked":"2026-01-01T12:00:00Z"}]}`,
+		},
+		{
+			Name:            "scenario 2: non-admin cannot get the health of the preset credentials",
+			PresetName:      "checked-preset",
+			HTTPStatus:      http.StatusForbidden,
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			Name:            "scenario 3: missing preset",
+			PresetName:      "missing-preset",
+			HTTPStatus:      http.StatusBadRequest,
+			ExistingAPIUser: test.GenDefaultAdminAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/presets/%s/status", tc.PresetName), strings.NewReader(""))
+			res := httptest.NewRecorder()
+
+			existingKubermaticObjs := []ctrlruntimeclient.Object{test.APIUserToKubermaticUser(*tc.ExistingAPIUser), genPreset()}
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, []ctrlruntimeclient.Object{}, existingKubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedResponse != "" {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestValidatePresetCredentials(t *testing.T) {
+	t.Parallel()
+	preset := &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{Name: "vsphere-preset"},
+		Spec: kubermaticv1.PresetSpec{
+			VSphere: &kubermaticv1.VSphere{Username: "admin", Password: "password"},
+		},
+	}
+	apiUser := test.GenDefaultAdminAPIUser()
+	existingKubermaticObjs := []ctrlruntimeclient.Object{test.APIUserToKubermaticUser(*apiUser), test.GenTestSeed(), preset}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/presets/vsphere-preset/validate", strings.NewReader(""))
+	res := httptest.NewRecorder()
+	ep, clientSets, err := test.CreateTestEndpointAndGetClients(*apiUser, nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, existingKubermaticObjs, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+
+	// the test seed has no vSphere datacenter to check the credentials against
+	health := &apiv2.PresetHealth{}
+	if err := json.Unmarshal(res.Body.Bytes(), health); err != nil {
+		t.Fatalf("failed to decode the response: %v", err)
+	}
+	if len(health.Providers) != 1 || health.Providers[0].Provider != kubermaticv1.VSphereCloudProvider || health.Providers[0].Status != apiv2.UnknownPresetCredentialStatus || health.Providers[0].LastChecked.IsZero() {
+		t.Fatalf("unexpected health %+v", health)
+	}
+
+	stored := &kubermaticv1.Preset{}
+	if err := clientSets.FakeMasterClient.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(preset), stored); err != nil {
+		t.Fatalf("failed to get preset: %v", err)
+	}
+	if _, ok := stored.Annotations[presethealth.HealthAnnotation]; !ok {
+		t.Fatal("expected the results to be stored in the preset")
+	}
+}
+
+func TestRotatePresetCredentials(t *testing.T) {
+	t.Parallel()
+
+	genCluster := func() *kubermaticv1.Cluster {
+		return test.GenCluster("clusterid", "cluster", test.GenDefaultProject().Name, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), func(cluster *kubermaticv1.Cluster) {
+			cluster.Labels[kubermaticv1.IsCredentialPresetLabelKey] = "true"
+			cluster.Annotations = map[string]string{kubermaticv1.PresetNameAnnotation: "anexia-preset"}
+			cluster.Spec.Cloud = kubermaticv1.CloudSpec{
+				DatacenterName: "private-do1",
+				ProviderName:   string(kubermaticv1.AnexiaCloudProvider),
+				Anexia:         &kubermaticv1.AnexiaCloudSpec{},
+			}
+		})
+	}
+	genObjects := func() []ctrlruntimeclient.Object {
+		preset := &kubermaticv1.Preset{
+			ObjectMeta: metav1.ObjectMeta{Name: "anexia-preset"},
+			Spec: kubermaticv1.PresetSpec{
+				RequiredEmails: []string{"acme.com"},
+				Anexia:         &kubermaticv1.Anexia{Token: "old"},
+			},
+		}
+		otherCluster := test.GenCluster("otherid", "other", test.GenDefaultProject().Name, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		return []ctrlruntimeclient.Object{test.GenTestSeed(), preset, genCluster(), otherCluster}
+	}
+
+	testCases := []struct {
+		name             string
+		dryRun           bool
+		body             string
+		apiUser          *apiv1.User
+		expectedCode     int
+		expectedStatus   string
+		expectedToken    string
+		expectedRequired []string
+	}{
+		{
+			name:             "scenario 1: dry run lists the clusters using the preset",
+			dryRun:           true,
+			body:             `{"spec":{"anexia":{"token":"new"}}}`,
+			apiUser:          test.GenDefaultAdminAPIUser(),
+			expectedCode:     http.StatusOK,
+			expectedStatus:   "planned",
+			expectedToken:    "old",
+			expectedRequired: []string{"acme.com"},
+		},
+		{
+			name:             "scenario 2: rotation updates the preset and the clusters using it",
+			body:             `{"spec":{"anexia":{"token":"new"}}}`,
+			apiUser:          test.GenDefaultAdminAPIUser(),
+			expectedCode:     http.StatusOK,
+			expectedStatus:   "updated",
+			expectedToken:    "new",
+			expectedRequired: []string{"acme.com"},
+		},
+		{
+			name:         "scenario 3: rotation without the credentials of the provider",
+			body:         `{"spec":{"hetzner":{"token":"new"}}}`,
+			apiUser:      test.GenDefaultAdminAPIUser(),
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "scenario 4: non-admin cannot rotate the credentials",
+			body:         `{"spec":{"anexia":{"token":"new"}}}`,
+			apiUser:      test.GenDefaultAPIUser(),
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := genObjects()
+			objects = append(objects, test.APIUserToKubermaticUser(*tc.apiUser))
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/presets/anexia-preset/provider/anexia/rotate?dryRun=%t", tc.dryRun), strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, clientSets, err := test.CreateTestEndpointAndGetClients(*tc.apiUser, nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, objects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+			if res.Code != tc.expectedCode {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.expectedCode, res.Code, res.Body.String())
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			report := &apiv2.PresetCredentialRotationReport{}
+			if err := json.Unmarshal(res.Body.Bytes(), report); err != nil {
+				t.Fatalf("failed to decode the response: %v", err)
+			}
+			// Anexia credentials cannot be checked
+			if report.DryRun != tc.dryRun || report.Validation.Status != apiv2.UnknownPresetCredentialStatus {
+				t.Fatalf("unexpected report %+v", report)
+			}
+			if len(report.Clusters) != 1 || report.Clusters[0].ClusterID != "clusterid" || report.Clusters[0].Seed != "us-central1" || report.Clusters[0].Status != tc.expectedStatus {
+				t.Fatalf("unexpected clusters %+v", report.Clusters)
+			}
+
+			preset := &kubermaticv1.Preset{}
+			if err := clientSets.FakeMasterClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "anexia-preset"}, preset); err != nil {
+				t.Fatalf("failed to get preset: %v", err)
+			}
+			if preset.Spec.Anexia.Token != tc.expectedToken || !reflect.DeepEqual(preset.Spec.RequiredEmails, tc.expectedRequired) {
+				t.Fatalf("unexpected preset %+v", preset.Spec)
+			}
+
+			secret := &corev1.Secret{}
+			err = clientSets.FakeSeedClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: resources.KubermaticNamespace, Name: genCluster().GetSecretName()}, secret)
+			if tc.dryRun {
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("expected no credential secret for a dry run, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get the credential secret: %v", err)
+			}
+			if token := string(secret.Data[resources.AnexiaToken]); token != tc.expectedToken {
+				t.Fatalf("expected the token %q in the credential secret, got %q", tc.expectedToken, token)
+			}
+
+			cluster := &kubermaticv1.Cluster{}
+			if err := clientSets.FakeSeedClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "clusterid"}, cluster); err != nil {
+				t.Fatalf("failed to get the cluster: %v", err)
+			}
+			if cluster.Spec.Cloud.Anexia.CredentialsReference == nil || cluster.Spec.Cloud.Anexia.CredentialsReference.Name != secret.Name {
+				t.Fatalf("expected the cluster to reference the credential secret, got %+v", cluster.Spec.Cloud.Anexia)
+			}
+			if _, ok := preset.Annotations["dashboard.kubermatic.io/previous-credentials-anexia"]; !ok {
+				t.Fatal("expected the previous credentials to be stored in the preset")
+			}
+			backup := &corev1.Secret{}
+			if err := clientSets.FakeSeedClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: resources.KubermaticNamespace, Name: "credential-rotation-clusterid"}, backup); err != nil {
+				t.Fatalf("failed to get the backup of the previous credentials: %v", err)
+			}
+		})
+	}
+}
+
+func TestRollbackPresetCredentials(t *testing.T) {
+	t.Parallel()
+
+	genObjects := func() []ctrlruntimeclient.Object {
+		preset := &kubermaticv1.Preset{
+			ObjectMeta: metav1.ObjectMeta{Name: "anexia-preset"},
+			Spec: kubermaticv1.PresetSpec{
+				Anexia: &kubermaticv1.Anexia{Token: "old"},
+			},
+		}
+		cluster := test.GenCluster("clusterid", "cluster", test.GenDefaultProject().Name, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), func(cluster *kubermaticv1.Cluster) {
+			cluster.Labels[kubermaticv1.IsCredentialPresetLabelKey] = "true"
+			cluster.Annotations = map[string]string{kubermaticv1.PresetNameAnnotation: "anexia-preset"}
+			cluster.Spec.Cloud = kubermaticv1.CloudSpec{
+				DatacenterName: "private-do1",
+				ProviderName:   string(kubermaticv1.AnexiaCloudProvider),
+				Anexia:         &kubermaticv1.AnexiaCloudSpec{},
+			}
+		})
+		return []ctrlruntimeclient.Object{test.GenTestSeed(), preset, cluster}
+	}
+
+	testCases := []struct {
+		name         string
+		rotate       bool
+		apiUser      *apiv1.User
+		expectedCode int
+	}{
+		{
+			name:         "scenario 1: rollback restores the preset and the clusters after a successful rotation",
+			rotate:       true,
+			apiUser:      test.GenDefaultAdminAPIUser(),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "scenario 2: rollback without a rotation",
+			apiUser:      test.GenDefaultAdminAPIUser(),
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:         "scenario 3: non-admin cannot roll back the rotation",
+			apiUser:      test.GenDefaultAPIUser(),
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := genObjects()
+			objects = append(objects, test.APIUserToKubermaticUser(*tc.apiUser))
+			ep, clientSets, err := test.CreateTestEndpointAndGetClients(*tc.apiUser, nil, []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{}, objects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			if tc.rotate {
+				req := httptest.NewRequest(http.MethodPost, "/api/v2/presets/anexia-preset/provider/anexia/rotate", strings.NewReader(`{"spec":{"anexia":{"token":"new"}}}`))
+				res := httptest.NewRecorder()
+				ep.ServeHTTP(res, req)
+				if res.Code != http.StatusOK {
+					t.Fatalf("failed to rotate the credentials, got %d: %s", res.Code, res.Body.String())
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v2/presets/anexia-preset/provider/anexia/rotate/rollback", nil)
+			res := httptest.NewRecorder()
+			ep.ServeHTTP(res, req)
+			if res.Code != tc.expectedCode {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.expectedCode, res.Code, res.Body.String())
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			report := &apiv2.PresetCredentialRotationReport{}
+			if err := json.Unmarshal(res.Body.Bytes(), report); err != nil {
+				t.Fatalf("failed to decode the response: %v", err)
+			}
+			if !report.RolledBack || len(report.Clusters) != 1 || report.Clusters[0].ClusterID != "clusterid" || report.Clusters[0].Status != "rolledBack" {
+				t.Fatalf("unexpected report %+v", report)
+			}
+
+			preset := &kubermaticv1.Preset{}
+			if err := clientSets.FakeMasterClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "anexia-preset"}, preset); err != nil {
+				t.Fatalf("failed to get preset: %v", err)
+			}
+			if preset.Spec.Anexia.Token != "old" {
+				t.Fatalf("expected the previous token in the preset, got %q", preset.Spec.Anexia.Token)
+			}
+			if _, ok := preset.Annotations["dashboard.kubermatic.io/previous-credentials-anexia"]; ok {
+				t.Fatal("expected the previous credentials to be removed from the preset")
+			}
+
+			cluster := &kubermaticv1.Cluster{}
+			if err := clientSets.FakeSeedClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "clusterid"}, cluster); err != nil {
+				t.Fatalf("failed to get the cluster: %v", err)
+			}
+			if cluster.Spec.Cloud.Anexia == nil || cluster.Spec.Cloud.Anexia.CredentialsReference != nil {
+				t.Fatalf("expected the previous cloud spec of the cluster, got %+v", cluster.Spec.Cloud.Anexia)
+			}
+
+			// the cluster had no credential secret before the rotation
+			for _, name := range []string{cluster.GetSecretName(), "credential-rotation-clusterid"} {
+				err := clientSets.FakeSeedClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: resources.KubermaticNamespace, Name: name}, &corev1.Secret{})
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("expected the secret %s to be removed, got %v", name, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,619 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/common"
+	v1common "k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/presethealth"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/util/retry"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The statuses of the clusters in a rotation report.
+const (
+	rotationPlanned    = "planned"
+	rotationUpdated    = "updated"
+	rotationFailed     = "failed"
+	rotationRolledBack = "rolledBack"
+)
+
+const (
+	// previousCredentialsAnnotationPrefix prefixes the annotation of a preset with the configuration of a provider
+	// from before its last rotation as JSON, e.g. dashboard.kubermatic.io/previous-credentials-aws.
+	previousCredentialsAnnotationPrefix = "dashboard.kubermatic.io/previous-credentials-"
+
+	// The keys of the secret with the credentials of a cluster from before a rotation.
+	backupRotationKey   = "rotation"
+	backupSecretKey     = "secret"
+	backupCloudPatchKey = "cloudPatch"
+)
+
+// previousCredentials is the configuration of a provider of a preset from before a rotation.
+type previousCredentials struct {
+	// Rotation identifies the rotation, only the backups of the clusters with the same rotation are restored.
+	Rotation string                  `json:"rotation"`
+	Spec     kubermaticv1.PresetSpec `json:"spec"`
+}
+
+func previousCredentialsAnnotation(providerType kubermaticv1.ProviderType) string {
+	return previousCredentialsAnnotationPrefix + string(providerType)
+}
+
+// backupSecretName returns the name of the secret with the credentials of the cluster from before the last
+// rotation, which is kept in the kubermatic namespace of the seed next to the credential secret.
+func backupSecretName(cluster *kubermaticv1.Cluster) string {
+	return "credential-rotation-" + cluster.Name
+}
+
+// rotatePresetCredentialsReq represents a request to rotate the credentials of a provider of a preset
+// swagger:parameters rotatePresetCredentials
+type rotatePresetCredentialsReq struct {
+	// in: path
+	// required: true
+	PresetName string `json:"preset_name"`
+	// in: path
+	// required: true
+	ProviderName string `json:"provider_name"`
+	// DryRun only lists the clusters whose credentials would be updated
+	// in: query
+	DryRun bool `json:"dryRun,omitempty"`
+	// in: body
+	// required: true
+	Body apiv2.PresetCredentialRotation
+}
+
+// Validate validates rotatePresetCredentialsReq request.
+func (r rotatePresetCredentialsReq) Validate() error {
+	if len(r.PresetName) == 0 {
+		return fmt.Errorf("the preset name cannot be empty")
+	}
+
+	if !kubermaticv1.IsProviderSupported(r.ProviderName) {
+		return fmt.Errorf("invalid provider name %s", r.ProviderName)
+	}
+
+	return common.ValidatePreset(&kubermaticv1.Preset{Spec: r.Body.Spec}, kubermaticv1.ProviderType(r.ProviderName))
+}
+
+func DecodeRotatePresetCredentials(_ context.Context, r *http.Request) (interface{}, error) {
+	var req rotatePresetCredentialsReq
+
+	req.PresetName = mux.Vars(r)["preset_name"]
+	req.ProviderName = mux.Vars(r)["provider_name"]
+	if dryRun := r.URL.Query().Get("dryRun"); dryRun != "" {
+		value, err := strconv.ParseBool(dryRun)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid value for 'dryRun': %v", err)
+		}
+		req.DryRun = value
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// linkedCluster is a cluster created with a preset.
+type linkedCluster struct {
+	cluster    kubermaticv1.Cluster
+	seed       string
+	seedClient ctrlruntimeclient.Client
+	// backedUp is true once the credentials from before the rotation are stored, the credentials of the cluster
+	// may have been changed afterwards
+	backedUp bool
+}
+
+func (l linkedCluster) result(status, message string) apiv2.PresetCredentialRotationResult {
+	return apiv2.PresetCredentialRotationResult{
+		ClusterID:   l.cluster.Name,
+		ClusterName: l.cluster.Spec.HumanReadableName,
+		ProjectID:   l.cluster.Labels[kubermaticv1.ProjectIDLabelKey],
+		Seed:        l.seed,
+		Status:      status,
+		Message:     message,
+	}
+}
+
+// RotatePresetCredentials replaces the credentials of a provider of a preset after checking them, and updates the
+// credential secrets of all clusters created with the preset. If the credentials of a cluster cannot be updated,
+// the previous credentials can be restored.
+func RotatePresetCredentials(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, checker *presethealth.Checker) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(rotatePresetCredentialsReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		err := req.Validate()
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, v1common.KubernetesErrorToHTTPError(err)
+		}
+
+		if !userInfo.IsAdmin {
+			return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+
+		preset, err := presetProvider.GetPreset(ctx, userInfo, nil, req.PresetName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, utilerrors.NewBadRequest("preset was not found.")
+			}
+			return nil, v1common.KubernetesErrorToHTTPError(err)
+		}
+
+		providerType := kubermaticv1.ProviderType(req.ProviderName)
+		if hasProvider, _ := common.PresetHasProvider(preset, providerType); !hasProvider {
+			return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("trying to rotate the credentials of the missing provider configuration for: %s", req.ProviderName))
+		}
+
+		// unlike an update of the preset, the rotation keeps the emails the preset is restricted to
+		rotated := common.OverridePresetProvider(preset.DeepCopy(), providerType, &kubermaticv1.Preset{Spec: req.Body.Spec})
+		report := &apiv2.PresetCredentialRotationReport{
+			PresetName: preset.Name,
+			Provider:   providerType,
+			DryRun:     req.DryRun,
+			Validation: checker.CheckProvider(ctx, rotated, providerType),
+			Clusters:   []apiv2.PresetCredentialRotationResult{},
+		}
+		if report.Validation.Status == apiv2.InvalidPresetCredentialStatus {
+			return nil, utilerrors.NewBadRequest("the new credentials are invalid: %s", report.Validation.Message)
+		}
+
+		clusters, err := listLinkedClusters(ctx, preset.Name, providerType, seedsGetter, clusterProviderGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.DryRun {
+			for _, linked := range clusters {
+				report.Clusters = append(report.Clusters, linked.result(rotationPlanned, ""))
+			}
+			return report, nil
+		}
+
+		// the previous configuration is kept in the preset, so that the rotation can be rolled back later
+		previous := previousCredentials{
+			Rotation: rand.String(10),
+			Spec:     common.OverridePresetProvider(&kubermaticv1.Preset{}, providerType, preset.DeepCopy()).Spec,
+		}
+		previousData, err := json.Marshal(previous)
+		if err != nil {
+			return nil, err
+		}
+		if rotated.Annotations == nil {
+			rotated.Annotations = map[string]string{}
+		}
+		rotated.Annotations[previousCredentialsAnnotation(providerType)] = string(previousData)
+
+		if err := presethealth.SetHealth(rotated, report.Validation); err != nil {
+			return nil, err
+		}
+		if _, err := presetProvider.UpdatePreset(ctx, rotated); err != nil {
+			return nil, v1common.KubernetesErrorToHTTPError(err)
+		}
+
+		failed := false
+		for i := range clusters {
+			if err := rotateClusterCredentials(ctx, presetProvider, userInfo, seedsGetter, preset.Name, previous.Rotation, &clusters[i]); err != nil {
+				report.Clusters = append(report.Clusters, clusters[i].result(rotationFailed, err.Error()))
+				failed = true
+				continue
+			}
+			report.Clusters = append(report.Clusters, clusters[i].result(rotationUpdated, ""))
+		}
+
+		if !failed || !req.Body.RollbackOnFailure {
+			return report, nil
+		}
+
+		rolledBack := true
+		for i := range clusters {
+			if !clusters[i].backedUp {
+				continue
+			}
+			if _, err := rollbackClusterCredentials(ctx, clusters[i].seedClient, &clusters[i].cluster, previous.Rotation); err != nil {
+				report.Clusters[i] = clusters[i].result(rotationFailed, fmt.Sprintf("failed to restore the previous credentials: %v", err))
+				rolledBack = false
+				continue
+			}
+			// the reason of a failed update is kept
+			report.Clusters[i] = clusters[i].result(rotationRolledBack, report.Clusters[i].Message)
+		}
+
+		if err := restorePresetProvider(ctx, presetProvider, userInfo, preset.Name, providerType, previous, rolledBack); err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to restore the previous credentials of the preset: %v", err))
+		}
+		report.RolledBack = rolledBack
+
+		return report, nil
+	}
+}
+
+// rollbackPresetCredentialsReq represents a request to roll back the last rotation of the credentials of a
+// provider of a preset
+// swagger:parameters rollbackPresetCredentials
+type rollbackPresetCredentialsReq struct {
+	// in: path
+	// required: true
+	PresetName string `json:"preset_name"`
+	// in: path
+	// required: true
+	ProviderName string `json:"provider_name"`
+}
+
+// Validate validates rollbackPresetCredentialsReq request.
+func (r rollbackPresetCredentialsReq) Validate() error {
+	if len(r.PresetName) == 0 {
+		return fmt.Errorf("the preset name cannot be empty")
+	}
+
+	if !kubermaticv1.IsProviderSupported(r.ProviderName) {
+		return fmt.Errorf("invalid provider name %s", r.ProviderName)
+	}
+
+	return nil
+}
+
+func DecodeRollbackPresetCredentials(_ context.Context, r *http.Request) (interface{}, error) {
+	return rollbackPresetCredentialsReq{
+		PresetName:   mux.Vars(r)["preset_name"],
+		ProviderName: mux.Vars(r)["provider_name"],
+	}, nil
+}
+
+// RollbackPresetCredentials restores the credentials of a provider of a preset and of the clusters created with
+// the preset from before the last rotation, also after the rotation succeeded. Clusters which were created after
+// the rotation are left as they are. If the credentials of a cluster cannot be restored, the rollback can be
+// repeated.
+func RollbackPresetCredentials(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(rollbackPresetCredentialsReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, v1common.KubernetesErrorToHTTPError(err)
+		}
+
+		if !userInfo.IsAdmin {
+			return nil, utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+
+		preset, err := presetProvider.GetPreset(ctx, userInfo, nil, req.PresetName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, utilerrors.NewBadRequest("preset was not found.")
+			}
+			return nil, v1common.KubernetesErrorToHTTPError(err)
+		}
+
+		providerType := kubermaticv1.ProviderType(req.ProviderName)
+		data, ok := preset.Annotations[previousCredentialsAnnotation(providerType)]
+		if !ok {
+			return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("the credentials of the provider %s of the preset were not rotated", req.ProviderName))
+		}
+		previous := previousCredentials{}
+		if err := json.Unmarshal([]byte(data), &previous); err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("invalid previous credentials of the preset: %v", err))
+		}
+
+		clusters, err := listLinkedClusters(ctx, preset.Name, providerType, seedsGetter, clusterProviderGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		report := &apiv2.PresetCredentialRotationReport{
+			PresetName: preset.Name,
+			Provider:   providerType,
+			Clusters:   []apiv2.PresetCredentialRotationResult{},
+		}
+		rolledBack := true
+		for i := range clusters {
+			restored, err := rollbackClusterCredentials(ctx, clusters[i].seedClient, &clusters[i].cluster, previous.Rotation)
+			switch {
+			case err != nil:
+				report.Clusters = append(report.Clusters, clusters[i].result(rotationFailed, fmt.Sprintf("failed to restore the previous credentials: %v", err)))
+				rolledBack = false
+			case restored:
+				report.Clusters = append(report.Clusters, clusters[i].result(rotationRolledBack, ""))
+			}
+		}
+
+		if err := restorePresetProvider(ctx, presetProvider, userInfo, preset.Name, providerType, previous, rolledBack); err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to restore the previous credentials of the preset: %v", err))
+		}
+		report.RolledBack = rolledBack
+
+		return report, nil
+	}
+}
+
+// listLinkedClusters returns the clusters of the provider created with the preset, sorted by seed and name. Unlike
+// the linkages of a preset, it fails if the clusters of a seed cannot be listed, so that no cluster is missed.
+func listLinkedClusters(ctx context.Context, presetName string, providerType kubermaticv1.ProviderType, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) ([]linkedCluster, error) {
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to list seeds: %v", err))
+	}
+	presetLabelRequirement, err := labels.NewRequirement(kubermaticv1.IsCredentialPresetLabelKey, selection.Equals, []string{"true"})
+	if err != nil {
+		return nil, v1common.KubernetesErrorToHTTPError(err)
+	}
+
+	seedNames := make([]string, 0, len(seeds))
+	for seedName := range seeds {
+		seedNames = append(seedNames, seedName)
+	}
+	sort.Strings(seedNames)
+
+	linked := []linkedCluster{}
+	for _, seedName := range seedNames {
+		seed := seeds[seedName]
+		if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("the seed %s is in an invalid phase", seedName))
+		}
+
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to get the cluster provider of the seed %s: %v", seedName, err))
+		}
+		privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+		if !ok {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("the cluster provider of the seed %s has no admin access", seedName))
+		}
+
+		clusters, err := clusterProvider.ListAll(ctx, labels.NewSelector().Add(*presetLabelRequirement))
+		if err != nil {
+			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to list the clusters of the seed %s: %v", seedName, err))
+		}
+		sort.Slice(clusters.Items, func(i, j int) bool {
+			return clusters.Items[i].Name < clusters.Items[j].Name
+		})
+
+		for _, cluster := range clusters.Items {
+			if cluster.Annotations[kubermaticv1.PresetNameAnnotation] != presetName {
+				continue
+			}
+			if providerName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud); err != nil || providerName != string(providerType) {
+				continue
+			}
+
+			linked = append(linked, linkedCluster{
+				cluster:    cluster,
+				seed:       seedName,
+				seedClient: privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(),
+			})
+		}
+	}
+
+	return linked, nil
+}
+
+// rotateClusterCredentials writes the credentials of the updated preset into the credential secret of the cluster
+// and updates the cluster to reference it. The previous credentials are stored for a rollback before anything is
+// changed.
+func rotateClusterCredentials(ctx context.Context, presetProvider provider.PresetProvider, userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, presetName, rotation string, linked *linkedCluster) error {
+	cluster := linked.cluster.DeepCopy()
+
+	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return err
+	}
+	cloud, err := presetProvider.SetCloudCredentials(ctx, userInfo, cluster.Labels[kubermaticv1.ProjectIDLabelKey], presetName, cluster.Spec.Cloud, dc)
+	if err != nil {
+		return err
+	}
+
+	backup, err := backupClusterCredentials(ctx, linked.seedClient, &linked.cluster, rotation)
+	if err != nil {
+		return fmt.Errorf("failed to store the previous credentials: %w", err)
+	}
+	linked.backedUp = true
+
+	// the credentials are moved from the spec into the credential secret, which the spec references afterwards
+	cluster.Spec.Cloud = *cloud
+	if err := kubernetesprovider.CreateOrUpdateCredentialSecretForCluster(ctx, linked.seedClient, cluster); err != nil {
+		return err
+	}
+
+	// the patch which restores the spec is stored before the spec is changed, so that a rollback always has it
+	cloudPatch, err := reverseCloudPatch(cluster.Spec.Cloud, linked.cluster.Spec.Cloud)
+	if err != nil {
+		return err
+	}
+	backup.Data[backupCloudPatchKey] = cloudPatch
+	if err := linked.seedClient.Update(ctx, backup); err != nil {
+		return fmt.Errorf("failed to store the previous credentials: %w", err)
+	}
+
+	if err := linked.seedClient.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(&linked.cluster)); err != nil {
+		return fmt.Errorf("failed to update the cluster: %w", err)
+	}
+	return nil
+}
+
+// reverseCloudPatch returns the JSON merge patch which changes the rotated cloud spec of a cluster back to the
+// previous one. Unlike the previous spec, it keeps the changes of the spec which were not made by the rotation.
+func reverseCloudPatch(rotated, previous kubermaticv1.CloudSpec) ([]byte, error) {
+	rotatedJSON, err := json.Marshal(rotated)
+	if err != nil {
+		return nil, err
+	}
+	previousJSON, err := json.Marshal(previous)
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.CreateMergePatch(rotatedJSON, previousJSON)
+}
+
+// backupClusterCredentials stores the data of the credential secret of the cluster, which is updated by the
+// rotation, in a secret next to it.
+func backupClusterCredentials(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, rotation string) (*corev1.Secret, error) {
+	data := map[string][]byte{backupRotationKey: []byte(rotation)}
+
+	secret := &corev1.Secret{}
+	err := client.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: cluster.GetSecretName()}, secret)
+	switch {
+	case err == nil:
+		previous, err := json.Marshal(secret.Data)
+		if err != nil {
+			return nil, err
+		}
+		data[backupSecretKey] = previous
+	case !apierrors.IsNotFound(err):
+		return nil, err
+	}
+
+	backup := &corev1.Secret{}
+	err = client.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: backupSecretName(cluster)}, backup)
+	switch {
+	case err == nil:
+		backup.Data = data
+		return backup, client.Update(ctx, backup)
+	case !apierrors.IsNotFound(err):
+		return nil, err
+	}
+
+	backup = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupSecretName(cluster),
+			Namespace: resources.KubermaticNamespace,
+			// the backup is deleted with the cluster
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.ClusterKindName,
+					Name:       cluster.Name,
+					UID:        cluster.UID,
+				},
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+	return backup, client.Create(ctx, backup)
+}
+
+// rollbackClusterCredentials restores the credential secret and the cloud spec of the cluster from before the
+// rotation and removes the backup. It returns false if the cluster has no backup of the rotation.
+func rollbackClusterCredentials(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, rotation string) (bool, error) {
+	backup := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: backupSecretName(cluster)}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if string(backup.Data[backupRotationKey]) != rotation {
+		return false, nil
+	}
+
+	if err := restoreCredentialSecret(ctx, client, cluster, backup); err != nil {
+		return false, err
+	}
+
+	// without the patch the spec of the cluster was not changed
+	if cloudPatch, ok := backup.Data[backupCloudPatchKey]; ok {
+		patch := []byte(fmt.Sprintf(`{"spec":{"cloud":%s}}`, cloudPatch))
+		if err := client.Patch(ctx, cluster.DeepCopy(), ctrlruntimeclient.RawPatch(types.MergePatchType, patch)); err != nil {
+			return false, fmt.Errorf("failed to restore the cloud spec of the cluster: %w", err)
+		}
+	}
+
+	return true, ctrlruntimeclient.IgnoreNotFound(client.Delete(ctx, backup))
+}
+
+func restoreCredentialSecret(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, backup *corev1.Secret) error {
+	name := types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: cluster.GetSecretName()}
+
+	previous, ok := backup.Data[backupSecretKey]
+	if !ok {
+		// the secret was created by the rotation
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name}}
+		return ctrlruntimeclient.IgnoreNotFound(client.Delete(ctx, secret))
+	}
+	data := map[string][]byte{}
+	if err := json.Unmarshal(previous, &data); err != nil {
+		return fmt.Errorf("invalid backup of the credential secret: %w", err)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &corev1.Secret{}
+		if err := client.Get(ctx, name, secret); err != nil {
+			return err
+		}
+		secret.Data = data
+		return client.Update(ctx, secret)
+	})
+}
+
+// restorePresetProvider restores the configuration of the provider of the preset from before the rotation. The
+// previous configuration is kept in the preset unless the rollback is complete, so that it can be repeated.
+func restorePresetProvider(ctx context.Context, presetProvider provider.PresetProvider, userInfo *provider.UserInfo, presetName string, providerType kubermaticv1.ProviderType, previous previousCredentials, complete bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := presetProvider.GetPreset(ctx, userInfo, nil, presetName)
+		if err != nil {
+			return err
+		}
+
+		restored := common.OverridePresetProvider(current, providerType, &kubermaticv1.Preset{Spec: *previous.Spec.DeepCopy()})
+		if complete {
+			delete(restored.Annotations, previousCredentialsAnnotation(providerType))
+		}
+		_, err = presetProvider.UpdatePreset(ctx, restored)
+		return err
+	})
+}
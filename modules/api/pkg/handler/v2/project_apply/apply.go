@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectapply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"sigs.k8s.io/yaml"
+)
+
+// maxBundleSize is the maximum size of a bundle in bytes.
+const maxBundleSize = 4 << 20
+
+// applyReq defines HTTP request for applyProjectBundle
+// swagger:parameters applyProjectBundle
+type applyReq struct {
+	common.ProjectReq
+	// DryRun only plans the changes
+	// in: query
+	DryRun bool `json:"dryRun,omitempty"`
+	// in: body
+	// required: true
+	Body apiv2.ProjectBundle
+}
+
+func DecodeApplyReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req applyReq
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	if req.DryRun, err = decodeDryRun(r); err != nil {
+		return nil, err
+	}
+	if err := decodeYAMLBody(r, &req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func decodeDryRun(r *http.Request) (bool, error) {
+	dryRun := r.URL.Query().Get("dryRun")
+	if dryRun == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(dryRun)
+	if err != nil {
+		return false, utilerrors.NewBadRequest("invalid value for 'dryRun': %v", err)
+	}
+	return value, nil
+}
+
+// decodeYAMLBody decodes a JSON or YAML body of at most maxBundleSize bytes.
+func decodeYAMLBody(r *http.Request, into interface{}) error {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBundleSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxBundleSize {
+		return utilerrors.NewBadRequest("the body exceeds the maximum size of %d bytes", maxBundleSize)
+	}
+	// JSON is valid YAML, so both formats are accepted
+	if err := yaml.UnmarshalStrict(data, into); err != nil {
+		return utilerrors.NewBadRequest("invalid body: %v", err)
+	}
+	return nil
+}
+
+// Validate validates the bundle.
+func (r applyReq) Validate() error {
+	return validateBundle(r.Body)
+}
+
+func validateBundle(bundle apiv2.ProjectBundle) error {
+	if err := unique("SSH key", len(bundle.SSHKeys), func(i int) string { return bundle.SSHKeys[i].Name }); err != nil {
+		return err
+	}
+	if err := unique("member", len(bundle.Members), func(i int) string { return strings.ToLower(bundle.Members[i].Email) }); err != nil {
+		return err
+	}
+	if err := unique("group binding", len(bundle.GroupBindings), func(i int) string { return bundle.GroupBindings[i].Group }); err != nil {
+		return err
+	}
+	if err := unique("cluster", len(bundle.Clusters), func(i int) string { return bundle.Clusters[i].Cluster.Name }); err != nil {
+		return err
+	}
+	if err := unique("cluster template", len(bundle.ClusterTemplates), func(i int) string { return bundle.ClusterTemplates[i].Name }); err != nil {
+		return err
+	}
+
+	for _, cluster := range bundle.Clusters {
+		name := cluster.Cluster.Name
+		if err := unique("machine deployment in cluster "+name, len(cluster.MachineDeployments), func(i int) string { return cluster.MachineDeployments[i].Name }); err != nil {
+			return err
+		}
+		if err := unique("addon in cluster "+name, len(cluster.Addons), func(i int) string { return cluster.Addons[i].Name }); err != nil {
+			return err
+		}
+		if err := unique("application in cluster "+name, len(cluster.Applications), func(i int) string {
+			return applicationKey(cluster.Applications[i].Namespace, cluster.Applications[i].Name)
+		}); err != nil {
+			return err
+		}
+		if err := unique("constraint in cluster "+name, len(cluster.Constraints), func(i int) string { return cluster.Constraints[i].Name }); err != nil {
+			return err
+		}
+		if err := unique("rule group in cluster "+name, len(cluster.RuleGroups), func(i int) string { return cluster.RuleGroups[i].Name }); err != nil {
+			return err
+		}
+		if err := unique("etcd backup config in cluster "+name, len(cluster.EtcdBackupConfigs), func(i int) string { return cluster.EtcdBackupConfigs[i].Name }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func unique(kind string, count int, name func(int) string) error {
+	seen := map[string]bool{}
+	for i := range count {
+		n := name(i)
+		if n == "" || n == "/" {
+			return utilerrors.NewBadRequest("the name of every %s is required", kind)
+		}
+		if seen[n] {
+			return utilerrors.NewBadRequest("%s %q is declared more than once", kind, n)
+		}
+		seen[n] = true
+	}
+	return nil
+}
+
+// ApplyEndpoint plans the changes needed to bring the project to the state declared in the bundle and, unless
+// it is a dry run, applies them. Changes are applied one by one, a failed change does not stop the others.
+func ApplyEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, operations Operations) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(applyReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+
+		if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		p := &planner{
+			operations: operations,
+			projectID:  req.ProjectID,
+			userEmail:  userInfo.Email,
+			bundle:     req.Body,
+		}
+		if err := p.plan(ctx); err != nil {
+			return nil, err
+		}
+
+		return p.apply(ctx, req.DryRun), nil
+	}
+}
+
+func applicationKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectapply
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// readOnlyFields are set by the server and never compared.
+var readOnlyFields = []string{"id", "creationTimestamp", "deletionTimestamp", "status"}
+
+// declaredFields returns the fields of a declared resource which are compared with the existing resource and sent
+// as merge patch. Fields with zero values are left out, as the API types do not tell them apart from unset fields.
+// ignored are dot separated paths of fields which are not compared either.
+func declaredFields(obj interface{}, ignored ...string) (map[string]interface{}, error) {
+	fields, err := toMap(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range append(readOnlyFields, ignored...) {
+		removeField(fields, strings.Split(path, "."))
+	}
+
+	pruned, _ := pruneZero(fields).(map[string]interface{})
+	if pruned == nil {
+		pruned = map[string]interface{}{}
+	}
+	return pruned, nil
+}
+
+func toMap(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func removeField(fields map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		delete(fields, path[0])
+		return
+	}
+	if nested, ok := fields[path[0]].(map[string]interface{}); ok {
+		removeField(nested, path[1:])
+	}
+}
+
+// pruneZero removes the zero values, empty maps and empty lists. It returns nil if nothing is left.
+func pruneZero(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		pruned := map[string]interface{}{}
+		for key, field := range v {
+			if field = pruneZero(field); field != nil {
+				pruned[key] = field
+			}
+		}
+		if len(pruned) == 0 {
+			return nil
+		}
+		return pruned
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		// list items are kept as a whole, so that their positions are not shifted
+		return v
+	case string:
+		if v == "" {
+			return nil
+		}
+	case float64:
+		if v == 0 {
+			return nil
+		}
+	case bool:
+		if !v {
+			return nil
+		}
+	case nil:
+		return nil
+	}
+	return value
+}
+
+// contains returns true if all declared fields have the same values in the existing resource.
+func contains(existing, declared interface{}) bool {
+	switch d := declared.(type) {
+	case map[string]interface{}:
+		e, ok := existing.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, field := range d {
+			if !contains(e[key], field) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		e, ok := existing.([]interface{})
+		if !ok || len(e) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !contains(e[i], pruneZero(d[i])) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return true
+	default:
+		return reflect.DeepEqual(existing, declared)
+	}
+}
+
+// upToDate returns true if the existing resource has all declared fields.
+func upToDate(existing interface{}, declared map[string]interface{}) (bool, error) {
+	fields, err := toMap(existing)
+	if err != nil {
+		return false, err
+	}
+	return contains(fields, declared), nil
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectapply
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// systemAnnotations are set by KKP when a cluster is created and are not exported.
+var systemAnnotations = []string{
+	kubermaticv1.PresetNameAnnotation,
+	kubermaticv1.InitialMachineDeploymentRequestAnnotation,
+	kubermaticv1.InitialApplicationInstallationsRequestAnnotation,
+	kubermaticv1.InitialCNIValuesRequestAnnotation,
+	kubermaticv1.CCMMigrationNeededAnnotation,
+	kubermaticv1.CSIMigrationNeededAnnotation,
+}
+
+// exportReq defines HTTP request for exportProject
+// swagger:parameters exportProject
+type exportReq struct {
+	common.ProjectReq
+}
+
+func DecodeExportReq(c context.Context, r *http.Request) (interface{}, error) {
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	return exportReq{ProjectReq: projectReq.(common.ProjectReq)}, nil
+}
+
+// ExportEndpoint exports the project together with its resources. The result can be applied to another project
+// or imported as a new one.
+func ExportEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	settingsProvider provider.SettingsProvider, operations Operations) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(exportReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return exportProject(ctx, settingsProvider, operations, project)
+	}
+}
+
+type exporter struct {
+	operations Operations
+	projectID  string
+	// etcdBackupEnabled is set if the admins enabled the etcd backups, the configs cannot be listed otherwise
+	etcdBackupEnabled bool
+}
+
+func exportProject(ctx context.Context, settingsProvider provider.SettingsProvider, operations Operations, project *kubermaticv1.Project) (*apiv2.ProjectExport, error) {
+	settings, err := settingsProvider.GetGlobalSettings(ctx)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	e := &exporter{
+		operations:        operations,
+		projectID:         project.Name,
+		etcdBackupEnabled: settings.Spec.EnableEtcdBackup,
+	}
+	export := &apiv2.ProjectExport{
+		Name:   project.Spec.Name,
+		Labels: project.Labels,
+		Spec:   common.ConvertInternalProjectToExternal(project, nil, 0).Spec,
+	}
+
+	if export.Bundle.SSHKeys, err = e.sshKeys(ctx); err != nil {
+		return nil, err
+	}
+	if export.Bundle.Members, err = e.members(ctx); err != nil {
+		return nil, err
+	}
+	if export.Bundle.GroupBindings, err = e.groupBindings(ctx); err != nil {
+		return nil, err
+	}
+	if export.Bundle.ClusterTemplates, err = e.clusterTemplates(ctx); err != nil {
+		return nil, err
+	}
+	if export.Bundle.Clusters, err = e.clusters(ctx); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+func (e *exporter) vars(keysAndValues ...string) map[string]string {
+	return projectVars(e.projectID, keysAndValues...)
+}
+
+func (e *exporter) sshKeys(ctx context.Context) ([]apiv2.ProjectBundleSSHKey, error) {
+	var keys []apiv1.SSHKey
+	if err := e.operations.ListSSHKeys.Call(ctx, e.vars(), nil, nil, &keys); err != nil {
+		return nil, err
+	}
+
+	exported := make([]apiv2.ProjectBundleSSHKey, 0, len(keys))
+	for _, key := range keys {
+		exported = append(exported, apiv2.ProjectBundleSSHKey{Name: key.Name, PublicKey: key.Spec.PublicKey})
+	}
+	return exported, nil
+}
+
+func (e *exporter) members(ctx context.Context) ([]apiv2.ProjectBundleMember, error) {
+	var users []apiv1.User
+	if err := e.operations.ListMembers.Call(ctx, e.vars(), nil, nil, &users); err != nil {
+		return nil, err
+	}
+
+	var exported []apiv2.ProjectBundleMember
+	for _, user := range users {
+		// service accounts belong to the project they were created in
+		if kubermaticv1helper.IsProjectServiceAccount(user.Email) {
+			continue
+		}
+		for _, project := range user.Projects {
+			if project.ID == e.projectID {
+				exported = append(exported, apiv2.ProjectBundleMember{Email: user.Email, Group: project.GroupPrefix})
+			}
+		}
+	}
+	return exported, nil
+}
+
+func (e *exporter) groupBindings(ctx context.Context) ([]apiv2.ProjectBundleGroupBinding, error) {
+	var bindings []apiv2.GroupProjectBinding
+	if err := e.operations.ListGroupBindings.Call(ctx, e.vars(), nil, nil, &bindings); err != nil {
+		return nil, err
+	}
+
+	var exported []apiv2.ProjectBundleGroupBinding
+	for _, binding := range bindings {
+		exported = append(exported, apiv2.ProjectBundleGroupBinding{Group: binding.Group, Role: binding.Role})
+	}
+	return exported, nil
+}
+
+func (e *exporter) clusterTemplates(ctx context.Context) ([]apiv2.ClusterTemplate, error) {
+	var templates []apiv2.ClusterTemplate
+	if err := e.operations.ListClusterTemplates.Call(ctx, e.vars(), nil, nil, &templates); err != nil {
+		return nil, err
+	}
+
+	var exported []apiv2.ClusterTemplate
+	for _, template := range templates {
+		// user and global templates do not belong to the project
+		if template.Scope != kubermaticv1.ProjectClusterTemplateScope {
+			continue
+		}
+
+		template.ObjectMeta = apiv1.ObjectMeta{}
+		template.ID = ""
+		template.ProjectID = ""
+		template.User = ""
+		// the SSH keys are referenced by name, their IDs differ between projects
+		for i := range template.UserSSHKeys {
+			template.UserSSHKeys[i].ID = ""
+		}
+		if template.Cluster != nil {
+			// the credential of the template is the name of its preset
+			template.Cluster.Annotations = withoutKeys(template.Cluster.Annotations, systemAnnotations...)
+			template.Cluster.Labels = withoutKeys(template.Cluster.Labels, kubermaticv1.ProjectIDLabelKey, kubermaticv1.IsCredentialPresetLabelKey)
+		}
+		exported = append(exported, template)
+	}
+	return exported, nil
+}
+
+func (e *exporter) clusters(ctx context.Context) ([]apiv2.ProjectBundleCluster, error) {
+	// the cluster specs are converted with their public JSON representation, which hides the credentials
+	var clusters apiv1.ClusterList
+	if err := e.operations.ListClusters.Call(ctx, e.vars(), nil, nil, &clusters); err != nil {
+		return nil, err
+	}
+
+	exported := make([]apiv2.ProjectBundleCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		bundleCluster, err := e.cluster(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		exported = append(exported, bundleCluster)
+	}
+	return exported, nil
+}
+
+func (e *exporter) cluster(ctx context.Context, cluster apiv1.Cluster) (apiv2.ProjectBundleCluster, error) {
+	vars := e.vars("cluster_id", cluster.ID)
+	exported := apiv2.ProjectBundleCluster{
+		Cluster: apiv1.Cluster{
+			ObjectMeta: apiv1.ObjectMeta{
+				Name:        cluster.Name,
+				Annotations: withoutKeys(cluster.Annotations, systemAnnotations...),
+			},
+			Labels: withoutKeys(cluster.Labels, kubermaticv1.ProjectIDLabelKey, kubermaticv1.IsCredentialPresetLabelKey),
+			// clusters created from presets reference them, the credentials of other clusters are not exported
+			Credential: cluster.Annotations[kubermaticv1.PresetNameAnnotation],
+			Spec:       cluster.Spec,
+		},
+	}
+
+	var machineDeployments []apiv1.NodeDeployment
+	if err := e.operations.ListMachineDeployments.Call(ctx, vars, nil, nil, &machineDeployments); err != nil {
+		return exported, err
+	}
+	for _, md := range machineDeployments {
+		exported.MachineDeployments = append(exported.MachineDeployments, apiv1.NodeDeployment{
+			ObjectMeta: apiv1.ObjectMeta{Name: md.Name, Annotations: md.Annotations},
+			Spec:       md.Spec,
+		})
+	}
+
+	var addons []apiv1.Addon
+	if err := e.operations.ListAddons.Call(ctx, vars, nil, nil, &addons); err != nil {
+		return exported, err
+	}
+	for _, addon := range addons {
+		// default addons are installed by KKP
+		if addon.Spec.IsDefault {
+			continue
+		}
+		exported.Addons = append(exported.Addons, apiv1.Addon{
+			ObjectMeta: apiv1.ObjectMeta{Name: addon.Name, Labels: addon.Labels},
+			Spec:       addon.Spec,
+		})
+	}
+
+	var applications []apiv2.ApplicationInstallationListItem
+	if err := e.operations.ListApplications.Call(ctx, vars, nil, nil, &applications); err != nil {
+		return exported, err
+	}
+	for _, item := range applications {
+		// the list items do not hold the whole spec
+		var application apiv2.ApplicationInstallation
+		appVars := e.vars("cluster_id", cluster.ID, "namespace", item.Namespace, "appinstall_name", item.Name)
+		if err := e.operations.GetApplication.Call(ctx, appVars, nil, nil, &application); err != nil {
+			return exported, err
+		}
+		exported.Applications = append(exported.Applications, apiv2.ApplicationInstallationBody{
+			ObjectMeta: apiv1.ObjectMeta{Name: application.Name, Annotations: application.Annotations},
+			Namespace:  application.Namespace,
+			Labels:     application.Labels,
+			Spec:       application.Spec,
+		})
+	}
+
+	if cluster.Spec.OPAIntegration != nil && cluster.Spec.OPAIntegration.Enabled {
+		var constraints []apiv2.Constraint
+		if err := e.operations.ListConstraints.Call(ctx, vars, nil, nil, &constraints); err != nil {
+			return exported, err
+		}
+		for _, constraint := range constraints {
+			constraint.Status = nil
+			exported.Constraints = append(exported.Constraints, constraint)
+		}
+	}
+
+	if mla := cluster.Spec.MLA; mla != nil && (mla.MonitoringEnabled || mla.LoggingEnabled) {
+		var ruleGroups []apiv2.RuleGroup
+		if err := e.operations.ListRuleGroups.Call(ctx, vars, nil, nil, &ruleGroups); err != nil {
+			return exported, err
+		}
+		for _, ruleGroup := range ruleGroups {
+			// default rule groups are managed by the admins
+			if !ruleGroup.IsDefault {
+				exported.RuleGroups = append(exported.RuleGroups, ruleGroup)
+			}
+		}
+
+		var alertmanager apiv2.Alertmanager
+		if err := e.operations.GetAlertmanager.Call(ctx, vars, nil, nil, &alertmanager); err != nil {
+			return exported, err
+		}
+		exported.Alertmanager = &alertmanager
+	}
+
+	if e.etcdBackupEnabled {
+		var configs []apiv2.EtcdBackupConfig
+		if err := e.operations.ListEtcdBackupConfigs.Call(ctx, vars, nil, nil, &configs); err != nil {
+			return exported, err
+		}
+		for _, config := range configs {
+			spec := config.Spec
+			spec.ClusterID = ""
+			exported.EtcdBackupConfigs = append(exported.EtcdBackupConfigs, apiv2.EtcdBackupConfig{
+				ObjectMeta: apiv1.ObjectMeta{Name: config.Name},
+				Spec:       spec,
+			})
+		}
+	}
+
+	return exported, nil
+}
+
+// withoutKeys returns a copy of the map without the given keys, or nil if nothing is left.
+func withoutKeys(in map[string]string, keys ...string) map[string]string {
+	out := map[string]string{}
+	for key, value := range in {
+		out[key] = value
+	}
+	for _, key := range keys {
+		delete(out, key)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
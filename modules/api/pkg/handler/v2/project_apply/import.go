@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectapply
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// importReq defines HTTP request for importProject
+// swagger:parameters importProject
+type importReq struct {
+	// DryRun only plans the changes, the project is not created
+	// in: query
+	DryRun bool `json:"dryRun,omitempty"`
+	// in: body
+	// required: true
+	Body apiv2.ProjectExport
+}
+
+func DecodeImportReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req importReq
+	var err error
+
+	if req.DryRun, err = decodeDryRun(r); err != nil {
+		return nil, err
+	}
+	if err := decodeYAMLBody(r, &req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// Validate validates the exported project.
+func (r importReq) Validate() error {
+	if r.Body.Name == "" {
+		return utilerrors.NewBadRequest("the name of the project is required")
+	}
+	return validateBundle(r.Body.Bundle)
+}
+
+// ImportEndpoint creates a new project from an exported one. The resources of the export are created one by one,
+// a failed resource does not stop the others. Machine deployments, addons and the other resources which need a
+// running cluster are skipped for new clusters, they are created by applying the bundle to the new project again.
+func ImportEndpoint(userInfoGetter provider.UserInfoGetter, operations Operations) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(importReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+
+		return importProject(ctx, userInfoGetter, operations, req.Body, req.DryRun)
+	}
+}
+
+// cloneReq defines HTTP request for cloneProject
+// swagger:parameters cloneProject
+type cloneReq struct {
+	common.ProjectReq
+	// DryRun only plans the changes, the project is not created
+	// in: query
+	DryRun bool `json:"dryRun,omitempty"`
+	// in: body
+	// required: true
+	Body struct {
+		// Name is the name of the new project
+		Name string `json:"name"`
+	}
+}
+
+func DecodeCloneReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req cloneReq
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+
+	if req.DryRun, err = decodeDryRun(r); err != nil {
+		return nil, err
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the body: %v", err)
+	}
+
+	return req, nil
+}
+
+// CloneEndpoint exports the project and imports it as a new project with the given name.
+func CloneEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	settingsProvider provider.SettingsProvider, operations Operations) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(cloneReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		if req.Body.Name == "" {
+			return nil, utilerrors.NewBadRequest("the name of the new project is required")
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		export, err := exportProject(ctx, settingsProvider, operations, project)
+		if err != nil {
+			return nil, err
+		}
+		export.Name = req.Body.Name
+
+		return importProject(ctx, userInfoGetter, operations, *export, req.DryRun)
+	}
+}
+
+func importProject(ctx context.Context, userInfoGetter provider.UserInfoGetter, operations Operations, export apiv2.ProjectExport, dryRun bool) (*apiv2.ProjectImportReport, error) {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	p := &planner{
+		operations: operations,
+		userEmail:  userInfo.Email,
+		bundle:     export.Bundle,
+		newProject: true,
+	}
+	report := &apiv2.ProjectImportReport{}
+
+	if !dryRun {
+		// the spec of the create request has no JSON name
+		body := map[string]interface{}{"name": export.Name, "labels": export.Labels, "Spec": export.Spec}
+		var project apiv1.Project
+		if err := operations.CreateProject.Call(ctx, nil, nil, body, &project); err != nil {
+			return nil, err
+		}
+		report.Project = &project
+		p.projectID = project.ID
+	}
+
+	if err := p.plan(ctx); err != nil {
+		return nil, err
+	}
+	report.Report = p.apply(ctx, dryRun)
+
+	return report, nil
+}
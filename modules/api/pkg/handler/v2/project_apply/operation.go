@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectapply
+
+import (
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+)
+
+// Operations are the endpoints used to read and change the resources of a project.
+type Operations struct {
+	ListSSHKeys  handlercommon.Operation
+	CreateSSHKey handlercommon.Operation
+	DeleteSSHKey handlercommon.Operation
+
+	ListMembers  handlercommon.Operation
+	AddMember    handlercommon.Operation
+	EditMember   handlercommon.Operation
+	DeleteMember handlercommon.Operation
+
+	ListGroupBindings  handlercommon.Operation
+	CreateGroupBinding handlercommon.Operation
+	PatchGroupBinding  handlercommon.Operation
+	DeleteGroupBinding handlercommon.Operation
+
+	ListClusters  handlercommon.Operation
+	CreateCluster handlercommon.Operation
+	PatchCluster  handlercommon.Operation
+	DeleteCluster handlercommon.Operation
+
+	ListMachineDeployments  handlercommon.Operation
+	CreateMachineDeployment handlercommon.Operation
+	PatchMachineDeployment  handlercommon.Operation
+	DeleteMachineDeployment handlercommon.Operation
+
+	ListAddons  handlercommon.Operation
+	CreateAddon handlercommon.Operation
+	PatchAddon  handlercommon.Operation
+	DeleteAddon handlercommon.Operation
+
+	ListApplications  handlercommon.Operation
+	GetApplication    handlercommon.Operation
+	CreateApplication handlercommon.Operation
+	UpdateApplication handlercommon.Operation
+	DeleteApplication handlercommon.Operation
+
+	ListConstraints  handlercommon.Operation
+	CreateConstraint handlercommon.Operation
+	PatchConstraint  handlercommon.Operation
+	DeleteConstraint handlercommon.Operation
+
+	ListRuleGroups  handlercommon.Operation
+	CreateRuleGroup handlercommon.Operation
+	UpdateRuleGroup handlercommon.Operation
+	DeleteRuleGroup handlercommon.Operation
+
+	GetAlertmanager    handlercommon.Operation
+	UpdateAlertmanager handlercommon.Operation
+
+	ListEtcdBackupConfigs  handlercommon.Operation
+	CreateEtcdBackupConfig handlercommon.Operation
+	PatchEtcdBackupConfig  handlercommon.Operation
+	DeleteEtcdBackupConfig handlercommon.Operation
+
+	ListClusterTemplates  handlercommon.Operation
+	ImportClusterTemplate handlercommon.Operation
+	DeleteClusterTemplate handlercommon.Operation
+
+	CreateProject handlercommon.Operation
+}
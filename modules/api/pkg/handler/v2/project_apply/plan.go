@@ -0,0 +1,944 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectapply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+const (
+	KindSSHKey            = "SSHKey"
+	KindMember            = "Member"
+	KindGroupBinding      = "GroupProjectBinding"
+	KindCluster           = "Cluster"
+	KindMachineDeployment = "MachineDeployment"
+	KindAddon             = "Addon"
+	KindApplication       = "ApplicationInstallation"
+	KindConstraint        = "Constraint"
+	KindRuleGroup         = "RuleGroup"
+	KindAlertmanager      = "Alertmanager"
+	KindEtcdBackupConfig  = "EtcdBackupConfig"
+	KindClusterTemplate   = "ClusterTemplate"
+
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+	ActionNone   = "none"
+
+	StatusPlanned   = "planned"
+	StatusApplied   = "applied"
+	StatusUnchanged = "unchanged"
+	StatusSkipped   = "skipped"
+	StatusFailed    = "failed"
+)
+
+// clusterIgnoredFields cannot be changed after the cluster was created or are not returned by the API.
+var clusterIgnoredFields = []string{"type", "credential", "inheritedLabels", "machineDeploymentCount", "spec.cloud"}
+
+type change struct {
+	result apiv2.ProjectApplyResult
+	apply  func(context.Context) error
+	// follows is the change which applies this one too, e.g. the creation of the cluster of its applications
+	follows *change
+	// deferred changes can only be applied once a new cluster is running
+	deferred bool
+}
+
+type planner struct {
+	operations Operations
+	projectID  string
+	userEmail  string
+	bundle     apiv2.ProjectBundle
+	// newProject is set for projects which were just created, their resources are not listed
+	newProject bool
+
+	changes []*change
+}
+
+func (p *planner) add(kind, name, cluster, action string, apply func(context.Context) error) *change {
+	c := &change{
+		result: apiv2.ProjectApplyResult{Kind: kind, Name: name, Cluster: cluster, Action: action},
+		apply:  apply,
+	}
+	p.changes = append(p.changes, c)
+	return c
+}
+
+// fail records a change which cannot be applied.
+func (p *planner) fail(kind, name, cluster, action string, err error) {
+	c := p.add(kind, name, cluster, action, nil)
+	c.result.Status = StatusFailed
+	c.result.Message = err.Error()
+}
+
+func (p *planner) vars(keysAndValues ...string) map[string]string {
+	return projectVars(p.projectID, keysAndValues...)
+}
+
+// projectVars returns the path variables of a request for a resource of the project.
+func projectVars(projectID string, keysAndValues ...string) map[string]string {
+	vars := map[string]string{"project_id": projectID}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		vars[keysAndValues[i]] = keysAndValues[i+1]
+	}
+	return vars
+}
+
+// list lists the existing resources of the project. New projects have none.
+func (p *planner) list(ctx context.Context, operation handlercommon.Operation, result interface{}) error {
+	if p.newProject {
+		return nil
+	}
+	return operation.Call(ctx, p.vars(), nil, nil, result)
+}
+
+// plan compares the bundle with the existing resources. It fails only if the resources of the project
+// cannot be listed, problems with single resources are reported in their results.
+func (p *planner) plan(ctx context.Context) error {
+	if err := p.planSSHKeys(ctx); err != nil {
+		return err
+	}
+	if err := p.planMembers(ctx); err != nil {
+		return err
+	}
+	if err := p.planGroupBindings(ctx); err != nil {
+		return err
+	}
+	if err := p.planClusterTemplates(ctx); err != nil {
+		return err
+	}
+	return p.planClusters(ctx)
+}
+
+func (p *planner) apply(ctx context.Context, dryRun bool) apiv2.ProjectApplyReport {
+	report := apiv2.ProjectApplyReport{DryRun: dryRun, Results: []apiv2.ProjectApplyResult{}}
+
+	for _, c := range p.changes {
+		switch {
+		case c.result.Status == StatusFailed:
+		case c.result.Action == ActionNone:
+			c.result.Status = StatusUnchanged
+		case dryRun:
+			c.result.Status = StatusPlanned
+		case c.deferred:
+			c.result.Status = StatusSkipped
+			c.result.Message = "the cluster is being created, apply the bundle again once it is running"
+		case c.follows != nil:
+			c.result.Status = c.follows.result.Status
+			c.result.Message = c.follows.result.Message
+		default:
+			if err := c.apply(ctx); err != nil {
+				c.result.Status = StatusFailed
+				c.result.Message = err.Error()
+			} else {
+				c.result.Status = StatusApplied
+			}
+		}
+		report.Results = append(report.Results, c.result)
+	}
+
+	return report
+}
+
+func (p *planner) planSSHKeys(ctx context.Context) error {
+	var existing []apiv1.SSHKey
+	if err := p.list(ctx, p.operations.ListSSHKeys, &existing); err != nil {
+		return err
+	}
+	byName := map[string]apiv1.SSHKey{}
+	for _, key := range existing {
+		byName[key.Name] = key
+	}
+
+	declared := map[string]bool{}
+	for _, key := range p.bundle.SSHKeys {
+		declared[key.Name] = true
+
+		current, ok := byName[key.Name]
+		switch {
+		case !ok:
+			body := apiv1.SSHKey{ObjectMeta: apiv1.ObjectMeta{Name: key.Name}, Spec: apiv1.SSHKeySpec{PublicKey: key.PublicKey}}
+			p.add(KindSSHKey, key.Name, "", ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateSSHKey.Call(ctx, p.vars(), nil, body, nil)
+			})
+		case strings.TrimSpace(current.Spec.PublicKey) == strings.TrimSpace(key.PublicKey):
+			p.add(KindSSHKey, key.Name, "", ActionNone, nil)
+		default:
+			p.fail(KindSSHKey, key.Name, "", ActionUpdate, fmt.Errorf("SSH keys cannot be changed, delete the key or declare it with a new name"))
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, key := range existing {
+			if declared[key.Name] {
+				continue
+			}
+			vars := p.vars("key_id", key.ID)
+			p.add(KindSSHKey, key.Name, "", ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteSSHKey.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planMembers(ctx context.Context) error {
+	var existing []apiv1.User
+	if err := p.list(ctx, p.operations.ListMembers, &existing); err != nil {
+		return err
+	}
+	byEmail := map[string]apiv1.User{}
+	for _, user := range existing {
+		byEmail[strings.ToLower(user.Email)] = user
+	}
+
+	declared := map[string]bool{}
+	for _, member := range p.bundle.Members {
+		email := strings.ToLower(member.Email)
+		declared[email] = true
+
+		body := apiv1.User{
+			Email:    member.Email,
+			Projects: []apiv1.ProjectGroup{{ID: p.projectID, GroupPrefix: member.Group}},
+		}
+
+		current, ok := byEmail[email]
+		switch {
+		case !ok && p.newProject && email == strings.ToLower(p.userEmail):
+			// the user creating a project becomes its owner
+			p.add(KindMember, member.Email, "", ActionNone, nil)
+		case !ok:
+			p.add(KindMember, member.Email, "", ActionCreate, func(ctx context.Context) error {
+				return p.operations.AddMember.Call(ctx, p.vars(), nil, body, nil)
+			})
+		case p.memberGroup(current) == member.Group:
+			p.add(KindMember, member.Email, "", ActionNone, nil)
+		default:
+			body.ID = current.ID
+			vars := p.vars("user_id", current.ID)
+			p.add(KindMember, member.Email, "", ActionUpdate, func(ctx context.Context) error {
+				return p.operations.EditMember.Call(ctx, vars, nil, body, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, user := range existing {
+			// users cannot remove themselves, the bundle is usually applied by an owner who is not declared
+			email := strings.ToLower(user.Email)
+			if declared[email] || email == strings.ToLower(p.userEmail) {
+				continue
+			}
+			vars := p.vars("user_id", user.ID)
+			p.add(KindMember, user.Email, "", ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteMember.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) memberGroup(user apiv1.User) string {
+	for _, project := range user.Projects {
+		if project.ID == p.projectID {
+			return project.GroupPrefix
+		}
+	}
+	return ""
+}
+
+func (p *planner) planGroupBindings(ctx context.Context) error {
+	var existing []apiv2.GroupProjectBinding
+	if err := p.list(ctx, p.operations.ListGroupBindings, &existing); err != nil {
+		return err
+	}
+	byGroup := map[string]apiv2.GroupProjectBinding{}
+	for _, binding := range existing {
+		byGroup[binding.Group] = binding
+	}
+
+	declared := map[string]bool{}
+	for _, binding := range p.bundle.GroupBindings {
+		declared[binding.Group] = true
+
+		body := map[string]string{"group": binding.Group, "role": binding.Role}
+
+		current, ok := byGroup[binding.Group]
+		switch {
+		case !ok:
+			p.add(KindGroupBinding, binding.Group, "", ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateGroupBinding.Call(ctx, p.vars(), nil, body, nil)
+			})
+		case current.Role == binding.Role:
+			p.add(KindGroupBinding, binding.Group, "", ActionNone, nil)
+		default:
+			vars := p.vars("binding_name", current.Name)
+			p.add(KindGroupBinding, binding.Group, "", ActionUpdate, func(ctx context.Context) error {
+				return p.operations.PatchGroupBinding.Call(ctx, vars, nil, body, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, binding := range existing {
+			if declared[binding.Group] {
+				continue
+			}
+			vars := p.vars("binding_name", binding.Name)
+			p.add(KindGroupBinding, binding.Group, "", ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteGroupBinding.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planClusters(ctx context.Context) error {
+	var existing apiv1.ClusterList
+	if err := p.list(ctx, p.operations.ListClusters, &existing); err != nil {
+		return err
+	}
+	byName := map[string][]apiv1.Cluster{}
+	for _, cluster := range existing {
+		byName[cluster.Name] = append(byName[cluster.Name], cluster)
+	}
+
+	declared := map[string]bool{}
+	for _, cluster := range p.bundle.Clusters {
+		name := cluster.Cluster.Name
+		declared[name] = true
+
+		switch current := byName[name]; len(current) {
+		case 0:
+			p.planNewCluster(cluster)
+		case 1:
+			if err := p.planExistingCluster(ctx, cluster, current[0]); err != nil {
+				return err
+			}
+		default:
+			p.fail(KindCluster, name, "", ActionUpdate, fmt.Errorf("the project has %d clusters named %q", len(current), name))
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, cluster := range existing {
+			if declared[cluster.Name] {
+				continue
+			}
+			vars := p.vars("cluster_id", cluster.ID)
+			p.add(KindCluster, cluster.Name, "", ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteCluster.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+// planNewCluster creates the cluster together with its first machine deployment and its applications. The other
+// machine deployments and the addons need a running cluster, so they are created by the next apply.
+func (p *planner) planNewCluster(cluster apiv2.ProjectBundleCluster) {
+	name := cluster.Cluster.Name
+
+	body := apiv1.CreateClusterSpec{Cluster: cluster.Cluster}
+	if len(cluster.MachineDeployments) > 0 {
+		md := cluster.MachineDeployments[0]
+		body.NodeDeployment = &md
+	}
+	for _, application := range cluster.Applications {
+		var converted apiv1.Application
+		if err := convert(application, &converted); err != nil {
+			p.fail(KindCluster, name, "", ActionCreate, fmt.Errorf("invalid application %s: %w", application.Name, err))
+			return
+		}
+		body.Applications = append(body.Applications, converted)
+	}
+
+	created := p.add(KindCluster, name, "", ActionCreate, func(ctx context.Context) error {
+		return p.operations.CreateCluster.Call(ctx, p.vars(), nil, body, nil)
+	})
+
+	for i, md := range cluster.MachineDeployments {
+		c := p.add(KindMachineDeployment, md.Name, name, ActionCreate, nil)
+		if i == 0 {
+			c.follows = created
+		} else {
+			c.deferred = true
+		}
+	}
+	for _, addon := range cluster.Addons {
+		p.add(KindAddon, addon.Name, name, ActionCreate, nil).deferred = true
+	}
+	for _, application := range cluster.Applications {
+		p.add(KindApplication, applicationKey(application.Namespace, application.Name), name, ActionCreate, nil).follows = created
+	}
+	for _, constraint := range cluster.Constraints {
+		p.add(KindConstraint, constraint.Name, name, ActionCreate, nil).deferred = true
+	}
+	for _, ruleGroup := range cluster.RuleGroups {
+		p.add(KindRuleGroup, ruleGroup.Name, name, ActionCreate, nil).deferred = true
+	}
+	if cluster.Alertmanager != nil {
+		p.add(KindAlertmanager, alertmanagerName, name, ActionUpdate, nil).deferred = true
+	}
+	for _, config := range cluster.EtcdBackupConfigs {
+		p.add(KindEtcdBackupConfig, config.Name, name, ActionCreate, nil).deferred = true
+	}
+}
+
+func (p *planner) planExistingCluster(ctx context.Context, cluster apiv2.ProjectBundleCluster, current apiv1.Cluster) error {
+	name := cluster.Cluster.Name
+
+	fields, err := declaredFields(cluster.Cluster, clusterIgnoredFields...)
+	if err != nil {
+		return err
+	}
+	applied, err := upToDate(current, fields)
+	switch {
+	case err != nil:
+		return err
+	case applied:
+		p.add(KindCluster, name, "", ActionNone, nil)
+	default:
+		vars := p.vars("cluster_id", current.ID)
+		p.add(KindCluster, name, "", ActionUpdate, func(ctx context.Context) error {
+			return p.operations.PatchCluster.Call(ctx, vars, nil, fields, nil)
+		})
+	}
+
+	if err := p.planMachineDeployments(ctx, cluster, current.ID); err != nil {
+		return err
+	}
+	if err := p.planAddons(ctx, cluster, current.ID); err != nil {
+		return err
+	}
+	if err := p.planApplications(ctx, cluster, current.ID); err != nil {
+		return err
+	}
+	if err := p.planConstraints(ctx, cluster, current.ID); err != nil {
+		return err
+	}
+	if err := p.planRuleGroups(ctx, cluster, current.ID); err != nil {
+		return err
+	}
+	if err := p.planAlertmanager(ctx, cluster, current.ID); err != nil {
+		return err
+	}
+	return p.planEtcdBackupConfigs(ctx, cluster, current.ID)
+}
+
+func (p *planner) planMachineDeployments(ctx context.Context, cluster apiv2.ProjectBundleCluster, clusterID string) error {
+	name := cluster.Cluster.Name
+
+	var existing []apiv1.NodeDeployment
+	if err := p.operations.ListMachineDeployments.Call(ctx, p.vars("cluster_id", clusterID), nil, nil, &existing); err != nil {
+		for _, md := range cluster.MachineDeployments {
+			p.fail(KindMachineDeployment, md.Name, name, ActionCreate, fmt.Errorf("failed to list the machine deployments: %w", err))
+		}
+		return nil
+	}
+	byName := map[string]apiv1.NodeDeployment{}
+	for _, md := range existing {
+		byName[md.Name] = md
+	}
+
+	declared := map[string]bool{}
+	for _, md := range cluster.MachineDeployments {
+		declared[md.Name] = true
+
+		current, ok := byName[md.Name]
+		if !ok {
+			p.add(KindMachineDeployment, md.Name, name, ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateMachineDeployment.Call(ctx, p.vars("cluster_id", clusterID), nil, md, nil)
+			})
+			continue
+		}
+
+		fields, err := declaredFields(md)
+		if err != nil {
+			return err
+		}
+		applied, err := upToDate(current, fields)
+		switch {
+		case err != nil:
+			return err
+		case applied:
+			p.add(KindMachineDeployment, md.Name, name, ActionNone, nil)
+		default:
+			vars := p.vars("cluster_id", clusterID, "machinedeployment_id", current.ID)
+			p.add(KindMachineDeployment, md.Name, name, ActionUpdate, func(ctx context.Context) error {
+				return p.operations.PatchMachineDeployment.Call(ctx, vars, nil, fields, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, md := range existing {
+			if declared[md.Name] {
+				continue
+			}
+			vars := p.vars("cluster_id", clusterID, "machinedeployment_id", md.ID)
+			p.add(KindMachineDeployment, md.Name, name, ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteMachineDeployment.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planAddons(ctx context.Context, cluster apiv2.ProjectBundleCluster, clusterID string) error {
+	name := cluster.Cluster.Name
+
+	var existing []apiv1.Addon
+	if err := p.operations.ListAddons.Call(ctx, p.vars("cluster_id", clusterID), nil, nil, &existing); err != nil {
+		for _, addon := range cluster.Addons {
+			p.fail(KindAddon, addon.Name, name, ActionCreate, fmt.Errorf("failed to list the addons: %w", err))
+		}
+		return nil
+	}
+	byName := map[string]apiv1.Addon{}
+	for _, addon := range existing {
+		byName[addon.Name] = addon
+	}
+
+	declared := map[string]bool{}
+	for _, addon := range cluster.Addons {
+		declared[addon.Name] = true
+
+		current, ok := byName[addon.Name]
+		if !ok {
+			p.add(KindAddon, addon.Name, name, ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateAddon.Call(ctx, p.vars("cluster_id", clusterID), nil, addon, nil)
+			})
+			continue
+		}
+
+		fields, err := declaredFields(addon)
+		if err != nil {
+			return err
+		}
+		applied, err := upToDate(current, fields)
+		switch {
+		case err != nil:
+			return err
+		case applied:
+			p.add(KindAddon, addon.Name, name, ActionNone, nil)
+		default:
+			vars := p.vars("cluster_id", clusterID, "addon_id", current.ID)
+			p.add(KindAddon, addon.Name, name, ActionUpdate, func(ctx context.Context) error {
+				return p.operations.PatchAddon.Call(ctx, vars, nil, addon, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, addon := range existing {
+			// default addons are installed by KKP and must not be removed
+			if declared[addon.Name] || addon.Spec.IsDefault {
+				continue
+			}
+			vars := p.vars("cluster_id", clusterID, "addon_id", addon.ID)
+			p.add(KindAddon, addon.Name, name, ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteAddon.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planApplications(ctx context.Context, cluster apiv2.ProjectBundleCluster, clusterID string) error {
+	name := cluster.Cluster.Name
+
+	var existing []apiv2.ApplicationInstallationListItem
+	if err := p.operations.ListApplications.Call(ctx, p.vars("cluster_id", clusterID), nil, nil, &existing); err != nil {
+		for _, application := range cluster.Applications {
+			p.fail(KindApplication, applicationKey(application.Namespace, application.Name), name, ActionCreate, fmt.Errorf("failed to list the applications: %w", err))
+		}
+		return nil
+	}
+	byKey := map[string]apiv2.ApplicationInstallationListItem{}
+	for _, application := range existing {
+		byKey[applicationKey(application.Namespace, application.Name)] = application
+	}
+
+	declared := map[string]bool{}
+	for _, application := range cluster.Applications {
+		key := applicationKey(application.Namespace, application.Name)
+		declared[key] = true
+		vars := p.vars("cluster_id", clusterID, "namespace", application.Namespace, "appinstall_name", application.Name)
+
+		if _, ok := byKey[key]; !ok {
+			p.add(KindApplication, key, name, ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateApplication.Call(ctx, p.vars("cluster_id", clusterID), nil, application, nil)
+			})
+			continue
+		}
+
+		// the list items do not hold the whole spec
+		var current apiv2.ApplicationInstallation
+		if err := p.operations.GetApplication.Call(ctx, vars, nil, nil, &current); err != nil {
+			p.fail(KindApplication, key, name, ActionUpdate, err)
+			continue
+		}
+
+		fields, err := declaredFields(application)
+		if err != nil {
+			return err
+		}
+		applied, err := upToDate(current, fields)
+		switch {
+		case err != nil:
+			return err
+		case applied:
+			p.add(KindApplication, key, name, ActionNone, nil)
+		default:
+			p.add(KindApplication, key, name, ActionUpdate, func(ctx context.Context) error {
+				return p.operations.UpdateApplication.Call(ctx, vars, nil, application, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, application := range existing {
+			key := applicationKey(application.Namespace, application.Name)
+			if declared[key] {
+				continue
+			}
+			vars := p.vars("cluster_id", clusterID, "namespace", application.Namespace, "appinstall_name", application.Name)
+			p.add(KindApplication, key, name, ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteApplication.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planClusterTemplates(ctx context.Context) error {
+	var existing []apiv2.ClusterTemplate
+	if err := p.list(ctx, p.operations.ListClusterTemplates, &existing); err != nil {
+		return err
+	}
+	byName := map[string]apiv2.ClusterTemplate{}
+	for _, template := range existing {
+		if template.Scope == kubermaticv1.ProjectClusterTemplateScope {
+			byName[template.Name] = template
+		}
+	}
+
+	declared := map[string]bool{}
+	for _, template := range p.bundle.ClusterTemplates {
+		declared[template.Name] = true
+
+		// cluster templates cannot be changed, they are only created
+		if _, ok := byName[template.Name]; ok {
+			p.add(KindClusterTemplate, template.Name, "", ActionNone, nil)
+			continue
+		}
+
+		p.add(KindClusterTemplate, template.Name, "", ActionCreate, func(ctx context.Context) error {
+			body, err := p.clusterTemplateBody(ctx, template)
+			if err != nil {
+				return err
+			}
+			return p.operations.ImportClusterTemplate.Call(ctx, p.vars(), nil, body, nil)
+		})
+	}
+
+	if p.bundle.Prune {
+		for _, template := range byName {
+			if declared[template.Name] {
+				continue
+			}
+			vars := p.vars("template_id", template.ID)
+			p.add(KindClusterTemplate, template.Name, "", ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteClusterTemplate.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+// clusterTemplateBody returns the template with the IDs of the project SSH keys it references by name. The keys
+// are looked up when the template is created, as they may be created by the same bundle.
+func (p *planner) clusterTemplateBody(ctx context.Context, template apiv2.ClusterTemplate) (apiv2.ClusterTemplate, error) {
+	template.ID = ""
+	template.ProjectID = ""
+	template.Scope = kubermaticv1.ProjectClusterTemplateScope
+	if len(template.UserSSHKeys) == 0 {
+		return template, nil
+	}
+
+	var keys []apiv1.SSHKey
+	if err := p.operations.ListSSHKeys.Call(ctx, p.vars(), nil, nil, &keys); err != nil {
+		return template, err
+	}
+	ids := map[string]string{}
+	for _, key := range keys {
+		ids[key.Name] = key.ID
+	}
+
+	sshKeys := make([]apiv2.ClusterTemplateSSHKey, 0, len(template.UserSSHKeys))
+	for _, key := range template.UserSSHKeys {
+		id, ok := ids[key.Name]
+		if !ok {
+			return template, fmt.Errorf("the project has no SSH key named %q", key.Name)
+		}
+		sshKeys = append(sshKeys, apiv2.ClusterTemplateSSHKey{Name: key.Name, ID: id})
+	}
+	template.UserSSHKeys = sshKeys
+
+	return template, nil
+}
+
+func (p *planner) planConstraints(ctx context.Context, cluster apiv2.ProjectBundleCluster, clusterID string) error {
+	name := cluster.Cluster.Name
+	if len(cluster.Constraints) == 0 && !p.bundle.Prune {
+		return nil
+	}
+
+	var existing []apiv2.Constraint
+	if err := p.operations.ListConstraints.Call(ctx, p.vars("cluster_id", clusterID), nil, nil, &existing); err != nil {
+		for _, constraint := range cluster.Constraints {
+			p.fail(KindConstraint, constraint.Name, name, ActionCreate, fmt.Errorf("failed to list the constraints: %w", err))
+		}
+		return nil
+	}
+	byName := map[string]apiv2.Constraint{}
+	for _, constraint := range existing {
+		byName[constraint.Name] = constraint
+	}
+
+	declared := map[string]bool{}
+	for _, constraint := range cluster.Constraints {
+		declared[constraint.Name] = true
+
+		current, ok := byName[constraint.Name]
+		if !ok {
+			// the spec of the create request has no JSON name
+			body := map[string]interface{}{"name": constraint.Name, "Spec": constraint.Spec}
+			p.add(KindConstraint, constraint.Name, name, ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateConstraint.Call(ctx, p.vars("cluster_id", clusterID), nil, body, nil)
+			})
+			continue
+		}
+
+		fields, err := declaredFields(constraint)
+		if err != nil {
+			return err
+		}
+		applied, err := upToDate(current, fields)
+		switch {
+		case err != nil:
+			return err
+		case applied:
+			p.add(KindConstraint, constraint.Name, name, ActionNone, nil)
+		default:
+			vars := p.vars("cluster_id", clusterID, "constraint_name", constraint.Name)
+			p.add(KindConstraint, constraint.Name, name, ActionUpdate, func(ctx context.Context) error {
+				return p.operations.PatchConstraint.Call(ctx, vars, nil, fields, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, constraint := range existing {
+			if declared[constraint.Name] {
+				continue
+			}
+			vars := p.vars("cluster_id", clusterID, "constraint_name", constraint.Name)
+			p.add(KindConstraint, constraint.Name, name, ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteConstraint.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planRuleGroups(ctx context.Context, cluster apiv2.ProjectBundleCluster, clusterID string) error {
+	name := cluster.Cluster.Name
+	if len(cluster.RuleGroups) == 0 && !p.bundle.Prune {
+		return nil
+	}
+
+	var existing []apiv2.RuleGroup
+	if err := p.operations.ListRuleGroups.Call(ctx, p.vars("cluster_id", clusterID), nil, nil, &existing); err != nil {
+		for _, ruleGroup := range cluster.RuleGroups {
+			p.fail(KindRuleGroup, ruleGroup.Name, name, ActionCreate, fmt.Errorf("failed to list the rule groups: %w", err))
+		}
+		return nil
+	}
+	byName := map[string]apiv2.RuleGroup{}
+	for _, ruleGroup := range existing {
+		byName[ruleGroup.Name] = ruleGroup
+	}
+
+	declared := map[string]bool{}
+	for _, ruleGroup := range cluster.RuleGroups {
+		declared[ruleGroup.Name] = true
+
+		current, ok := byName[ruleGroup.Name]
+		switch {
+		case !ok:
+			p.add(KindRuleGroup, ruleGroup.Name, name, ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateRuleGroup.Call(ctx, p.vars("cluster_id", clusterID), nil, ruleGroup, nil)
+			})
+		case current.Type == ruleGroup.Type && bytes.Equal(current.Data, ruleGroup.Data):
+			p.add(KindRuleGroup, ruleGroup.Name, name, ActionNone, nil)
+		default:
+			vars := p.vars("cluster_id", clusterID, "rulegroup_id", ruleGroup.Name)
+			p.add(KindRuleGroup, ruleGroup.Name, name, ActionUpdate, func(ctx context.Context) error {
+				return p.operations.UpdateRuleGroup.Call(ctx, vars, nil, ruleGroup, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, ruleGroup := range existing {
+			// default rule groups are managed by the admins
+			if declared[ruleGroup.Name] || ruleGroup.IsDefault {
+				continue
+			}
+			vars := p.vars("cluster_id", clusterID, "rulegroup_id", ruleGroup.Name)
+			p.add(KindRuleGroup, ruleGroup.Name, name, ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteRuleGroup.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+// alertmanagerName is the name reported for the alertmanager config, a cluster has only one.
+const alertmanagerName = "config"
+
+func (p *planner) planAlertmanager(ctx context.Context, cluster apiv2.ProjectBundleCluster, clusterID string) error {
+	name := cluster.Cluster.Name
+	if cluster.Alertmanager == nil {
+		return nil
+	}
+
+	vars := p.vars("cluster_id", clusterID)
+	var current apiv2.Alertmanager
+	if err := p.operations.GetAlertmanager.Call(ctx, vars, nil, nil, &current); err != nil {
+		p.fail(KindAlertmanager, alertmanagerName, name, ActionUpdate, err)
+		return nil
+	}
+
+	if bytes.Equal(bytes.TrimSpace(current.Spec.Config), bytes.TrimSpace(cluster.Alertmanager.Spec.Config)) {
+		p.add(KindAlertmanager, alertmanagerName, name, ActionNone, nil)
+		return nil
+	}
+
+	body := *cluster.Alertmanager
+	p.add(KindAlertmanager, alertmanagerName, name, ActionUpdate, func(ctx context.Context) error {
+		return p.operations.UpdateAlertmanager.Call(ctx, vars, nil, body, nil)
+	})
+	return nil
+}
+
+func (p *planner) planEtcdBackupConfigs(ctx context.Context, cluster apiv2.ProjectBundleCluster, clusterID string) error {
+	name := cluster.Cluster.Name
+	if len(cluster.EtcdBackupConfigs) == 0 && !p.bundle.Prune {
+		return nil
+	}
+
+	var existing []apiv2.EtcdBackupConfig
+	if err := p.operations.ListEtcdBackupConfigs.Call(ctx, p.vars("cluster_id", clusterID), nil, nil, &existing); err != nil {
+		for _, config := range cluster.EtcdBackupConfigs {
+			p.fail(KindEtcdBackupConfig, config.Name, name, ActionCreate, fmt.Errorf("failed to list the etcd backup configs: %w", err))
+		}
+		return nil
+	}
+	byName := map[string]apiv2.EtcdBackupConfig{}
+	for _, config := range existing {
+		byName[config.Name] = config
+	}
+
+	declared := map[string]bool{}
+	for _, config := range cluster.EtcdBackupConfigs {
+		declared[config.Name] = true
+
+		// the configs are bound to the cluster they are applied to
+		spec := config.Spec
+		spec.ClusterID = clusterID
+
+		current, ok := byName[config.Name]
+		if !ok {
+			body := map[string]interface{}{"name": config.Name, "spec": spec}
+			p.add(KindEtcdBackupConfig, config.Name, name, ActionCreate, func(ctx context.Context) error {
+				return p.operations.CreateEtcdBackupConfig.Call(ctx, p.vars("cluster_id", clusterID), nil, body, nil)
+			})
+			continue
+		}
+
+		fields, err := declaredFields(config, "spec.clusterId")
+		if err != nil {
+			return err
+		}
+		applied, err := upToDate(current, fields)
+		switch {
+		case err != nil:
+			return err
+		case applied:
+			p.add(KindEtcdBackupConfig, config.Name, name, ActionNone, nil)
+		default:
+			vars := p.vars("cluster_id", clusterID, "ebc_id", current.ID)
+			p.add(KindEtcdBackupConfig, config.Name, name, ActionUpdate, func(ctx context.Context) error {
+				return p.operations.PatchEtcdBackupConfig.Call(ctx, vars, nil, spec, nil)
+			})
+		}
+	}
+
+	if p.bundle.Prune {
+		for _, config := range existing {
+			if declared[config.Name] {
+				continue
+			}
+			vars := p.vars("cluster_id", clusterID, "ebc_id", config.ID)
+			p.add(KindEtcdBackupConfig, config.Name, name, ActionDelete, func(ctx context.Context) error {
+				return p.operations.DeleteEtcdBackupConfig.Call(ctx, vars, nil, nil, nil)
+			})
+		}
+	}
+
+	return nil
+}
+
+func convert(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
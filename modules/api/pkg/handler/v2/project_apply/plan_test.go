@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectapply
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+)
+
+type fakeRequest struct {
+	vars map[string]string
+	body map[string]interface{}
+}
+
+type fakeCall struct {
+	operation string
+	vars      map[string]string
+	body      map[string]interface{}
+}
+
+type fakeProject struct {
+	calls []fakeCall
+}
+
+func (f *fakeProject) operation(name string, response interface{}) handlercommon.Operation {
+	return handlercommon.Operation{
+		Decode: func(_ context.Context, r *http.Request) (interface{}, error) {
+			req := fakeRequest{vars: mux.Vars(r)}
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+			if len(data) > 0 {
+				if err := json.Unmarshal(data, &req.body); err != nil {
+					return nil, err
+				}
+			}
+			return req, nil
+		},
+		Endpoint: func(_ context.Context, request interface{}) (interface{}, error) {
+			req := request.(fakeRequest)
+			if response == nil {
+				f.calls = append(f.calls, fakeCall{operation: name, vars: req.vars, body: req.body})
+			}
+			return response, nil
+		},
+	}
+}
+
+func (f *fakeProject) operations() Operations {
+	replicas := int32(1)
+	return Operations{
+		ListSSHKeys: f.operation("ListSSHKeys", []apiv1.SSHKey{
+			{ObjectMeta: apiv1.ObjectMeta{ID: "key-abc", Name: "old-key"}, Spec: apiv1.SSHKeySpec{PublicKey: "ssh-rsa OLD"}},
+		}),
+		CreateSSHKey: f.operation("CreateSSHKey", nil),
+		DeleteSSHKey: f.operation("DeleteSSHKey", nil),
+
+		ListMembers: f.operation("ListMembers", []apiv1.User{
+			{ObjectMeta: apiv1.ObjectMeta{ID: "user-owner"}, Email: "owner@acme.com", Projects: []apiv1.ProjectGroup{{ID: "my-project", GroupPrefix: "owners"}}},
+			{ObjectMeta: apiv1.ObjectMeta{ID: "user-bob"}, Email: "bob@acme.com", Projects: []apiv1.ProjectGroup{{ID: "my-project", GroupPrefix: "viewers"}}},
+		}),
+		AddMember:    f.operation("AddMember", nil),
+		EditMember:   f.operation("EditMember", nil),
+		DeleteMember: f.operation("DeleteMember", nil),
+
+		ListGroupBindings: f.operation("ListGroupBindings", []apiv2.GroupProjectBinding{
+			{Name: "binding-1", Group: "developers", ProjectID: "my-project", Role: "editors"},
+		}),
+		CreateGroupBinding: f.operation("CreateGroupBinding", nil),
+		PatchGroupBinding:  f.operation("PatchGroupBinding", nil),
+		DeleteGroupBinding: f.operation("DeleteGroupBinding", nil),
+
+		ListClusters: f.operation("ListClusters", apiv1.ClusterList{
+			{ObjectMeta: apiv1.ObjectMeta{ID: "abc123", Name: "prod"}, Labels: map[string]string{"team": "a"}},
+		}),
+		CreateCluster: f.operation("CreateCluster", nil),
+		PatchCluster:  f.operation("PatchCluster", nil),
+		DeleteCluster: f.operation("DeleteCluster", nil),
+
+		ListMachineDeployments: f.operation("ListMachineDeployments", []apiv1.NodeDeployment{
+			{ObjectMeta: apiv1.ObjectMeta{ID: "workers", Name: "workers"}, Spec: apiv1.NodeDeploymentSpec{Replicas: replicas, MinReplicas: 1}},
+		}),
+		CreateMachineDeployment: f.operation("CreateMachineDeployment", nil),
+		PatchMachineDeployment:  f.operation("PatchMachineDeployment", nil),
+		DeleteMachineDeployment: f.operation("DeleteMachineDeployment", nil),
+
+		ListAddons: f.operation("ListAddons", []apiv1.Addon{
+			{ObjectMeta: apiv1.ObjectMeta{ID: "canal", Name: "canal"}, Spec: apiv1.AddonSpec{IsDefault: true}},
+			{ObjectMeta: apiv1.ObjectMeta{ID: "dashboard", Name: "dashboard"}},
+		}),
+		CreateAddon: f.operation("CreateAddon", nil),
+		PatchAddon:  f.operation("PatchAddon", nil),
+		DeleteAddon: f.operation("DeleteAddon", nil),
+
+		ListApplications:  f.operation("ListApplications", []apiv2.ApplicationInstallationListItem{}),
+		GetApplication:    f.operation("GetApplication", nil),
+		CreateApplication: f.operation("CreateApplication", nil),
+		UpdateApplication: f.operation("UpdateApplication", nil),
+		DeleteApplication: f.operation("DeleteApplication", nil),
+
+		ListConstraints:  f.operation("ListConstraints", []apiv2.Constraint{}),
+		CreateConstraint: f.operation("CreateConstraint", nil),
+		PatchConstraint:  f.operation("PatchConstraint", nil),
+		DeleteConstraint: f.operation("DeleteConstraint", nil),
+
+		ListRuleGroups: f.operation("ListRuleGroups", []apiv2.RuleGroup{
+			{Name: "alerts", IsDefault: true},
+		}),
+		CreateRuleGroup: f.operation("CreateRuleGroup", nil),
+		UpdateRuleGroup: f.operation("UpdateRuleGroup", nil),
+		DeleteRuleGroup: f.operation("DeleteRuleGroup", nil),
+
+		GetAlertmanager:    f.operation("GetAlertmanager", apiv2.Alertmanager{}),
+		UpdateAlertmanager: f.operation("UpdateAlertmanager", nil),
+
+		ListEtcdBackupConfigs:  f.operation("ListEtcdBackupConfigs", []apiv2.EtcdBackupConfig{}),
+		CreateEtcdBackupConfig: f.operation("CreateEtcdBackupConfig", nil),
+		PatchEtcdBackupConfig:  f.operation("PatchEtcdBackupConfig", nil),
+		DeleteEtcdBackupConfig: f.operation("DeleteEtcdBackupConfig", nil),
+
+		ListClusterTemplates:  f.operation("ListClusterTemplates", []apiv2.ClusterTemplate{}),
+		ImportClusterTemplate: f.operation("ImportClusterTemplate", nil),
+		DeleteClusterTemplate: f.operation("DeleteClusterTemplate", nil),
+
+		CreateProject: f.operation("CreateProject", nil),
+	}
+}
+
+func testBundle() apiv2.ProjectBundle {
+	return apiv2.ProjectBundle{
+		Prune:   true,
+		SSHKeys: []apiv2.ProjectBundleSSHKey{{Name: "new-key", PublicKey: "ssh-rsa NEW"}},
+		Members: []apiv2.ProjectBundleMember{{Email: "Bob@acme.com", Group: "editors"}},
+		GroupBindings: []apiv2.ProjectBundleGroupBinding{
+			{Group: "developers", Role: "editors"},
+		},
+		Clusters: []apiv2.ProjectBundleCluster{
+			{
+				Cluster: apiv1.Cluster{ObjectMeta: apiv1.ObjectMeta{Name: "prod"}, Labels: map[string]string{"team": "a"}},
+				MachineDeployments: []apiv1.NodeDeployment{
+					{ObjectMeta: apiv1.ObjectMeta{Name: "workers"}, Spec: apiv1.NodeDeploymentSpec{Replicas: 3}},
+				},
+				EtcdBackupConfigs: []apiv2.EtcdBackupConfig{
+					{ObjectMeta: apiv1.ObjectMeta{Name: "daily"}, Spec: apiv2.EtcdBackupConfigSpec{Schedule: "@daily"}},
+				},
+			},
+			{
+				Cluster: apiv1.Cluster{ObjectMeta: apiv1.ObjectMeta{Name: "staging"}},
+				MachineDeployments: []apiv1.NodeDeployment{
+					{ObjectMeta: apiv1.ObjectMeta{Name: "workers"}},
+					{ObjectMeta: apiv1.ObjectMeta{Name: "gpu"}},
+				},
+			},
+		},
+	}
+}
+
+func TestPlan(t *testing.T) {
+	project := &fakeProject{}
+	p := &planner{operations: project.operations(), projectID: "my-project", userEmail: "owner@acme.com", bundle: testBundle()}
+	if err := p.plan(context.Background()); err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+
+	report := p.apply(context.Background(), true)
+	if len(project.calls) != 0 {
+		t.Fatalf("expected a dry run not to change anything, got %+v", project.calls)
+	}
+
+	expected := []apiv2.ProjectApplyResult{
+		{Kind: KindSSHKey, Name: "new-key", Action: ActionCreate, Status: StatusPlanned},
+		{Kind: KindSSHKey, Name: "old-key", Action: ActionDelete, Status: StatusPlanned},
+		{Kind: KindMember, Name: "Bob@acme.com", Action: ActionUpdate, Status: StatusPlanned},
+		{Kind: KindGroupBinding, Name: "developers", Action: ActionNone, Status: StatusUnchanged},
+		{Kind: KindCluster, Name: "prod", Action: ActionNone, Status: StatusUnchanged},
+		{Kind: KindMachineDeployment, Name: "workers", Cluster: "prod", Action: ActionUpdate, Status: StatusPlanned},
+		{Kind: KindAddon, Name: "dashboard", Cluster: "prod", Action: ActionDelete, Status: StatusPlanned},
+		{Kind: KindEtcdBackupConfig, Name: "daily", Cluster: "prod", Action: ActionCreate, Status: StatusPlanned},
+		{Kind: KindCluster, Name: "staging", Action: ActionCreate, Status: StatusPlanned},
+		{Kind: KindMachineDeployment, Name: "workers", Cluster: "staging", Action: ActionCreate, Status: StatusPlanned},
+		{Kind: KindMachineDeployment, Name: "gpu", Cluster: "staging", Action: ActionCreate, Status: StatusPlanned},
+	}
+	if !reflect.DeepEqual(report.Results, expected) {
+		t.Fatalf("unexpected plan:\n%+v\nexpected:\n%+v", report.Results, expected)
+	}
+}
+
+func TestApply(t *testing.T) {
+	project := &fakeProject{}
+	p := &planner{operations: project.operations(), projectID: "my-project", userEmail: "owner@acme.com", bundle: testBundle()}
+	if err := p.plan(context.Background()); err != nil {
+		t.Fatalf("failed to plan: %v", err)
+	}
+
+	report := p.apply(context.Background(), false)
+
+	var operations []string
+	for _, call := range project.calls {
+		operations = append(operations, call.operation)
+	}
+	expectedOperations := []string{"CreateSSHKey", "DeleteSSHKey", "EditMember", "PatchMachineDeployment", "DeleteAddon", "CreateEtcdBackupConfig", "CreateCluster"}
+	if !reflect.DeepEqual(operations, expectedOperations) {
+		t.Fatalf("expected the calls %v, got %v", expectedOperations, operations)
+	}
+
+	patch := project.calls[3]
+	if patch.vars["machinedeployment_id"] != "workers" || patch.vars["cluster_id"] != "abc123" {
+		t.Errorf("expected the machine deployment of the existing cluster to be patched, got %v", patch.vars)
+	}
+	if !reflect.DeepEqual(patch.body, map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(3)}}) {
+		t.Errorf("expected only the declared fields to be patched, got %v", patch.body)
+	}
+
+	backup := project.calls[5]
+	if spec, _ := backup.body["spec"].(map[string]interface{}); spec == nil || spec["clusterId"] != "abc123" {
+		t.Errorf("expected the etcd backup config to be bound to the cluster, got %v", backup.body)
+	}
+
+	create := project.calls[6]
+	if md, _ := create.body["nodeDeployment"].(map[string]interface{}); md == nil || md["name"] != "workers" {
+		t.Errorf("expected the first machine deployment to be created with the cluster, got %v", create.body)
+	}
+
+	statuses := map[string]string{}
+	for _, result := range report.Results {
+		statuses[result.Cluster+"/"+result.Name] = result.Status
+	}
+	if statuses["staging/workers"] != StatusApplied || statuses["staging/gpu"] != StatusSkipped {
+		t.Errorf("expected the first machine deployment to be applied with the cluster and the second one to be skipped, got %v", statuses)
+	}
+}
+
+func TestImportDryRun(t *testing.T) {
+	project := &fakeProject{}
+	operations := project.operations()
+	operations.ListSSHKeys = project.operation("ListSSHKeys", nil)
+	operations.ListMembers = project.operation("ListMembers", nil)
+	operations.ListGroupBindings = project.operation("ListGroupBindings", nil)
+	operations.ListClusterTemplates = project.operation("ListClusterTemplates", nil)
+	operations.ListClusters = project.operation("ListClusters", nil)
+
+	bundle := testBundle()
+	bundle.Members = append(bundle.Members, apiv2.ProjectBundleMember{Email: "owner@acme.com", Group: "owners"})
+	userInfoGetter := func(_ context.Context, _ string) (*provider.UserInfo, error) {
+		return &provider.UserInfo{Email: "owner@acme.com"}, nil
+	}
+
+	report, err := importProject(context.Background(), userInfoGetter, operations, apiv2.ProjectExport{Name: "staging", Bundle: bundle}, true)
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	if len(project.calls) != 0 {
+		t.Fatalf("expected a dry run of an import neither to create the project nor to list its resources, got %+v", project.calls)
+	}
+	if report.Project != nil {
+		t.Errorf("expected no project for a dry run, got %v", report.Project)
+	}
+
+	for _, result := range report.Report.Results {
+		expected := ActionCreate
+		if result.Name == "owner@acme.com" {
+			expected = ActionNone
+		}
+		if result.Action != expected {
+			t.Errorf("expected %s %s to be planned as %s, got %s", result.Kind, result.Name, expected, result.Action)
+		}
+	}
+}
+
+func TestWithoutKeys(t *testing.T) {
+	in := map[string]string{"team": "a", "project-id": "my-project"}
+
+	if out := withoutKeys(in, "project-id"); !reflect.DeepEqual(out, map[string]string{"team": "a"}) {
+		t.Errorf("expected only the team label to be left, got %v", out)
+	}
+	if out := withoutKeys(in, "team", "project-id"); out != nil {
+		t.Errorf("expected nil if no label is left, got %v", out)
+	}
+	if len(in) != 2 {
+		t.Errorf("expected the input not to be changed, got %v", in)
+	}
+}
+
+func TestContains(t *testing.T) {
+	existing := map[string]interface{}{
+		"name":   "workers",
+		"labels": map[string]interface{}{"team": "a", "env": "prod"},
+		"taints": []interface{}{map[string]interface{}{"key": "gpu", "effect": "NoSchedule"}},
+	}
+
+	testcases := []struct {
+		declared map[string]interface{}
+		contains bool
+	}{
+		{declared: map[string]interface{}{"labels": map[string]interface{}{"team": "a"}}, contains: true},
+		{declared: map[string]interface{}{"labels": map[string]interface{}{"team": "b"}}, contains: false},
+		{declared: map[string]interface{}{"taints": []interface{}{map[string]interface{}{"key": "gpu"}}}, contains: true},
+		{declared: map[string]interface{}{"taints": []interface{}{}}, contains: false},
+		{declared: map[string]interface{}{"replicas": float64(1)}, contains: false},
+	}
+
+	for _, tc := range testcases {
+		if contains(existing, tc.declared) != tc.contains {
+			t.Errorf("expected contains(%v) to be %v", tc.declared, tc.contains)
+		}
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
 	"k8s.io/utils/ptr"
@@ -44,7 +45,7 @@ func AnexiaProjectVlansEndpoint(presetProvider provider.PresetProvider, userInfo
 		}
 
 		if len(req.Credential) > 0 {
-			preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential)
+			preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential, string(kubermaticv1.AnexiaCloudProvider))
 			if err != nil {
 				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 			}
@@ -68,7 +69,7 @@ func AnexiaProjectTemplatesEndpoint(presetProvider provider.PresetProvider, user
 		}
 
 		if len(req.Credential) > 0 {
-			preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential)
+			preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential, string(kubermaticv1.AnexiaCloudProvider))
 			if err != nil {
 				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 			}
@@ -92,7 +93,7 @@ func AnexiaProjectDiskTypesEndpoint(presetProvider provider.PresetProvider, user
 		}
 
 		if len(req.Credential) > 0 {
-			preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential)
+			preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential, string(kubermaticv1.AnexiaCloudProvider))
 			if err != nil {
 				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 			}
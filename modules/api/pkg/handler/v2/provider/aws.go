@@ -41,6 +41,7 @@ import (
 // swagger:parameters listAWSSizesNoCredentialsV2
 type awsSizeNoCredentialsReq struct {
 	cluster.GetClusterReq
+	common.ListOptionsReq
 	// architecture query parameter. Supports: arm64 and x64 types.
 	// in: query
 	Architecture string `json:"architecture,omitempty"`
@@ -80,6 +81,7 @@ type AWSProjectCommonReq struct {
 // swagger:parameters listProjectAWSSizes
 type AWSProjectSizesReq struct {
 	AWSProjectCommonReq
+	common.ListOptionsReq
 
 	// in: header
 	// name: Region
@@ -182,6 +184,11 @@ func DecodeAWSSizeNoCredentialsReq(c context.Context, r *http.Request) (interfac
 	}
 	req.ProjectReq = pr.(common.ProjectReq)
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	req.Architecture = r.URL.Query().Get("architecture")
 	if len(req.Architecture) > 0 {
 		if req.Architecture == handlercommon.ARM64Architecture || req.Architecture == handlercommon.X64Architecture {
@@ -205,6 +212,11 @@ func DecodeProjectAWSSizesReq(c context.Context, r *http.Request) (interface{},
 	req.Region = r.Header.Get("Region")
 	req.DatacenterName = r.Header.Get("DatacenterName")
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	req.Architecture = r.URL.Query().Get("architecture")
 	if len(req.Architecture) > 0 {
 		if req.Architecture == handlercommon.ARM64Architecture || req.Architecture == handlercommon.X64Architecture {
@@ -300,7 +312,7 @@ func ListProjectAWSSubnets(userInfoGetter provider.UserInfoGetter, seedsGetter p
 		}
 
 		if len(req.Credential) > 0 {
-			preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential)
+			preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(req.GetProjectID()), req.Credential, string(kubermaticv1.AWSCloudProvider))
 			if err != nil {
 				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 			}
@@ -404,7 +416,7 @@ func getAWSCredentialsFromRequest(ctx context.Context, req AWSCommonReq, userInf
 	}
 
 	if len(req.Credential) > 0 {
-		preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(projectID), req.Credential)
+		preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), req.Credential, string(kubermaticv1.AWSCloudProvider))
 		if err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 		}
@@ -29,15 +29,17 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
 	"k8s.io/utils/ptr"
 )
 
-func AzureSizeWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func AzureSizeWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(azureSizeNoCredentialsReq)
-		return providercommon.AzureSizeWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, settingsProvider, req.ProjectID, req.ClusterID)
+		return providercommon.AzureSizeWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, settingsProvider, req.ProjectID, req.ClusterID)
 	}
 }
 
@@ -203,7 +205,7 @@ func AzureSubnetsEndpoint(presetProvider provider.PresetProvider, userInfoGetter
 	}
 }
 
-func AzureSizesEndpoint(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func AzureSizesEndpoint(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(azureProjectSizesReq)
 		if !ok {
@@ -235,7 +237,7 @@ func AzureSizesEndpoint(presetProvider provider.PresetProvider, userInfoGetter p
 			filter = handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
 		}
 
-		return providercommon.AzureSize(ctx, filter, credentials.subscriptionID, credentials.clientID, credentials.clientSecret, credentials.tenantID, req.Location)
+		return providercommon.AzureSize(ctx, providerCache, filter, credentials.subscriptionID, credentials.clientID, credentials.clientSecret, credentials.tenantID, req.Location)
 	}
 }
 
@@ -274,7 +276,7 @@ func getAzureCredentialsFromReq(ctx context.Context, req azureCommonReq, userInf
 	}
 
 	if len(req.Credential) > 0 {
-		preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(projectID), req.Credential)
+		preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), req.Credential, string(kubermaticv1.AzureCloudProvider))
 		if err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 		}
@@ -299,6 +301,7 @@ func getAzureCredentialsFromReq(ctx context.Context, req azureCommonReq, userInf
 // swagger:parameters listAzureSizesNoCredentialsV2
 type azureSizeNoCredentialsReq struct {
 	cluster.GetClusterReq
+	common.ListOptionsReq
 }
 
 // GetSeedCluster returns the SeedCluster object.
@@ -322,6 +325,11 @@ func DecodeAzureSizesNoCredentialsReq(c context.Context, r *http.Request) (inter
 		return nil, err
 	}
 	req.ProjectReq = pr.(common.ProjectReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
@@ -329,7 +337,7 @@ func DecodeAzureSizesNoCredentialsReq(c context.Context, r *http.Request) (inter
 // note that the request doesn't have credentials for authN
 // swagger:parameters listAzureAvailabilityZonesNoCredentialsV2
 type azureAvailabilityZonesNoCredentialsReq struct {
-	azureSizeNoCredentialsReq
+	cluster.GetClusterReq
 	// in: header
 	// name: SKUName
 	SKUName string
@@ -348,7 +356,7 @@ func DecodeAzureAvailabilityZonesNoCredentialsReq(c context.Context, r *http.Req
 	if err != nil {
 		return nil, err
 	}
-	req.azureSizeNoCredentialsReq = lr.(azureSizeNoCredentialsReq)
+	req.GetClusterReq = lr.(azureSizeNoCredentialsReq).GetClusterReq
 	req.SKUName = r.Header.Get("SKUName")
 	return req, nil
 }
@@ -383,6 +391,7 @@ func DecodeAzureCommonReq(_ context.Context, r *http.Request) (interface{}, erro
 // swagger:parameters listProjectAzureSizes
 type azureProjectSizesReq struct {
 	common.ProjectReq
+	common.ListOptionsReq
 	azureCommonReq
 
 	// in: header
@@ -403,8 +412,14 @@ func DecodeAzureProjectSizesReq(c context.Context, r *http.Request) (interface{}
 		return nil, err
 	}
 
+	listOptions, err := common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return azureProjectSizesReq{
 		ProjectReq:     projectReq.(common.ProjectReq),
+		ListOptionsReq: listOptions,
 		azureCommonReq: commonReq.(azureCommonReq),
 		Location:       r.Header.Get("Location"),
 		DatacenterName: r.Header.Get("DatacenterName"),
@@ -30,11 +30,13 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
 // gcpTypesNoCredentialReq represent a request for GCP machine or disk types.
-// swagger:parameters listGCPSizesNoCredentialsV2 listGCPDiskTypesNoCredentialsV2
+// swagger:parameters listGCPDiskTypesNoCredentialsV2
 type gcpTypesNoCredentialReq struct {
 	common.ProjectReq
 	// in: path
@@ -115,6 +117,7 @@ type GCPProjectSubnetReq struct {
 // swagger:parameters listProjectGCPVMSizes
 type GCPProjectMachineTypesReq struct {
 	GCPProjectCommonReq
+	common.ListOptionsReq
 	Zone string
 	DC   string
 }
@@ -146,6 +149,29 @@ func DecodeGCPTypesNoCredentialReq(c context.Context, r *http.Request) (interfac
 	return req, nil
 }
 
+// gcpSizesNoCredentialReq represent a request for GCP machine types.
+// swagger:parameters listGCPSizesNoCredentialsV2
+type gcpSizesNoCredentialReq struct {
+	gcpTypesNoCredentialReq
+	common.ListOptionsReq
+}
+
+func DecodeGCPSizesNoCredentialReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req gcpSizesNoCredentialReq
+	typesReq, err := DecodeGCPTypesNoCredentialReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.gcpTypesNoCredentialReq = typesReq.(gcpTypesNoCredentialReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func DecodeGCPSubnetworksNoCredentialReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req gcpSubnetworksNoCredentialReq
 	clusterID, err := common.DecodeClusterID(c, r)
@@ -283,6 +309,11 @@ func DecodeProjectGCPVMSizes(c context.Context, r *http.Request) (interface{}, e
 	req.DC = r.Header.Get("DatacenterName")
 	req.Zone = r.Header.Get("Zone")
 
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
@@ -304,7 +335,7 @@ func getSAFromPreset(ctx context.Context,
 	if err != nil {
 		return "", common.KubernetesErrorToHTTPError(err)
 	}
-	preset, err := presetProvider.GetPreset(ctx, userInfo, &projectID, presetName)
+	preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, &projectID, presetName, string(kubermaticv1.GCPCloudProvider))
 	if err != nil {
 		return "", utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", presetName, userInfo.Email))
 	}
@@ -315,7 +346,7 @@ func getSAFromPreset(ctx context.Context,
 	return credentials.ServiceAccount, nil
 }
 
-func ListProjectGCPDiskTypes(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func ListProjectGCPDiskTypes(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		listReq, ok := request.(GCPProjectVMReq)
 		if !ok {
@@ -335,11 +366,11 @@ func ListProjectGCPDiskTypes(presetProvider provider.PresetProvider, userInfoGet
 			}
 		}
 
-		return providercommon.ListGCPDiskTypes(ctx, sa, listReq.Zone)
+		return providercommon.ListGCPDiskTypes(ctx, providerCache, sa, listReq.Zone)
 	}
 }
 
-func ListProjectGCPZones(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedGetter provider.SeedsGetter) endpoint.Endpoint {
+func ListProjectGCPZones(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedGetter provider.SeedsGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		projectReq, ok := request.(GCPProjectDatacenterReq)
 		if !ok {
@@ -365,11 +396,11 @@ func ListProjectGCPZones(presetProvider provider.PresetProvider, userInfoGetter
 			return nil, err
 		}
 
-		return providercommon.ListGCPZones(ctx, userInfo, sa, projectReq.DC, seedGetter)
+		return providercommon.ListGCPZones(ctx, providerCache, userInfo, sa, projectReq.DC, seedGetter)
 	}
 }
 
-func ListProjectGCPNetworks(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func ListProjectGCPNetworks(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		listReq, ok := request.(GCPProjectCommonReq)
 		if !ok {
@@ -390,11 +421,11 @@ func ListProjectGCPNetworks(presetProvider provider.PresetProvider, userInfoGett
 			}
 		}
 
-		return providercommon.ListGCPNetworks(ctx, sa)
+		return providercommon.ListGCPNetworks(ctx, providerCache, sa)
 	}
 }
 
-func ListProjectGCPSubnetworks(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedGetter provider.SeedsGetter) endpoint.Endpoint {
+func ListProjectGCPSubnetworks(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, seedGetter provider.SeedsGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		listReq := request.(GCPProjectSubnetReq)
 
@@ -417,11 +448,11 @@ func ListProjectGCPSubnetworks(presetProvider provider.PresetProvider, userInfoG
 			}
 		}
 
-		return providercommon.ListGCPSubnetworks(ctx, userInfo, listReq.DC, sa, listReq.Network, seedGetter)
+		return providercommon.ListGCPSubnetworks(ctx, providerCache, userInfo, listReq.DC, sa, listReq.Network, seedGetter)
 	}
 }
 
-func ListProjectGCPVMSizes(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, seedsGetter provider.SeedsGetter) endpoint.Endpoint {
+func ListProjectGCPVMSizes(presetProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, seedsGetter provider.SeedsGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		listReq, ok := request.(GCPProjectMachineTypesReq)
 		if !ok {
@@ -461,41 +492,41 @@ func ListProjectGCPVMSizes(presetProvider provider.PresetProvider, userInfoGette
 			filter = handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
 		}
 
-		return providercommon.ListGCPSizes(ctx, filter, sa, listReq.Zone)
+		return providercommon.ListGCPSizes(ctx, providerCache, filter, sa, listReq.Zone)
 	}
 }
 
-func GCPDiskTypesWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func GCPDiskTypesWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(gcpTypesNoCredentialReq)
-		return providercommon.GCPDiskTypesWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.Zone)
+		return providercommon.GCPDiskTypesWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.Zone)
 	}
 }
 
-func GCPSizeWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider) endpoint.Endpoint {
+func GCPSizeWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(gcpTypesNoCredentialReq)
-		return providercommon.GCPSizeWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, settingsProvider, req.ProjectID, req.ClusterID, req.Zone)
+		req := request.(gcpSizesNoCredentialReq)
+		return providercommon.GCPSizeWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, settingsProvider, req.ProjectID, req.ClusterID, req.Zone)
 	}
 }
 
-func GCPZoneWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func GCPZoneWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(cluster.GetClusterReq)
-		return providercommon.GCPZoneWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID)
+		return providercommon.GCPZoneWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID)
 	}
 }
 
-func GCPNetworkWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func GCPNetworkWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(cluster.GetClusterReq)
-		return providercommon.GCPNetworkWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+		return providercommon.GCPNetworkWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
 	}
 }
 
-func GCPSubnetworkWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func GCPSubnetworkWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(gcpSubnetworksNoCredentialReq)
-		return providercommon.GCPSubnetworkWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, req.Network)
+		return providercommon.GCPSubnetworkWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider, privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, req.Network)
 	}
 }
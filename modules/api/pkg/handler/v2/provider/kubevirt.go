@@ -29,6 +29,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticprovider "k8c.io/kubermatic/v2/pkg/provider"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
@@ -97,7 +98,7 @@ func getKubeconfig(ctx context.Context, kubeconfig, credential, projectID string
 		return "", common.KubernetesErrorToHTTPError(err)
 	}
 	if len(credential) > 0 {
-		preset, err := presetsProvider.GetPreset(ctx, userInfo, ptr.To(projectID), credential)
+		preset, err := presetsProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), credential, string(kubermaticv1.KubevirtCloudProvider))
 		if err != nil {
 			return "", utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", credential, userInfo.Email))
 		}
@@ -115,7 +116,7 @@ func getKubeconfigAndVPCName(ctx context.Context, kubeconfig, vpcName, credentia
 	}
 	var presetSubnets []string
 	if len(credential) > 0 {
-		preset, err := presetsProvider.GetPreset(ctx, userInfo, ptr.To(projectID), credential)
+		preset, err := presetsProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), credential, string(kubermaticv1.KubevirtCloudProvider))
 		if err != nil {
 			return "", "", nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", credential, userInfo.Email))
 		}
@@ -543,7 +543,7 @@ func getNutanixClient(ctx context.Context, req NutanixCommonReq, presetProvider
 	}
 
 	if len(req.Credential) > 0 {
-		preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(projectID), req.Credential)
+		preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), req.Credential, string(kubermaticv1.NutanixCloudProvider))
 		if err != nil {
 			return nil, nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 		}
@@ -35,6 +35,8 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/machine-controller/sdk/providerconfig"
@@ -82,7 +84,7 @@ func GetOpenstackAuthInfo(ctx context.Context, req OpenstackReq, projectID strin
 }
 
 func getPresetCredentials(ctx context.Context, userInfo *provider.UserInfo, presetName string, projectID string, presetProvider provider.PresetProvider, token string) (resources.OpenstackCredentials, error) {
-	p, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(projectID), presetName)
+	p, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), presetName, string(kubermaticv1.OpenstackCloudProvider))
 	if err != nil {
 		return resources.OpenstackCredentials{}, fmt.Errorf("can not get preset %s for user %s", presetName, userInfo.Email)
 	}
@@ -109,9 +111,9 @@ func getPresetCredentials(ctx context.Context, userInfo *provider.UserInfo, pres
 }
 
 func OpenstackSizeEndpoint(seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider,
-	userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req, ok := request.(OpenstackProjectReq)
+		req, ok := request.(OpenstackProjectSizesReq)
 		if !ok {
 			return nil, utilerrors.NewBadRequest("invalid request")
 		}
@@ -134,7 +136,7 @@ func OpenstackSizeEndpoint(seedsGetter provider.SeedsGetter, presetProvider prov
 
 		filter := handlercommon.DetermineMachineFlavorFilter(datacenter.Spec.MachineFlavorFilter, settings.Spec.MachineDeploymentVMResourceQuota)
 
-		return providercommon.GetOpenstackSizes(cred, datacenter, filter, caBundle)
+		return providercommon.GetOpenstackSizes(providerCache, cred, datacenter, filter, caBundle)
 	}
 }
 
@@ -162,7 +164,7 @@ func OpenstackAvailabilityZoneEndpoint(seedsGetter provider.SeedsGetter, presetP
 }
 
 func OpenstackNetworkEndpoint(seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider,
-	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(OpenstackProjectReq)
 		if !ok {
@@ -174,12 +176,12 @@ func OpenstackNetworkEndpoint(seedsGetter provider.SeedsGetter, presetProvider p
 			return nil, err
 		}
 
-		return providercommon.GetOpenstackNetworks(ctx, userInfo, seedsGetter, cred, req.DatacenterName, caBundle)
+		return providercommon.GetOpenstackNetworks(ctx, providerCache, userInfo, seedsGetter, cred, req.DatacenterName, caBundle)
 	}
 }
 
 func OpenstackSubnetsEndpoint(seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider,
-	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(OpenstackProjectSubnetReq)
 		if !ok {
@@ -191,12 +193,12 @@ func OpenstackSubnetsEndpoint(seedsGetter provider.SeedsGetter, presetProvider p
 			return nil, err
 		}
 
-		return providercommon.GetOpenstackSubnets(ctx, userInfo, seedsGetter, cred, req.NetworkID, req.DatacenterName, caBundle)
+		return providercommon.GetOpenstackSubnets(ctx, providerCache, userInfo, seedsGetter, cred, req.NetworkID, req.DatacenterName, caBundle)
 	}
 }
 
 func OpenstackSecurityGroupEndpoint(seedsGetter provider.SeedsGetter, presetProvider provider.PresetProvider,
-	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(OpenstackProjectReq)
 		if !ok {
@@ -208,7 +210,7 @@ func OpenstackSecurityGroupEndpoint(seedsGetter provider.SeedsGetter, presetProv
 			return nil, err
 		}
 
-		return providercommon.GetOpenstackSecurityGroups(ctx, userInfo, seedsGetter, cred, req.DatacenterName, caBundle)
+		return providercommon.GetOpenstackSecurityGroups(ctx, providerCache, userInfo, seedsGetter, cred, req.DatacenterName, caBundle)
 	}
 }
 
@@ -245,10 +247,10 @@ func OpenstackTenantEndpoint(seedsGetter provider.SeedsGetter, presetProvider pr
 
 func OpenstackSizeWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
-	userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(openstackNoCredentialsReq)
-		return providercommon.OpenstackSizeWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider,
+		req := request.(openstackSizesNoCredentialsReq)
+		return providercommon.OpenstackSizeWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider,
 			privilegedProjectProvider, seedsGetter, settingsProvider, req.ProjectID, req.ClusterID, caBundle)
 	}
 }
@@ -265,20 +267,20 @@ func OpenstackTenantWithClusterCredentialsEndpoint(projectProvider provider.Proj
 
 func OpenstackNetworkWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
-	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(openstackNoCredentialsReq)
-		return providercommon.OpenstackNetworkWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider,
+		return providercommon.OpenstackNetworkWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider,
 			privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, caBundle)
 	}
 }
 
 func OpenstackSecurityGroupWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
-	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(openstackNoCredentialsReq)
-		return providercommon.OpenstackSecurityGroupWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider,
+		return providercommon.OpenstackSecurityGroupWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider,
 			privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, caBundle)
 	}
 }
@@ -328,10 +330,10 @@ func OpenstackServerGroupEndpoint(seedsGetter provider.SeedsGetter, presetProvid
 
 func OpenstackSubnetsWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
-	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, caBundle *x509.CertPool, providerCache *providercache.Cache) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(openstackSubnetNoCredentialsReq)
-		return providercommon.OpenstackSubnetsWithClusterCredentialsEndpoint(ctx, userInfoGetter, projectProvider,
+		return providercommon.OpenstackSubnetsWithClusterCredentialsEndpoint(ctx, providerCache, userInfoGetter, projectProvider,
 			privilegedProjectProvider, seedsGetter, req.ProjectID, req.ClusterID, req.NetworkID, caBundle)
 	}
 }
@@ -454,7 +456,7 @@ func OpenstackMemberSubnetsEndpoint(seedsGetter provider.SeedsGetter, presetProv
 }
 
 // openstackNoCredentialsReq represent a request for openstack
-// swagger:parameters listOpenstackSizesNoCredentialsV2 listOpenstackTenantsNoCredentialsV2 listOpenstackNetworksNoCredentialsV2 listOpenstackSecurityGroupsNoCredentialsV2 listOpenstackAvailabilityZonesNoCredentialsV2 listOpenstackServerGroupsNoCredentials listOpenstackImagesNoCredentials
+// swagger:parameters listOpenstackTenantsNoCredentialsV2 listOpenstackNetworksNoCredentialsV2 listOpenstackSecurityGroupsNoCredentialsV2 listOpenstackAvailabilityZonesNoCredentialsV2 listOpenstackServerGroupsNoCredentials listOpenstackImagesNoCredentials
 type openstackNoCredentialsReq struct {
 	cluster.GetClusterReq
 	// in: query
@@ -487,6 +489,28 @@ func DecodeOpenstackNoCredentialsReq(c context.Context, r *http.Request) (interf
 	return req, nil
 }
 
+// openstackSizesNoCredentialsReq represent a request for openstack sizes
+// swagger:parameters listOpenstackSizesNoCredentialsV2
+type openstackSizesNoCredentialsReq struct {
+	openstackNoCredentialsReq
+	common.ListOptionsReq
+}
+
+func DecodeOpenstackSizesNoCredentialsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req openstackSizesNoCredentialsReq
+	lr, err := DecodeOpenstackNoCredentialsReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.openstackNoCredentialsReq = lr.(openstackNoCredentialsReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 // openstackSubnetNoCredentialsReq represent a request for openstack subnets
 // swagger:parameters listOpenstackSubnetsNoCredentialsV2
 type openstackSubnetNoCredentialsReq struct {
@@ -607,7 +631,7 @@ func (r OpenstackReq) GetProjectIdOrDefaultToTenantId() string {
 }
 
 // OpenstackProjectReq represent a request for Openstack data within the context of a KKP project.
-// swagger:parameters listProjectOpenstackSizes listProjectOpenstackAvailabilityZones listProjectOpenstackNetworks listProjectOpenstackSecurityGroups listProjectOpenstackServerGroups listProjectOpenstackImages
+// swagger:parameters listProjectOpenstackAvailabilityZones listProjectOpenstackNetworks listProjectOpenstackSecurityGroups listProjectOpenstackServerGroups listProjectOpenstackImages
 type OpenstackProjectReq struct {
 	OpenstackReq
 	common.ProjectReq
@@ -616,6 +640,13 @@ type OpenstackProjectReq struct {
 	OS string `json:"os,omitempty"`
 }
 
+// OpenstackProjectSizesReq represent a request for Openstack sizes within the context of a KKP project.
+// swagger:parameters listProjectOpenstackSizes
+type OpenstackProjectSizesReq struct {
+	OpenstackProjectReq
+	common.ListOptionsReq
+}
+
 // OpenstackProjectSubnetPoolReq represent a request for openstack subnet pools within the context of a KKP project.
 // swagger:parameters listProjectOpenstackSubnetPools
 type OpenstackProjectSubnetPoolReq struct {
@@ -715,6 +746,21 @@ func DecodeOpenstackProjectReq(c context.Context, r *http.Request) (interface{},
 	}, nil
 }
 
+func DecodeOpenstackProjectSizesReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req OpenstackProjectSizesReq
+	projectReq, err := DecodeOpenstackProjectReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.OpenstackProjectReq = projectReq.(OpenstackProjectReq)
+
+	req.ListOptionsReq, err = common.DecodeListOptionsReq(r)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 func DecodeOpenstackProjectSubnetPoolReq(c context.Context, r *http.Request) (interface{}, error) {
 	projectReq, err := common.DecodeProjectRequest(c, r)
 	if err != nil {
@@ -31,6 +31,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/provider"
 	vcd "k8c.io/dashboard/v2/pkg/provider/cloud/vmwareclouddirector"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
 	"k8s.io/utils/ptr"
@@ -255,7 +256,7 @@ func getVMwareCloudDirectorCredentialsFromReq(ctx context.Context, req VMwareClo
 	}
 
 	if len(req.Credential) > 0 {
-		preset, err := presetProvider.GetPreset(ctx, userInfo, ptr.To(projectID), req.Credential)
+		preset, err := presetProvider.GetPresetCredentials(ctx, userInfo, ptr.To(projectID), req.Credential, string(kubermaticv1.VMwareCloudDirectorCloudProvider))
 		if err != nil {
 			return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
 		}
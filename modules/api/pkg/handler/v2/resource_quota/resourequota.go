@@ -133,3 +133,21 @@ func DeleteResourceQuotaEndpoint(userInfoGetter provider.UserInfoGetter, provide
 		return nil, nil
 	}
 }
+
+func PutResourceQuotaAlertThresholdsEndpoint(userInfoGetter provider.UserInfoGetter, provider provider.ResourceQuotaProvider) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		if !userInfo.IsAdmin {
+			return nil, apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%s doesn't have admin rights", userInfo.Email))
+		}
+
+		err = putResourceQuotaAlertThresholds(ctx, req, provider)
+		if err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+}
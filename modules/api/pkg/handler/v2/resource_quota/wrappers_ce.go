@@ -56,6 +56,10 @@ func deleteResourceQuota(_ context.Context, _ interface{}, _ provider.ResourceQu
 	return nil
 }
 
+func putResourceQuotaAlertThresholds(_ context.Context, _ interface{}, _ provider.ResourceQuotaProvider) error {
+	return nil
+}
+
 func DecodeResourceQuotasReq(_ context.Context, _ *http.Request) (interface{}, error) {
 	return nil, nil
 }
@@ -72,6 +76,10 @@ func DecodePutResourceQuotasReq(_ context.Context, _ *http.Request) (interface{}
 	return nil, nil
 }
 
+func DecodePutResourceQuotaAlertThresholdsReq(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
 func DecodeCalculateProjectResourceQuotaUpdateReq(_ context.Context, _ *http.Request) (interface{}, error) {
 	return nil, nil
 }
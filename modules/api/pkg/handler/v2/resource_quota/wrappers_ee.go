@@ -59,6 +59,10 @@ func deleteResourceQuota(ctx context.Context, request interface{}, provider prov
 	return resourcequota.DeleteResourceQuota(ctx, request, provider)
 }
 
+func putResourceQuotaAlertThresholds(ctx context.Context, request interface{}, provider provider.ResourceQuotaProvider) error {
+	return resourcequota.PutResourceQuotaAlertThresholds(ctx, request, provider)
+}
+
 func DecodeResourceQuotasReq(_ context.Context, r *http.Request) (interface{}, error) {
 	return resourcequota.DecodeResourceQuotaReq(r)
 }
@@ -75,6 +79,10 @@ func DecodePutResourceQuotasReq(_ context.Context, r *http.Request) (interface{}
 	return resourcequota.DecodePutResourceQuotaReq(r)
 }
 
+func DecodePutResourceQuotaAlertThresholdsReq(_ context.Context, r *http.Request) (interface{}, error) {
+	return resourcequota.DecodePutResourceQuotaAlertThresholdsReq(r)
+}
+
 func DecodeCalculateProjectResourceQuotaUpdateReq(c context.Context, r *http.Request) (interface{}, error) {
 	return resourcequota.DecodeCalculateProjectResourceQuotaUpdateReq(c, r)
 }
@@ -25,8 +25,12 @@ import (
 
 	"k8c.io/dashboard/v2/pkg/handler"
 	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	projectv1 "k8c.io/dashboard/v2/pkg/handler/v1/project"
+	"k8c.io/dashboard/v2/pkg/handler/v1/ssh"
+	userv1 "k8c.io/dashboard/v2/pkg/handler/v1/user"
 	"k8c.io/dashboard/v2/pkg/handler/v2/addon"
 	"k8c.io/dashboard/v2/pkg/handler/v2/alertmanager"
 	allowedregistry "k8c.io/dashboard/v2/pkg/handler/v2/allowed_registry"
@@ -36,6 +40,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v2/authflow"
 	"k8c.io/dashboard/v2/pkg/handler/v2/backupcredentials"
 	"k8c.io/dashboard/v2/pkg/handler/v2/backupdestinations"
+	"k8c.io/dashboard/v2/pkg/handler/v2/chargeback"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	clusterdefault "k8c.io/dashboard/v2/pkg/handler/v2/cluster_default"
 	clustertemplate "k8c.io/dashboard/v2/pkg/handler/v2/cluster_template"
@@ -47,6 +52,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v2/cniversion"
 	"k8c.io/dashboard/v2/pkg/handler/v2/constraint"
 	constrainttemplate "k8c.io/dashboard/v2/pkg/handler/v2/constraint_template"
+	"k8c.io/dashboard/v2/pkg/handler/v2/cost"
 	"k8c.io/dashboard/v2/pkg/handler/v2/etcdbackupconfig"
 	"k8c.io/dashboard/v2/pkg/handler/v2/etcdrestore"
 	externalcluster "k8c.io/dashboard/v2/pkg/handler/v2/external_cluster"
@@ -62,16 +68,19 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v2/networkdefaults"
 	operatingsystemprofile "k8c.io/dashboard/v2/pkg/handler/v2/operatingsystemprofile"
 	"k8c.io/dashboard/v2/pkg/handler/v2/preset"
+	projectapply "k8c.io/dashboard/v2/pkg/handler/v2/project_apply"
 	"k8c.io/dashboard/v2/pkg/handler/v2/provider"
 	resourcequota "k8c.io/dashboard/v2/pkg/handler/v2/resource_quota"
 	"k8c.io/dashboard/v2/pkg/handler/v2/rulegroup"
 	rulegroupadmin "k8c.io/dashboard/v2/pkg/handler/v2/rulegroup_admin"
 	"k8c.io/dashboard/v2/pkg/handler/v2/seedoverview"
 	"k8c.io/dashboard/v2/pkg/handler/v2/seedsettings"
+	upgradeworkflow "k8c.io/dashboard/v2/pkg/handler/v2/upgrade_workflow"
 	"k8c.io/dashboard/v2/pkg/handler/v2/user"
 	userclusterconfig "k8c.io/dashboard/v2/pkg/handler/v2/user_cluster_config"
 	"k8c.io/dashboard/v2/pkg/handler/v2/version"
 	"k8c.io/dashboard/v2/pkg/handler/v2/webterminal"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 )
 
 // RegisterV2 declares all router paths for v2.
@@ -244,6 +253,10 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/health").
 		Handler(r.getClusterHealth())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/health/history").
+		Handler(r.getClusterHealthHistory())
+
 	mux.Methods(http.MethodPost).
 		Path("/projects/{project_id}/clusters/{cluster_id}/externalccmmigration").
 		Handler(r.migrateClusterToExternalCCM())
@@ -280,6 +293,22 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades").
 		Handler(r.getClusterUpgrades())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades/plan").
+		Handler(r.getClusterUpgradePlan())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades/workflow").
+		Handler(r.createClusterUpgradeWorkflow())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades/workflow").
+		Handler(r.getClusterUpgradeWorkflow())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades/workflow/{action}").
+		Handler(r.controlClusterUpgradeWorkflow())
+
 	mux.Methods(http.MethodPut).
 		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades").
 		Handler(r.upgradeClusterNodeDeployments())
@@ -1164,6 +1193,7 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 			middleware.SetSeedsGetter(r.seedsGetter)).
 		Middlewares(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		).
@@ -1258,10 +1288,26 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/presets/{preset_name}/linkages").
 		Handler(r.getPresetLinkages())
 
+	mux.Methods(http.MethodGet).
+		Path("/presets/{preset_name}/status").
+		Handler(r.getPresetStatus())
+
 	mux.Methods(http.MethodPut).
 		Path("/presets/{preset_name}/status").
 		Handler(r.updatePresetStatus())
 
+	mux.Methods(http.MethodPost).
+		Path("/presets/{preset_name}/validate").
+		Handler(r.validatePresetCredentials())
+
+	mux.Methods(http.MethodPost).
+		Path("/presets/{preset_name}/provider/{provider_name}/rotate").
+		Handler(r.rotatePresetCredentials())
+
+	mux.Methods(http.MethodPost).
+		Path("/presets/{preset_name}/provider/{provider_name}/rotate/rollback").
+		Handler(r.rollbackPresetCredentials())
+
 	mux.Methods(http.MethodDelete).
 		Path("/presets/{preset_name}/provider/{provider_name}").
 		Handler(r.deletePresetProvider())
@@ -1638,6 +1684,19 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/quotacalculation").
 		Handler(r.calculateProjectResourceQuotaUpdate())
 
+	// Defines endpoints to estimate the cost of clusters
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/costestimation").
+		Handler(r.estimateClusterCost())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/cost").
+		Handler(r.getProjectCost())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/cost").
+		Handler(r.getClusterCost())
+
 	mux.Methods(http.MethodGet).
 		Path("/quotas/{quota_name}").
 		Handler(r.getResourceQuota())
@@ -1654,10 +1713,31 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/quotas/{quota_name}").
 		Handler(r.putResourceQuota())
 
+	mux.Methods(http.MethodPut).
+		Path("/quotas/{quota_name}/alertthresholds").
+		Handler(r.putResourceQuotaAlertThresholds())
+
 	mux.Methods(http.MethodDelete).
 		Path("/quotas/{quota_name}").
 		Handler(r.deleteResourceQuota())
 
+	// Defines endpoints to manage the chargeback attributes and break down the costs by them
+	mux.Methods(http.MethodGet).
+		Path("/chargeback/schema").
+		Handler(r.getChargebackSchema())
+
+	mux.Methods(http.MethodPut).
+		Path("/chargeback/schema").
+		Handler(r.updateChargebackSchema())
+
+	mux.Methods(http.MethodGet).
+		Path("/chargeback/metering").
+		Handler(r.getChargebackMetering())
+
+	mux.Methods(http.MethodGet).
+		Path("/chargeback/quotas").
+		Handler(r.getChargebackQuotas())
+
 	// Defines endpoints to interact with group project bindings
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/groupbindings").
@@ -1679,6 +1759,24 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/groupbindings/{binding_name}").
 		Handler(r.patchGroupProjectBinding())
 
+	// Defines an endpoint to apply a declarative bundle of project resources
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/apply").
+		Handler(r.applyProjectBundle())
+
+	// Defines endpoints to export, import and clone projects
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/export").
+		Handler(r.exportProject())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/import").
+		Handler(r.importProject())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clone").
+		Handler(r.cloneProject())
+
 	// Defines endpoints to manage IPAM pools
 	mux.Methods(http.MethodGet).
 		Path("/seeds/{seed_name}/ipampools").
@@ -1794,7 +1892,9 @@ func (r Routing) listProjectAWSSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(provider.ListProjectAWSSizes(r.userInfoGetter, r.settingsProvider, r.seedsGetter)),
 		provider.DecodeProjectAWSSizesReq,
 		handler.EncodeJSON,
@@ -1816,6 +1916,7 @@ func (r Routing) listProjectAWSSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.ListProjectAWSSubnets(r.userInfoGetter, r.seedsGetter, r.presetProvider)),
 		provider.DecodeProjectAWSDCReq,
@@ -1838,6 +1939,7 @@ func (r Routing) listProjectAWSVPCs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.ListProjectAWSVPCs(r.userInfoGetter, r.seedsGetter, r.presetProvider)),
 		provider.DecodeProjectAWSDCReq,
@@ -1860,6 +1962,7 @@ func (r Routing) listProjectAWSSecurityGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.ListProjectAWSSecurityGroups(r.userInfoGetter, r.seedsGetter, r.presetProvider)),
 		provider.DecodeProjectAWSDCReq,
@@ -1885,8 +1988,9 @@ func (r Routing) listProjectGCPDiskTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.ListProjectGCPDiskTypes(r.presetProvider, r.userInfoGetter)),
+		)(provider.ListProjectGCPDiskTypes(r.presetProvider, r.userInfoGetter, r.providerCache)),
 		provider.DecodeProjectGCPDisktypes,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -1907,8 +2011,9 @@ func (r Routing) listProjectGCPSubnetworks() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.ListProjectGCPSubnetworks(r.presetProvider, r.userInfoGetter, r.seedsGetter)),
+		)(provider.ListProjectGCPSubnetworks(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.providerCache)),
 		provider.DecodeProjectGCPSubnetworks,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -1929,8 +2034,9 @@ func (r Routing) listProjectGCPNetworks() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.ListProjectGCPNetworks(r.presetProvider, r.userInfoGetter)),
+		)(provider.ListProjectGCPNetworks(r.presetProvider, r.userInfoGetter, r.providerCache)),
 		provider.DecodeProjectGCPCommonReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -1951,8 +2057,9 @@ func (r Routing) listProjectGCPZones() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.ListProjectGCPZones(r.presetProvider, r.userInfoGetter, r.seedsGetter)),
+		)(provider.ListProjectGCPZones(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.providerCache)),
 		provider.DecodeProjectGCPZones,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -1973,8 +2080,10 @@ func (r Routing) listProjectGCPVMSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.ListProjectGCPVMSizes(r.presetProvider, r.userInfoGetter, r.settingsProvider, r.seedsGetter)),
+			middleware.Paginate(),
+		)(provider.ListProjectGCPVMSizes(r.presetProvider, r.userInfoGetter, r.settingsProvider, r.seedsGetter, r.providerCache)),
 		provider.DecodeProjectGCPVMSizes,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -2000,11 +2109,13 @@ func (r Routing) createCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter,
-			r.presetProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.caBundle, r.kubermaticConfigGetter, r.features)),
+			r.presetProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.caBundle, r.kubermaticConfigGetter, r.features, r.chargebackSchemaProvider)),
 		cluster.DecodeCreateReq,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
 		r.defaultServerOptions()...,
@@ -2027,6 +2138,7 @@ func (r Routing) listClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(cluster.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
 		cluster.DecodeListClustersReq,
@@ -2051,6 +2163,7 @@ func (r Routing) getCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2078,7 +2191,9 @@ func (r Routing) deleteCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -2104,7 +2219,9 @@ func (r Routing) patchCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.kubermaticConfigGetter, r.features, r.settingsProvider)),
@@ -2131,6 +2248,7 @@ func (r Routing) getClusterEvents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2157,6 +2275,7 @@ func (r Routing) getClusterHealth() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2167,6 +2286,35 @@ func (r Routing) getClusterHealth() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/health/history project getClusterHealthHistoryV2
+//
+//	Returns the uptime of the cluster's components in time windows ending now.
+//
+//	The health is sampled by every API replica and kept in its memory only. The history is best-effort: it starts over when the replica restarts and may differ between the replicas serving the requests.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterHealthHistory
+//	  401: empty
+//	  403: empty
+func (r Routing) getClusterHealthHistory() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.HealthHistoryEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.healthHistory)),
+		cluster.DecodeHealthHistoryReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // getClusterKubeconfig returns the kubeconfig for the cluster.
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/kubeconfig project getClusterKubeconfigV2
 //
@@ -2184,7 +2332,9 @@ func (r Routing) getClusterHealth() http.Handler {
 func (r Routing) getClusterKubeconfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
+			middleware.TokenOperation(authtypes.AdminKubeconfigTokenOperation),
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2212,6 +2362,7 @@ func (r Routing) getOidcClusterKubeconfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2239,6 +2390,7 @@ func (r Routing) getClusterOidc() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2265,6 +2417,7 @@ func (r Routing) getClusterMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2291,6 +2444,7 @@ func (r Routing) listNamespace() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2317,6 +2471,7 @@ func (r Routing) getClusterUpgrades() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2327,6 +2482,185 @@ func (r Routing) getClusterUpgrades() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades/plan project getClusterUpgradePlanV2
+//
+//	Runs the preflight checks of an upgrade to the given version and returns the ordered upgrade steps.
+//	Nothing is changed in the cluster.
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ClusterUpgradePlan
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterUpgradePlan() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		)(cluster.GetUpgradePlanEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeUpgradePlanReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades/workflow project createClusterUpgradeWorkflow
+//
+//	Plans the upgrade of the cluster to the given version and runs it in stages, first the control plane and then
+//	the machine deployments. A stage starts when the cluster became healthy after the previous one.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ClusterUpgradeWorkflow
+//	  401: empty
+//	  403: empty
+func (r Routing) createClusterUpgradeWorkflow() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(upgradeworkflow.CreateEndpoint(r.userInfoGetter, r.upgradeWorkflowOperations(), r.upgradeWorkflows)),
+		upgradeworkflow.DecodeCreateReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades/workflow project getClusterUpgradeWorkflow
+//
+//	Returns the progress and the events of the latest upgrade workflow of the cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterUpgradeWorkflow
+//	  401: empty
+//	  403: empty
+func (r Routing) getClusterUpgradeWorkflow() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(upgradeworkflow.GetEndpoint(r.upgradeWorkflowOperations(), r.upgradeWorkflows)),
+		upgradeworkflow.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades/workflow/{action} project controlClusterUpgradeWorkflow
+//
+//	Pauses, resumes or aborts the upgrade workflow of the cluster. A paused workflow completes its running stage,
+//	an aborted one stops immediately. The upgrades which were already done are kept.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterUpgradeWorkflow
+//	  401: empty
+//	  403: empty
+func (r Routing) controlClusterUpgradeWorkflow() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(upgradeworkflow.ActionEndpoint(r.userInfoGetter, r.upgradeWorkflowOperations(), r.upgradeWorkflows)),
+		upgradeworkflow.DecodeActionReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// upgradeWorkflowOperations returns the endpoints used to plan and run cluster upgrades. The user was already
+// verified by the workflow endpoints or is the one who started the workflow, so only the middlewares providing
+// the clusters and addons are chained. The upgrades are audited like the requests of the user.
+func (r Routing) upgradeWorkflowOperations() upgradeworkflow.Operations {
+	clusterProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+	)
+	auditedClusterProviders := endpoint.Chain(
+		middleware.Audit(r.auditLogger, r.userInfoGetter),
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+	)
+	addonProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+	)
+
+	return upgradeworkflow.Operations{
+		GetUpgradePlan: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades/plan",
+			Decode:   cluster.DecodeUpgradePlanReq,
+			Endpoint: addonProviders(cluster.GetUpgradePlanEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		},
+		GetCluster: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}",
+			Decode:   cluster.DecodeGetClusterReq,
+			Endpoint: clusterProviders(cluster.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
+		},
+		PatchCluster: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}",
+			Decode:   cluster.DecodePatchReq,
+			Endpoint: auditedClusterProviders(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.kubermaticConfigGetter, r.features, r.settingsProvider)),
+		},
+		GetClusterHealth: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/health",
+			Decode:   cluster.DecodeGetClusterReq,
+			Endpoint: clusterProviders(cluster.HealthEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		},
+
+		GetMachineDeployment: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}",
+			Decode:   machine.DecodeGetMachineDeployment,
+			Endpoint: clusterProviders(machine.GetMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		},
+		ListMachineDeploymentNodes: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes",
+			Decode:   machine.DecodeListMachineDeploymentNodes,
+			Endpoint: clusterProviders(machine.ListMachineDeploymentNodes(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		},
+		PatchMachineDeployment: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}",
+			Decode:   machine.DecodePatchMachineDeployment,
+			Endpoint: auditedClusterProviders(machine.PatchMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
+		},
+	}
+}
+
 // swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades project upgradeClusterNodeDeploymentsV2
 //
 //	Upgrades node deployments in a cluster
@@ -2343,7 +2677,9 @@ func (r Routing) upgradeClusterNodeDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.UpgradeNodeDeploymentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -2372,7 +2708,9 @@ func (r Routing) assignSSHKeyToCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.AssignSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -2401,7 +2739,9 @@ func (r Routing) detachSSHKeyFromCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.DetachSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -2431,6 +2771,7 @@ func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2460,7 +2801,9 @@ func (r Routing) createExternalCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(externalcluster.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.presetProvider)),
 		externalcluster.DecodeCreateReq,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
@@ -2485,7 +2828,9 @@ func (r Routing) deleteExternalCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(externalcluster.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeDeleteReq,
 		handler.EncodeJSON,
@@ -2509,7 +2854,9 @@ func (r Routing) listExternalClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(externalcluster.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeListReq,
 		handler.EncodeJSON,
@@ -2534,6 +2881,7 @@ func (r Routing) getExternalCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -2559,7 +2907,9 @@ func (r Routing) patchExternalCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(externalcluster.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodePatchReq,
 		handler.EncodeJSON,
@@ -2583,7 +2933,9 @@ func (r Routing) updateExternalCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(externalcluster.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeUpdateReq,
 		handler.EncodeJSON,
@@ -2608,6 +2960,7 @@ func (r Routing) getExternalClusterUpgrades() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -2633,6 +2986,7 @@ func (r Routing) listExternalClusterMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeListMachineDeploymentReq,
@@ -2658,7 +3012,9 @@ func (r Routing) createExternalClusterMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(externalcluster.CreateMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeCreateMachineDeploymentReq,
 		handler.EncodeJSON,
@@ -2683,7 +3039,9 @@ func (r Routing) deleteExternalClusterMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(externalcluster.DeleteMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeGetMachineDeploymentReq,
 		handler.EncodeJSON,
@@ -2708,6 +3066,7 @@ func (r Routing) listExternalClusterNodes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListNodesEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeListNodesReq,
@@ -2733,6 +3092,7 @@ func (r Routing) getExternalClusterNode() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GetNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetNodeReq,
@@ -2758,6 +3118,7 @@ func (r Routing) getExternalClusterMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GetMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -2783,6 +3144,7 @@ func (r Routing) listExternalClusterNodesMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListNodesMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeListNodesReq,
@@ -2808,6 +3170,7 @@ func (r Routing) listExternalClusterEvents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEventsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeListEventsReq,
@@ -2833,6 +3196,7 @@ func (r Routing) listConstraintTemplates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(constrainttemplate.ListEndpoint(r.constraintTemplateProvider)),
 		common.DecodeEmptyReq,
@@ -2858,6 +3222,7 @@ func (r Routing) getConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(constrainttemplate.GetEndpoint(r.constraintTemplateProvider)),
 		constrainttemplate.DecodeConstraintTemplateRequest,
@@ -2885,7 +3250,9 @@ func (r Routing) createConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(constrainttemplate.CreateEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
 		constrainttemplate.DecodeCreateConstraintTemplateRequest,
 		handler.EncodeJSON,
@@ -2912,7 +3279,9 @@ func (r Routing) patchConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(constrainttemplate.PatchEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
 		constrainttemplate.DecodePatchConstraintTemplateReq,
 		handler.EncodeJSON,
@@ -2936,7 +3305,9 @@ func (r Routing) deleteConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(constrainttemplate.DeleteEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
 		constrainttemplate.DecodeConstraintTemplateRequest,
 		handler.EncodeJSON,
@@ -2960,6 +3331,7 @@ func (r Routing) listConstraints() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -2988,6 +3360,7 @@ func (r Routing) getConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3016,7 +3389,9 @@ func (r Routing) deleteConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
@@ -3045,7 +3420,9 @@ func (r Routing) createConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
@@ -3073,7 +3450,9 @@ func (r Routing) createDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(constraint.CreateDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
 		constraint.DecodeCreateDefaultConstraintReq,
 		handler.EncodeJSON,
@@ -3098,6 +3477,7 @@ func (r Routing) listDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(constraint.ListDefaultEndpoint(r.defaultConstraintProvider)),
 		common.DecodeEmptyReq,
@@ -3123,6 +3503,7 @@ func (r Routing) getDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(constraint.GetDefaultEndpoint(r.defaultConstraintProvider)),
 		constraint.DecodeDefaultConstraintReq,
@@ -3148,7 +3529,9 @@ func (r Routing) deleteDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(constraint.DeleteDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider)),
 		constraint.DecodeDefaultConstraintReq,
 		handler.EncodeJSON,
@@ -3175,7 +3558,9 @@ func (r Routing) patchDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(constraint.PatchDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
 		constraint.DecodePatchDefaultConstraintReq,
 		handler.EncodeJSON,
@@ -3200,7 +3585,9 @@ func (r Routing) patchConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
@@ -3229,6 +3616,7 @@ func (r Routing) getGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3256,7 +3644,9 @@ func (r Routing) deleteGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(gatekeeperconfig.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -3285,7 +3675,9 @@ func (r Routing) createGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(gatekeeperconfig.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -3312,7 +3704,9 @@ func (r Routing) patchGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(gatekeeperconfig.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -3341,7 +3735,9 @@ func (r Routing) createMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(machine.CreateMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
@@ -3368,7 +3764,9 @@ func (r Routing) deleteMachineDeploymentNode() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(machine.DeleteMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3394,6 +3792,7 @@ func (r Routing) listMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3420,6 +3819,7 @@ func (r Routing) getMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3446,6 +3846,7 @@ func (r Routing) getMachineDeploymentJoinScript() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3472,9 +3873,11 @@ func (r Routing) listMachineDeploymentNodes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Paginate(),
 		)(machine.ListMachineDeploymentNodes(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		machine.DecodeListMachineDeploymentNodes,
 		handler.EncodeJSON,
@@ -3498,9 +3901,11 @@ func (r Routing) listNodesForCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Paginate(),
 		)(machine.ListNodesForCluster(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		machine.DecodeListNodesForCluster,
 		handler.EncodeJSON,
@@ -3524,6 +3929,7 @@ func (r Routing) listMachineDeploymentMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3554,7 +3960,9 @@ func (r Routing) patchMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(machine.PatchMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
@@ -3583,7 +3991,9 @@ func (r Routing) restartMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(machine.RestartMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3610,6 +4020,7 @@ func (r Routing) listMachineDeploymentNodesEvents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3636,7 +4047,9 @@ func (r Routing) deleteMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(machine.DeleteMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3662,6 +4075,7 @@ func (r Routing) listClusterRole() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListClusterRoleEndpoint(r.userInfoGetter)),
@@ -3687,6 +4101,7 @@ func (r Routing) listClusterRoleNames() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListClusterRoleNamesEndpoint(r.userInfoGetter)),
@@ -3712,6 +4127,7 @@ func (r Routing) listRole() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListRoleEndpoint(r.userInfoGetter)),
@@ -3737,6 +4153,7 @@ func (r Routing) listRoleNames() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListRoleNamesEndpoint(r.userInfoGetter)),
@@ -3765,7 +4182,9 @@ func (r Routing) bindUserToRole() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.BindUserToRoleEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3794,7 +4213,9 @@ func (r Routing) bindUserToClusterRole() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.BindUserToClusterRoleEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3823,7 +4244,9 @@ func (r Routing) unbindUserFromRoleBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.UnbindUserFromRoleBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3852,7 +4275,9 @@ func (r Routing) unbindUserFromClusterRoleBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.UnbindUserFromClusterRoleBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3879,6 +4304,7 @@ func (r Routing) listClusterRoleBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3906,6 +4332,7 @@ func (r Routing) listRoleBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3932,6 +4359,7 @@ func (r Routing) listInstallableAddons() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -3963,7 +4391,9 @@ func (r Routing) createAddon() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
@@ -3991,6 +4421,7 @@ func (r Routing) listAddons() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4019,6 +4450,7 @@ func (r Routing) getAddon() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4050,7 +4482,9 @@ func (r Routing) patchAddon() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
@@ -4078,7 +4512,9 @@ func (r Routing) deleteAddon() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
@@ -4104,9 +4540,11 @@ func (r Routing) listAWSSizesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Paginate(),
 		)(provider.AWSSizeNoCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.settingsProvider, r.userInfoGetter)),
 		provider.DecodeAWSSizeNoCredentialsReq,
 		handler.EncodeJSON,
@@ -4128,6 +4566,7 @@ func (r Routing) listAWSSubnetsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4152,11 +4591,13 @@ func (r Routing) listGCPSizesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.GCPSizeWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
-		provider.DecodeGCPTypesNoCredentialReq,
+			middleware.Paginate(),
+		)(provider.GCPSizeWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.providerCache)),
+		provider.DecodeGCPSizesNoCredentialReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -4176,10 +4617,11 @@ func (r Routing) listGCPDiskTypesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.GCPDiskTypesWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(provider.GCPDiskTypesWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.providerCache)),
 		provider.DecodeGCPTypesNoCredentialReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4200,10 +4642,11 @@ func (r Routing) listGCPZonesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.GCPZoneWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		)(provider.GCPZoneWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.providerCache)),
 		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4224,10 +4667,11 @@ func (r Routing) listGCPNetworksNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.GCPNetworkWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(provider.GCPNetworkWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.providerCache)),
 		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4248,10 +4692,11 @@ func (r Routing) listGCPSubnetworksNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.GCPSubnetworkWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		)(provider.GCPSubnetworkWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.providerCache)),
 		provider.DecodeGCPSubnetworksNoCredentialReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4274,7 +4719,9 @@ func (r Routing) revokeClusterAdminToken() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.RevokeAdminTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4300,7 +4747,9 @@ func (r Routing) revokeClusterViewerToken() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.RevokeViewerTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4324,6 +4773,7 @@ func (r Routing) listHetznerSizesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4348,6 +4798,7 @@ func (r Routing) listDigitaloceanSizesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4372,12 +4823,14 @@ func (r Routing) listOpenstackSizesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Paginate(),
 		)(provider.OpenstackSizeWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider,
-			r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.caBundle)),
-		provider.DecodeOpenstackNoCredentialsReq,
+			r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.caBundle, r.providerCache)),
+		provider.DecodeOpenstackSizesNoCredentialsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -4397,6 +4850,7 @@ func (r Routing) listOpenstackTenantsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4422,11 +4876,12 @@ func (r Routing) listOpenstackNetworksNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(provider.OpenstackNetworkWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter,
-			r.userInfoGetter, r.caBundle)),
+			r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeOpenstackNoCredentialsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4447,11 +4902,12 @@ func (r Routing) listOpenstackSecurityGroupsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(provider.OpenstackSecurityGroupWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider,
-			r.seedsGetter, r.userInfoGetter, r.caBundle)),
+			r.seedsGetter, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeOpenstackNoCredentialsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4472,6 +4928,7 @@ func (r Routing) listOpenstackServerGroupsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4497,6 +4954,7 @@ func (r Routing) listOpenstackServerGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackServerGroupEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, false)),
 		provider.DecodeOpenstackReq,
@@ -4519,11 +4977,12 @@ func (r Routing) listOpenstackSubnetsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(provider.OpenstackSubnetsWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter,
-			r.userInfoGetter, r.caBundle)),
+			r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeOpenstackSubnetNoCredentialsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4544,6 +5003,7 @@ func (r Routing) listOpenstackAvailabilityZonesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4569,6 +5029,7 @@ func (r Routing) listOpenstackImagesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4594,6 +5055,7 @@ func (r Routing) listOpenstackSubnetPools() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackSubnetPoolEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, false)),
 		provider.DecodeOpenstackSubnetPoolReq,
@@ -4616,10 +5078,12 @@ func (r Routing) listAzureSizesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.AzureSizeWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
+			middleware.Paginate(),
+		)(provider.AzureSizeWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.providerCache)),
 		provider.DecodeAzureSizesNoCredentialsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4640,6 +5104,7 @@ func (r Routing) listAzureAvailabilityZonesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4664,10 +5129,11 @@ func (r Routing) listVSphereNetworksNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.VsphereNetworksWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle)),
+		)(provider.VsphereNetworksWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeVSphereNoCredentialsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4688,10 +5154,11 @@ func (r Routing) listVSphereFoldersNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(provider.VsphereFoldersWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle)),
+		)(provider.VsphereFoldersWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeVSphereNoCredentialsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -4712,6 +5179,7 @@ func (r Routing) listVSphereVMGroupsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4736,6 +5204,7 @@ func (r Routing) listVSphereTagCategoriesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4760,6 +5229,7 @@ func (r Routing) listVSphereTagsForTagCategoryNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4784,6 +5254,7 @@ func (r Routing) listAlibabaInstanceTypesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4808,6 +5279,7 @@ func (r Routing) listAlibabaZonesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4832,6 +5304,7 @@ func (r Routing) listAlibabaVSwitchesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4856,6 +5329,7 @@ func (r Routing) listAnexiaVlansNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4880,6 +5354,7 @@ func (r Routing) listAnexiaTemplatesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4904,6 +5379,7 @@ func (r Routing) listAnexiaDiskTypesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4928,6 +5404,7 @@ func (r Routing) listKubeVirtInstancetypesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4952,6 +5429,7 @@ func (r Routing) listKubeVirtPreferencesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -4976,6 +5454,7 @@ func (r Routing) listKubevirtStorageClassesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5000,6 +5479,7 @@ func (r Routing) listKubeVirtVPCsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5024,6 +5504,7 @@ func (r Routing) listKubeVirtSubnetsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5048,6 +5529,7 @@ func (r Routing) listNutanixSubnetsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5072,6 +5554,7 @@ func (r Routing) listNutanixCategoriesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5096,6 +5579,7 @@ func (r Routing) listNutanixCategoryValuesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5120,6 +5604,7 @@ func (r Routing) listVMwareCloudDirectorNetworksNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5144,6 +5629,7 @@ func (r Routing) listVMwareCloudDirectorStorageProfilesNoCredentials() http.Hand
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5168,6 +5654,7 @@ func (r Routing) listVMwareCloudDirectorCatalogsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5192,6 +5679,7 @@ func (r Routing) listVMwareCloudDirectorComputePoliciesNoCredentials() http.Hand
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5216,6 +5704,7 @@ func (r Routing) listVMwareCloudDirectorTemplatesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5240,6 +5729,7 @@ func (r Routing) listAzureSecurityGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureSecurityGroupsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		provider.DecodeAzureSecurityGroupsReq,
@@ -5262,6 +5752,7 @@ func (r Routing) listAzureResourceGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureResourceGroupsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		provider.DecodeAzureResourceGroupsReq,
@@ -5284,6 +5775,7 @@ func (r Routing) listAzureRouteTables() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureRouteTablesEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		provider.DecodeAzureRouteTablesReq,
@@ -5306,6 +5798,7 @@ func (r Routing) listAzureVnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureVirtualNetworksEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		provider.DecodeAzureVirtualNetworksReq,
@@ -5328,6 +5821,7 @@ func (r Routing) listVSphereDatastores() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VsphereDatastoreEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, false)),
 		provider.DecodeVSphereCommonReq,
@@ -5350,6 +5844,7 @@ func (r Routing) listVSphereVMGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VsphereVMGroupEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, false)),
 		provider.DecodeVSphereCommonReq,
@@ -5372,6 +5867,7 @@ func (r Routing) listAzureSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureSubnetsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		provider.DecodeAzureSubnetsReq,
@@ -5394,6 +5890,7 @@ func (r Routing) listNutanixClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixClusterEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeNutanixCommonReq,
@@ -5416,6 +5913,7 @@ func (r Routing) listNutanixProjects() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixProjectEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeNutanixCommonReq,
@@ -5438,6 +5936,7 @@ func (r Routing) listNutanixSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixSubnetEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeNutanixSubnetReq,
@@ -5460,6 +5959,7 @@ func (r Routing) listNutanixCategories() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixCategoryEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeNutanixCommonReq,
@@ -5482,6 +5982,7 @@ func (r Routing) listNutanixCategoryValues() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixCategoryValuesEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeNutanixCategoryValueReq,
@@ -5504,6 +6005,7 @@ func (r Routing) listVMwareCloudDirectorNetworks() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorNetworksEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeVMwareCloudDirectorCommonReq,
@@ -5526,6 +6028,7 @@ func (r Routing) listVMwareCloudDirectorStorageProfiles() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorStorageProfilesEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeVMwareCloudDirectorCommonReq,
@@ -5548,6 +6051,7 @@ func (r Routing) listVMwareCloudDirectorCatalogs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorCatalogsEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeVMwareCloudDirectorCommonReq,
@@ -5570,6 +6074,7 @@ func (r Routing) listVMwareCloudDirectorTemplates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorTemplatesEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, false)),
 		provider.DecodeVMwareCloudDirectorListTemplatesReq,
@@ -5595,6 +6100,7 @@ func (r Routing) listPresets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(preset.ListPresets(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeListPresets,
@@ -5623,7 +6129,9 @@ func (r Routing) updatePresetStatus() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(preset.UpdatePresetStatus(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeUpdatePresetStatus,
 		handler.EncodeJSON,
@@ -5631,6 +6139,118 @@ func (r Routing) updatePresetStatus() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/presets/{preset_name}/status preset getPresetStatus
+//
+//	Returns the results of the last checks of the credentials of the providers of a preset.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: PresetHealth
+//	  401: empty
+//	  403: empty
+func (r Routing) getPresetStatus() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(preset.GetPresetStatus(r.presetProvider, r.userInfoGetter, r.presetHealthChecker)),
+		preset.DecodePresetHealth,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/presets/{preset_name}/validate preset validatePresetCredentials
+//
+//	Validates the credentials of a preset.
+//
+//	Checks the credentials of the providers of the preset with an authenticated call to each provider and stores the results in the status of the preset.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: PresetHealth
+//	  401: empty
+//	  403: empty
+func (r Routing) validatePresetCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(preset.ValidatePresetCredentials(r.presetProvider, r.userInfoGetter, r.presetHealthChecker)),
+		preset.DecodePresetHealth,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/presets/{preset_name}/provider/{provider_name}/rotate preset rotatePresetCredentials
+//
+//	Rotates the credentials of a provider of a preset.
+//
+//	Checks the new credentials, replaces the credentials of the provider in the preset and updates the credentials of all clusters created with the preset. With dryRun the clusters which would be updated are only listed.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: PresetCredentialRotationReport
+//	  401: empty
+//	  403: empty
+func (r Routing) rotatePresetCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(preset.RotatePresetCredentials(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter, r.presetHealthChecker)),
+		preset.DecodeRotatePresetCredentials,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/presets/{preset_name}/provider/{provider_name}/rotate/rollback preset rollbackPresetCredentials
+//
+//	Rolls back the last rotation of the credentials of a provider of a preset.
+//
+//	Restores the credentials of the provider in the preset and of the clusters created with the preset from before the last rotation, also after the rotation succeeded.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: PresetCredentialRotationReport
+//	  401: empty
+//	  403: empty
+func (r Routing) rollbackPresetCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(preset.RollbackPresetCredentials(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter)),
+		preset.DecodeRollbackPresetCredentials,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route DELETE /api/v2/presets/{preset_name} preset deletePreset
 //
 //	    Removes preset.
@@ -5651,7 +6271,9 @@ func (r Routing) deletePreset() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(preset.DeletePreset(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeDeletePreset,
 		handler.EncodeJSON,
@@ -5679,7 +6301,9 @@ func (r Routing) deletePresetProvider() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(preset.DeletePresetProvider(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeDeletePresetProvider,
 		handler.EncodeJSON,
@@ -5704,6 +6328,7 @@ func (r Routing) listProviderPresets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(preset.ListProviderPresets(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeListProviderPresets,
@@ -5729,6 +6354,7 @@ func (r Routing) listProjectPresets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(preset.ListProjectPresets(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeListProjectPresets,
@@ -5754,6 +6380,7 @@ func (r Routing) listProjectProviderPresets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(preset.ListProjectProviderPresets(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeListProjectProviderPresets,
@@ -5781,7 +6408,9 @@ func (r Routing) createPreset() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(preset.CreatePreset(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeCreatePreset,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
@@ -5808,7 +6437,9 @@ func (r Routing) updatePreset() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(preset.UpdatePreset(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeUpdatePreset,
 		handler.EncodeJSON,
@@ -5839,7 +6470,9 @@ func (r Routing) deleteProviderPreset() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(preset.DeleteProviderPreset(r.presetProvider, r.userInfoGetter)),
 		preset.DecodeDeleteProviderPreset,
 		handler.EncodeJSON,
@@ -5864,6 +6497,7 @@ func (r Routing) getPresetStats() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(preset.GetPresetStats(r.presetProvider, r.userInfoGetter, r.clusterProviderGetter, r.seedsGetter, r.clusterTemplateProvider)),
 		preset.DecodeGetPresetStats,
@@ -5889,6 +6523,7 @@ func (r Routing) getPresetLinkages() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(preset.GetPresetLinkages(r.presetProvider, r.userInfoGetter, r.clusterProviderGetter, r.seedsGetter, r.clusterTemplateProvider, r.privilegedProjectProvider)),
 		preset.DecodeGetPresetLinkages,
@@ -5916,6 +6551,7 @@ func (r Routing) listVersionsByProvider() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(version.ListVersions(r.kubermaticConfigGetter)),
 		version.DecodeListProviderVersions,
@@ -5941,6 +6577,7 @@ func (r Routing) getAlertmanager() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -5972,7 +6609,9 @@ func (r Routing) updateAlertmanager() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Alertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
@@ -6001,7 +6640,9 @@ func (r Routing) resetAlertmanager() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Alertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
@@ -6027,6 +6668,7 @@ func (r Routing) listProjectGKEImages() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEImagesEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeGKEProjectVMReq,
@@ -6049,6 +6691,7 @@ func (r Routing) listProjectGKEZones() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEZonesEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeGKEProjectCommonReq,
@@ -6071,9 +6714,11 @@ func (r Routing) listProjectGKEVMSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(externalcluster.GKEVMSizesEndpoint(r.presetProvider, r.userInfoGetter, true)),
-		externalcluster.DecodeGKEProjectVMReq,
+		externalcluster.DecodeGKEProjectVMSizesReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -6093,6 +6738,7 @@ func (r Routing) listProjectGKEDiskTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEDiskTypesEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeGKEProjectVMReq,
@@ -6115,6 +6761,7 @@ func (r Routing) listProjectGKEVersions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEVersionsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeGKEProjectVersionsReq,
@@ -6137,6 +6784,7 @@ func (r Routing) validateProjectGKECredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEValidateCredentialsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeGKEProjectCommonReq,
@@ -6159,6 +6807,7 @@ func (r Routing) listTinkerbellImages() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.TinkerbellImagesEndpoint(r.userInfoGetter, r.seedsGetter)),
 		provider.DecodeTinkerbellListImageReq,
@@ -6181,6 +6830,7 @@ func (r Routing) validateProjectEKSCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSValidateCredentialsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeEKSProjectRegionReq,
@@ -6203,8 +6853,9 @@ func (r Routing) listProjectEKSVPCs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListEKSVPCEndpoint(r.userInfoGetter, r.presetProvider, true)),
+		)(externalcluster.ListEKSVPCEndpoint(r.userInfoGetter, r.presetProvider, true, r.providerCache)),
 		externalcluster.DecodeEKSProjectRegionReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -6225,8 +6876,9 @@ func (r Routing) listProjectEKSSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListEKSSubnetsEndpoint(r.userInfoGetter, r.presetProvider, true)),
+		)(externalcluster.ListEKSSubnetsEndpoint(r.userInfoGetter, r.presetProvider, true, r.providerCache)),
 		externalcluster.DecodeEKSProjectVPCReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -6249,6 +6901,7 @@ func (r Routing) listProjectEKSSecurityGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEKSSecurityGroupsEndpoint(r.userInfoGetter, r.presetProvider, true)),
 		externalcluster.DecodeEKSProjectVPCReq,
@@ -6273,6 +6926,7 @@ func (r Routing) listProjectEKSRegions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEKSRegionsEndpoint(r.userInfoGetter, r.presetProvider, true)),
 		externalcluster.DecodeEKSProjectCommonReq,
@@ -6297,6 +6951,7 @@ func (r Routing) listProjectEKSClusterRoles() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEKSClusterRolesEndpoint(r.userInfoGetter, r.presetProvider, true)),
 		externalcluster.DecodeEKSProjectCommonReq,
@@ -6319,6 +6974,7 @@ func (r Routing) listProjectEKSVersions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSVersionsEndpoint(r.kubermaticConfigGetter, r.externalClusterProvider)),
 		externalcluster.DecodeEKSProjectReq,
@@ -6344,6 +7000,7 @@ func (r Routing) listProjectEKSAMITypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSAMITypesEndpoint()),
 		externalcluster.DecodeEKSProjectReq,
@@ -6369,6 +7026,7 @@ func (r Routing) listProjectEKSCapacityTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSCapacityTypesEndpoint()),
 		externalcluster.DecodeEKSProjectReq,
@@ -6391,6 +7049,7 @@ func (r Routing) listProjectKubeVirtInstancetypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtInstancetypesEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.settingsProvider, true)),
 		provider.DecodeKubeVirtProjectGenericReq,
@@ -6413,6 +7072,7 @@ func (r Routing) listProjectKubeVirtPreferences() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtPreferencesEndpoint(r.presetProvider, r.userInfoGetter, r.settingsProvider, r.seedsGetter, true)),
 		provider.DecodeKubeVirtProjectGenericReq,
@@ -6435,6 +7095,7 @@ func (r Routing) listProjectKubevirtStorageClasses() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtStorageClassesEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, true)),
 		provider.DecodeKubeVirtProjectGenericReq,
@@ -6457,6 +7118,7 @@ func (r Routing) listProjectKubevirtVPCs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtVPCsEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, true)),
 		provider.DecodeKubeVirtProjectGenericReq,
@@ -6479,6 +7141,7 @@ func (r Routing) listProjectKubevirtSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtSubnetsEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, true)),
 		provider.DecodeKubeVirtVPCSubnetsReq,
@@ -6501,6 +7164,7 @@ func (r Routing) listKubevirtImages() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtImagesEndpoint(r.userInfoGetter, r.seedsGetter)),
 		provider.DecodeKubeVirtListImageReq,
@@ -6523,8 +7187,10 @@ func (r Routing) listProjectAzureSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.AzureSizesEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.settingsProvider)),
+			middleware.Paginate(),
+		)(provider.AzureSizesEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.settingsProvider, r.providerCache)),
 		provider.DecodeAzureProjectSizesReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -6545,6 +7211,7 @@ func (r Routing) listProjectAzureSKUAvailabilityZones() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureAvailabilityZonesEndpoint(r.presetProvider, r.userInfoGetter)),
 		provider.DecodeAzureProjectAvailabilityZonesReq,
@@ -6567,6 +7234,7 @@ func (r Routing) listProjectAzureSecurityGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureSecurityGroupsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		provider.DecodeAzureProjectSecurityGroupsReq,
@@ -6589,6 +7257,7 @@ func (r Routing) listProjectAzureResourceGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureResourceGroupsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		provider.DecodeAzureProjectResourceGroupsReq,
@@ -6611,6 +7280,7 @@ func (r Routing) listProjectAzureRouteTables() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureRouteTablesEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		provider.DecodeAzureProjectRouteTablesReq,
@@ -6633,6 +7303,7 @@ func (r Routing) listProjectAzureVnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureVirtualNetworksEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		provider.DecodeAzureProjectVirtualNetworksReq,
@@ -6655,6 +7326,7 @@ func (r Routing) listProjectAzureSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AzureSubnetsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		provider.DecodeAzureProjectSubnetsReq,
@@ -6677,6 +7349,7 @@ func (r Routing) validateProjectAKSCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.AKSValidateCredentialsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeAKSProjectCommonReq,
@@ -6699,7 +7372,9 @@ func (r Routing) listProjectAKSVMSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(externalcluster.ListAKSVMSizesEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeAKSProjectVMSizesReq,
 		handler.EncodeJSON,
@@ -6721,6 +7396,7 @@ func (r Routing) listProjectAKSResourceGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListAKSResourceGroupsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeAKSProjectCommonReq,
@@ -6743,6 +7419,7 @@ func (r Routing) listProjectAKSLocations() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListAKSLocationsEndpoint(r.presetProvider, r.userInfoGetter, true)),
 		externalcluster.DecodeAKSProjectCommonReq,
@@ -6765,6 +7442,7 @@ func (r Routing) listProjectAKSVersions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.AKSVersionsEndpoint(r.kubermaticConfigGetter, r.externalClusterProvider)),
 		externalcluster.DecodeAKSProjectReq,
@@ -6787,8 +7465,9 @@ func (r Routing) listProjectVSphereNetworks() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.VsphereNetworksEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
+		)(provider.VsphereNetworksEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeVSphereProjectReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -6809,8 +7488,9 @@ func (r Routing) listProjectVSphereFolders() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.VsphereFoldersEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
+		)(provider.VsphereFoldersEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeVSphereProjectReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -6831,6 +7511,7 @@ func (r Routing) listProjectVSphereDatastores() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VsphereDatastoreEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, true)),
 		provider.DecodeVSphereProjectReq,
@@ -6853,6 +7534,7 @@ func (r Routing) listProjectVSphereVMGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VsphereVMGroupEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, true)),
 		provider.DecodeVSphereProjectReq,
@@ -6875,6 +7557,7 @@ func (r Routing) listProjectVSphereTagCategories() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VsphereTagCategoriesEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
 		provider.DecodeVSphereProjectReq,
@@ -6897,6 +7580,7 @@ func (r Routing) listProjectVSphereTagsForTagCategories() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VsphereTagForTagCategoryEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
 		provider.DecodeVSphereTagsReq,
@@ -6919,6 +7603,7 @@ func (r Routing) listProjectNutanixClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixClusterEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeNutanixProjectClusterReq,
@@ -6941,6 +7626,7 @@ func (r Routing) listProjectNutanixProjects() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixProjectEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeNutanixProjectProjectReq,
@@ -6963,6 +7649,7 @@ func (r Routing) listProjectNutanixSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixSubnetEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeNutanixProjectSubnetReq,
@@ -6985,6 +7672,7 @@ func (r Routing) listProjectNutanixCategories() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixCategoryEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeNutanixProjectCategoryReq,
@@ -7007,6 +7695,7 @@ func (r Routing) listProjectNutanixCategoryValues() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.NutanixCategoryValuesEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeNutanixProjectCategoryValueReq,
@@ -7029,6 +7718,7 @@ func (r Routing) listProjectVMwareCloudDirectorNetworks() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorNetworksEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeVMwareCloudDirectorProjectCommonReq,
@@ -7051,6 +7741,7 @@ func (r Routing) listProjectVMwareCloudDirectorStorageProfiles() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorStorageProfilesEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeVMwareCloudDirectorProjectCommonReq,
@@ -7073,6 +7764,7 @@ func (r Routing) listProjectVMwareCloudDirectorComputePolicies() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorComputePoliciesEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeVMwareCloudDirectorProjectCommonReq,
@@ -7095,6 +7787,7 @@ func (r Routing) listProjectVMwareCloudDirectorCatalogs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorCatalogsEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeVMwareCloudDirectorProjectCommonReq,
@@ -7117,6 +7810,7 @@ func (r Routing) listProjectVMwareCloudDirectorTemplates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.VMwareCloudDirectorTemplatesEndpoint(r.presetProvider, r.seedsGetter, r.userInfoGetter, true)),
 		provider.DecodeVMwareCloudDirectorProjectListTemplatesReq,
@@ -7139,6 +7833,7 @@ func (r Routing) listProjectHetznerSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.HetznerProjectSizeEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.settingsProvider)),
 		provider.DecodeHetznerProjectSizesReq,
@@ -7161,6 +7856,7 @@ func (r Routing) listProjectAlibabaInstanceTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AlibabaInstanceTypesEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.settingsProvider)),
 		provider.DecodeAlibabaProjectInstanceTypesReq,
@@ -7183,6 +7879,7 @@ func (r Routing) listProjectAlibabaZones() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AlibabaZonesEndpoint(r.presetProvider, r.userInfoGetter)),
 		provider.DecodeAlibabaProjectReq,
@@ -7205,6 +7902,7 @@ func (r Routing) listProjectAlibabaVSwitches() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AlibabaVSwitchesEndpoint(r.presetProvider, r.userInfoGetter)),
 		provider.DecodeAlibabaProjectReq,
@@ -7227,6 +7925,7 @@ func (r Routing) listProjectAnexiaVlans() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AnexiaProjectVlansEndpoint(r.presetProvider, r.userInfoGetter)),
 		provider.DecodeAnexiaProjectReq,
@@ -7249,6 +7948,7 @@ func (r Routing) listProjectAnexiaTemplates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AnexiaProjectTemplatesEndpoint(r.presetProvider, r.userInfoGetter)),
 		provider.DecodeAnexiaProjectTemplateReq,
@@ -7271,6 +7971,7 @@ func (r Routing) listProjectAnexiaDiskTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.AnexiaProjectDiskTypesEndpoint(r.presetProvider, r.userInfoGetter)),
 		provider.DecodeAnexiaProjectDiskTypeReq,
@@ -7293,6 +7994,7 @@ func (r Routing) listProjectDigitaloceanSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.DigitaloceanSizeEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.settingsProvider)),
 		provider.DecodeDoProjectSizesReq,
@@ -7315,9 +8017,11 @@ func (r Routing) listProjectOpenstackSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.OpenstackSizeEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.settingsProvider, r.caBundle)),
-		provider.DecodeOpenstackProjectReq,
+			middleware.Paginate(),
+		)(provider.OpenstackSizeEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.settingsProvider, r.caBundle, r.providerCache)),
+		provider.DecodeOpenstackProjectSizesReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -7337,6 +8041,7 @@ func (r Routing) listProjectOpenstackAvailabilityZones() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackAvailabilityZoneEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
 		provider.DecodeOpenstackProjectReq,
@@ -7359,8 +8064,9 @@ func (r Routing) listProjectOpenstackNetworks() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.OpenstackNetworkEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
+		)(provider.OpenstackNetworkEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeOpenstackProjectReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -7381,8 +8087,9 @@ func (r Routing) listProjectOpenstackSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.OpenstackSubnetsEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
+		)(provider.OpenstackSubnetsEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeOpenstackProjectSubnetReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -7403,8 +8110,9 @@ func (r Routing) listProjectOpenstackSecurityGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(provider.OpenstackSecurityGroupEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
+		)(provider.OpenstackSecurityGroupEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, r.providerCache)),
 		provider.DecodeOpenstackProjectReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -7425,6 +8133,7 @@ func (r Routing) listProjectOpenstackTenants() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackTenantEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
 		provider.DecodeOpenstackProjectTenantReq,
@@ -7447,6 +8156,7 @@ func (r Routing) listProjectOpenstackSubnetPools() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackSubnetPoolEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, true)),
 		provider.DecodeOpenstackProjectSubnetPoolReq,
@@ -7469,6 +8179,7 @@ func (r Routing) listProjectOpenstackServerGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackServerGroupEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle, true)),
 		provider.DecodeOpenstackProjectReq,
@@ -7491,6 +8202,7 @@ func (r Routing) listProjectOpenstackImages() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackImageEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
 		provider.DecodeOpenstackProjectReq,
@@ -7513,6 +8225,7 @@ func (r Routing) listProjectOpenstackMemberSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.OpenstackMemberSubnetsEndpoint(r.seedsGetter, r.presetProvider, r.userInfoGetter, r.caBundle)),
 		provider.DecodeOpenstackProjectMemberSubnetReq,
@@ -7537,6 +8250,7 @@ func (r Routing) getSeedSettings() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(seedsettings.GetSeedSettingsEndpoint(r.seedsGetter)),
 		seedsettings.DecodeGetSeedSettingsReq,
@@ -7564,7 +8278,9 @@ func (r Routing) createClusterTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(clustertemplate.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider, r.seedsGetter, r.presetProvider, r.caBundle, r.exposeStrategy, r.sshKeyProvider, r.kubermaticConfigGetter, r.features, r.settingsProvider)),
 		clustertemplate.DecodeCreateReq,
@@ -7592,7 +8308,9 @@ func (r Routing) importClusterTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(clustertemplate.ImportEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider, r.seedsGetter, r.presetProvider, r.caBundle, r.exposeStrategy, r.sshKeyProvider, r.kubermaticConfigGetter, r.features, r.settingsProvider)),
 		clustertemplate.DecodeImportReq,
@@ -7620,6 +8338,7 @@ func (r Routing) listClusterTemplates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(clustertemplate.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider)),
 		clustertemplate.DecodeListReq,
@@ -7647,6 +8366,7 @@ func (r Routing) getClusterTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(clustertemplate.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider)),
 		clustertemplate.DecodeGetReq,
@@ -7672,6 +8392,7 @@ func (r Routing) exportClusterTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(clustertemplate.ExportEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider)),
 		clustertemplate.DecodeExportReq,
@@ -7699,7 +8420,9 @@ func (r Routing) deleteClusterTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(clustertemplate.DeleteEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider)),
 		clustertemplate.DecodeGetReq,
 		handler.EncodeJSON,
@@ -7726,7 +8449,9 @@ func (r Routing) updateClusterTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(clustertemplate.UpdateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider, r.seedsGetter, r.presetProvider, r.caBundle, r.exposeStrategy, r.sshKeyProvider, r.kubermaticConfigGetter, r.features, r.settingsProvider)),
 		clustertemplate.DecodeUpdateReq,
@@ -7754,7 +8479,9 @@ func (r Routing) createClusterTemplateInstance() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(clustertemplate.CreateInstanceEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider, r.seedsGetter, r.clusterTemplateInstanceProviderGetter)),
 		clustertemplate.DecodeCreateInstanceReq,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
@@ -7778,6 +8505,7 @@ func (r Routing) getRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -7806,6 +8534,7 @@ func (r Routing) listRuleGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -7837,7 +8566,9 @@ func (r Routing) createRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.RuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
@@ -7868,7 +8599,9 @@ func (r Routing) updateRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.RuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
@@ -7896,7 +8629,9 @@ func (r Routing) deleteRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.RuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
@@ -7924,7 +8659,9 @@ func (r Routing) migrateClusterToExternalCCM() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.MigrateEndpointToExternalCCM(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
@@ -7953,7 +8690,9 @@ func (r Routing) createAllowedRegistry() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(allowedregistry.CreateEndpoint(r.userInfoGetter, r.privilegedAllowedRegistryProvider)),
 		allowedregistry.DecodeCreateAllowedRegistryRequest,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
@@ -7978,6 +8717,7 @@ func (r Routing) listAllowedRegistries() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(allowedregistry.ListEndpoint(r.userInfoGetter, r.privilegedAllowedRegistryProvider)),
 		common.DecodeEmptyReq,
@@ -8003,6 +8743,7 @@ func (r Routing) getAllowedRegistry() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(allowedregistry.GetEndpoint(r.userInfoGetter, r.privilegedAllowedRegistryProvider)),
 		allowedregistry.DecodeGetAllowedRegistryRequest,
@@ -8027,7 +8768,9 @@ func (r Routing) deleteAllowedRegistry() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(allowedregistry.DeleteEndpoint(r.userInfoGetter, r.privilegedAllowedRegistryProvider)),
 		allowedregistry.DecodeGetAllowedRegistryRequest,
 		handler.EncodeJSON,
@@ -8054,7 +8797,9 @@ func (r Routing) patchAllowedRegistry() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(allowedregistry.PatchEndpoint(r.userInfoGetter, r.privilegedAllowedRegistryProvider)),
 		allowedregistry.DecodePatchAllowedRegistryReq,
 		handler.EncodeJSON,
@@ -8067,7 +8812,9 @@ func (r Routing) createClusterBackup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(clusterbackup.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8081,6 +8828,7 @@ func (r Routing) listClusterBackup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -8095,6 +8843,7 @@ func (r Routing) getClusterBackup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -8109,7 +8858,9 @@ func (r Routing) deleteClusterBackup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(clusterbackup.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8135,7 +8886,9 @@ func (r Routing) clusterBackupDownloadURL() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(clusterbackup.DownloadURLEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8161,7 +8914,9 @@ func (r Routing) createBackupStorageLocation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(backupstoragelocation.CreateEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
 		backupstoragelocation.DecodeCreateBSLReq,
@@ -8185,6 +8940,7 @@ func (r Routing) createBackupStorageLocation() http.Handler {
 func (r Routing) listBackupStorageLocation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(backupstoragelocation.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8210,6 +8966,7 @@ func (r Routing) getBackupStorageLocation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(backupstoragelocation.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8235,7 +8992,9 @@ func (r Routing) deleteBackupStorageLocation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(backupstoragelocation.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
 		backupstoragelocation.DecodeDeleteBSLReq,
@@ -8249,7 +9008,9 @@ func (r Routing) createClusterRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterrestore.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
 		clusterrestore.DecodeCreateClusterRestoreReq,
@@ -8261,6 +9022,7 @@ func (r Routing) createClusterRestore() http.Handler {
 func (r Routing) listClusterRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterrestore.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8274,6 +9036,7 @@ func (r Routing) getClusterRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterrestore.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8287,7 +9050,9 @@ func (r Routing) deleteClusterRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterrestore.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
 		clusterrestore.DecodeDeleteClusterRestoreReq,
@@ -8301,7 +9066,9 @@ func (r Routing) createClusterBackupSchedule() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterbackupschedule.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
 		clusterbackupschedule.DecodeCreateClusterBackupScheduleReq,
@@ -8313,6 +9080,7 @@ func (r Routing) createClusterBackupSchedule() http.Handler {
 func (r Routing) listClusterBackupSchedule() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterbackupschedule.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8326,6 +9094,7 @@ func (r Routing) getClusterBackupSchedule() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterbackupschedule.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
@@ -8339,7 +9108,9 @@ func (r Routing) deleteClusterBackupSchedule() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter))(clusterbackupschedule.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
 		clusterbackupschedule.DecodeDeleteClusterBackupScheduleReq,
@@ -8364,6 +9135,7 @@ func (r Routing) listProjectCBSL() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(storagelocation.ListCBSLEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider)), storagelocation.DecodeListProjectCBSLReq, handler.EncodeJSON, r.defaultServerOptions()...,
 	)
@@ -8385,6 +9157,7 @@ func (r Routing) getCBSL() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(storagelocation.GetCBSLEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider)), storagelocation.DecodeGetCBSLReq, handler.EncodeJSON, r.defaultServerOptions()...,
 	)
@@ -8406,6 +9179,7 @@ func (r Routing) listCBSLBucketObjects() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(storagelocation.ListCBSLBucketObjectsEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider)), storagelocation.DecodeListCBSLBucketObjectsReq, handler.EncodeJSON, r.defaultServerOptions()...,
 	)
@@ -8427,6 +9201,7 @@ func (r Routing) getCBSLCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(storagelocation.GetCBSLCredentialsEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider)), storagelocation.DecodeGetCBSLCredentialsReq, handler.EncodeJSON, r.defaultServerOptions()...,
 	)
@@ -8451,7 +9226,9 @@ func (r Routing) createCBSL() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(storagelocation.CreateCBSLEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider, r.settingsProvider)), storagelocation.DecodeCreateCBSLReq, handler.EncodeJSON, r.defaultServerOptions()...,
 	)
 }
@@ -8469,7 +9246,9 @@ func (r Routing) deleteCBSL() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(storagelocation.DeleteCBSLEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider)), storagelocation.DecodeDeleteCBSLReq, handler.EncodeJSON, r.defaultServerOptions()...,
 	)
 }
@@ -8493,7 +9272,9 @@ func (r Routing) patchCBSL() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(storagelocation.PatchCBSLEndpoint(r.userInfoGetter, r.backupStorageProvider, r.projectProvider)), storagelocation.DecodePatchCBSLReq, handler.EncodeJSON, r.defaultServerOptions()...,
 	)
 }
@@ -8517,7 +9298,9 @@ func (r Routing) createEtcdBackupConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.EtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
@@ -8545,6 +9328,7 @@ func (r Routing) getEtcdBackupConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -8573,6 +9357,7 @@ func (r Routing) listEtcdBackupConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -8598,7 +9383,9 @@ func (r Routing) deleteEtcdBackupConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.EtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
@@ -8629,7 +9416,9 @@ func (r Routing) patchEtcdBackupConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.EtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
@@ -8657,6 +9446,7 @@ func (r Routing) listProjectEtcdBackupConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.EtcdBackupConfigProject(r.etcdBackupConfigProjectProviderGetter, r.seedsGetter),
 			middleware.PrivilegedEtcdBackupConfigProject(r.etcdBackupConfigProjectProviderGetter, r.seedsGetter),
@@ -8686,7 +9476,9 @@ func (r Routing) createEtcdRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.EtcdRestore(r.clusterProviderGetter, r.etcdRestoreProviderGetter, r.seedsGetter),
@@ -8714,6 +9506,7 @@ func (r Routing) getEtcdRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -8742,6 +9535,7 @@ func (r Routing) listEtcdRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -8768,7 +9562,9 @@ func (r Routing) deleteEtcdRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.EtcdRestore(r.clusterProviderGetter, r.etcdRestoreProviderGetter, r.seedsGetter),
@@ -8796,6 +9592,7 @@ func (r Routing) listProjectEtcdRestore() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.EtcdRestoreProject(r.etcdRestoreProjectProviderGetter, r.seedsGetter),
 			middleware.PrivilegedEtcdRestoreProject(r.etcdRestoreProjectProviderGetter, r.seedsGetter),
@@ -8825,7 +9622,9 @@ func (r Routing) createOrUpdateBackupCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.BackupCredentials(r.backupCredentialsProviderGetter, r.seedsGetter),
 		)(backupcredentials.CreateOrUpdateEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
 		backupcredentials.DecodeBackupCredentialsReq,
@@ -8850,6 +9649,7 @@ func (r Routing) getMLAAdminSetting() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -8880,7 +9680,9 @@ func (r Routing) createMLAAdminSetting() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
@@ -8910,7 +9712,9 @@ func (r Routing) updateMLAAdminSetting() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
@@ -8937,7 +9741,9 @@ func (r Routing) deleteMLAAdminSetting() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
@@ -8964,6 +9770,7 @@ func (r Routing) listUser() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(user.ListEndpoint(r.userInfoGetter, r.userProvider)),
 		common.DecodeEmptyReq,
@@ -8988,6 +9795,7 @@ func (r Routing) getFeatureGates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(featuregates.GetEndpoint(r.featureGatesProvider)),
 		common.DecodeEmptyReq,
@@ -9012,6 +9820,7 @@ func (r Routing) getAdmissionPluginsConfiguration() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(userclusterconfig.GetAdmissionPluginsConfiguration(r.userClusterConfigProvider)),
 		common.DecodeEmptyReq,
@@ -9036,7 +9845,9 @@ func (r Routing) updateAdmissionPluginsConfiguration() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(userclusterconfig.UpdateAdmissionPluginsConfiguration(r.userInfoGetter, r.userClusterConfigProvider)),
 		userclusterconfig.DecodeUpdateAdmissionPlugins,
 		handler.EncodeJSON,
@@ -9058,6 +9869,7 @@ func (r Routing) listGKEClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEClustersEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.presetProvider, false)),
 		externalcluster.DecodeGKEProjectCommonReq,
@@ -9080,6 +9892,7 @@ func (r Routing) listGKEImages() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEImagesEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeGKEVMReq,
@@ -9102,6 +9915,7 @@ func (r Routing) listGKEZones() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEZonesEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeGKECommonReq,
@@ -9124,9 +9938,11 @@ func (r Routing) listGKEVMSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(externalcluster.GKEVMSizesEndpoint(r.presetProvider, r.userInfoGetter, false)),
-		externalcluster.DecodeGKEVMReq,
+		externalcluster.DecodeGKEVMSizesReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -9146,6 +9962,7 @@ func (r Routing) listGKEDiskTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEDiskTypesEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeGKEVMReq,
@@ -9168,6 +9985,7 @@ func (r Routing) listGKEVersions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEVersionsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeGKEVersionsReq,
@@ -9190,6 +10008,7 @@ func (r Routing) validateGKECredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEValidateCredentialsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeGKECommonReq,
@@ -9212,6 +10031,7 @@ func (r Routing) validateEKSCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSValidateCredentialsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeEKSRegionReq,
@@ -9237,8 +10057,9 @@ func (r Routing) listEKSInstanceTypesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.EKSInstanceTypesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		)(externalcluster.EKSInstanceTypesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.providerCache)),
 		externalcluster.DecodeEKSNoCredentialSizeReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -9262,8 +10083,9 @@ func (r Routing) listEKSSubnetsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.EKSSubnetsWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		)(externalcluster.EKSSubnetsWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.providerCache)),
 		externalcluster.DecodeEKSSubnetsNoCredentialReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -9287,8 +10109,9 @@ func (r Routing) listEKSVPCsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.EKSVPCsWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		)(externalcluster.EKSVPCsWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.providerCache)),
 		externalcluster.DecodeEKSNoCredentialReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -9311,6 +10134,7 @@ func (r Routing) listEKSNodeRolesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSNodeRolesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeEKSNoCredentialReq,
@@ -9333,6 +10157,7 @@ func (r Routing) validateAKSCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.AKSValidateCredentialsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeAKSCommonReq,
@@ -9355,7 +10180,9 @@ func (r Routing) listAKSVMSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(externalcluster.ListAKSVMSizesEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeAKSVMSizesReq,
 		handler.EncodeJSON,
@@ -9377,6 +10204,7 @@ func (r Routing) listAKSResourceGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListAKSResourceGroupsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeAKSCommonReq,
@@ -9399,6 +10227,7 @@ func (r Routing) listAKSLocations() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListAKSLocationsEndpoint(r.presetProvider, r.userInfoGetter, false)),
 		externalcluster.DecodeAKSCommonReq,
@@ -9424,6 +10253,7 @@ func (r Routing) listAKSNodePoolModes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.AKSNodePoolModesEndpoint()),
 		common.DecodeEmptyReq,
@@ -9446,6 +10276,7 @@ func (r Routing) listAKSVersions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.AKSVersionsEndpoint(r.kubermaticConfigGetter, r.externalClusterProvider)),
 		common.DecodeEmptyReq,
@@ -9468,6 +10299,7 @@ func (r Routing) listEKSClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEKSClustersEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.presetProvider)),
 		externalcluster.DecodeEKSProjectRegionReq,
@@ -9490,8 +10322,9 @@ func (r Routing) listEKSVPCS() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListEKSVPCEndpoint(r.userInfoGetter, r.presetProvider, false)),
+		)(externalcluster.ListEKSVPCEndpoint(r.userInfoGetter, r.presetProvider, false, r.providerCache)),
 		externalcluster.DecodeEKSRegionReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -9512,8 +10345,9 @@ func (r Routing) listEKSSubnets() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListEKSSubnetsEndpoint(r.userInfoGetter, r.presetProvider, false)),
+		)(externalcluster.ListEKSSubnetsEndpoint(r.userInfoGetter, r.presetProvider, false, r.providerCache)),
 		externalcluster.DecodeEKSVPCReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -9536,6 +10370,7 @@ func (r Routing) listEKSSecurityGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEKSSecurityGroupsEndpoint(r.userInfoGetter, r.presetProvider, false)),
 		externalcluster.DecodeEKSVPCReq,
@@ -9560,6 +10395,7 @@ func (r Routing) listEKSRegions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEKSRegionsEndpoint(r.userInfoGetter, r.presetProvider, false)),
 		externalcluster.DecodeEKSCommonReq,
@@ -9584,6 +10420,7 @@ func (r Routing) listEKSClusterRoles() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListEKSClusterRolesEndpoint(r.userInfoGetter, r.presetProvider, false)),
 		externalcluster.DecodeEKSCommonReq,
@@ -9606,6 +10443,7 @@ func (r Routing) listEKSVersions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSVersionsEndpoint(r.kubermaticConfigGetter, r.externalClusterProvider)),
 		common.DecodeEmptyReq,
@@ -9631,6 +10469,7 @@ func (r Routing) listEKSAMITypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSAMITypesEndpoint()),
 		common.DecodeEmptyReq,
@@ -9656,6 +10495,7 @@ func (r Routing) listEKSCapacityTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.EKSCapacityTypesEndpoint()),
 		common.DecodeEmptyReq,
@@ -9678,6 +10518,7 @@ func (r Routing) listAKSClusters() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListAKSClustersEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.presetProvider)),
 		externalcluster.DecodeAKSClusterListReq,
@@ -9700,6 +10541,7 @@ func (r Routing) listKubeVirtInstancetypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtInstancetypesEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, r.settingsProvider, false)),
 		provider.DecodeKubeVirtGenericReq,
@@ -9722,6 +10564,7 @@ func (r Routing) listKubeVirtPreferences() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtPreferencesEndpoint(r.presetProvider, r.userInfoGetter, r.settingsProvider, r.seedsGetter, false)),
 		provider.DecodeKubeVirtGenericReq,
@@ -9744,6 +10587,7 @@ func (r Routing) listKubevirtStorageClasses() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(provider.KubeVirtStorageClassesEndpoint(r.presetProvider, r.userInfoGetter, r.seedsGetter, false)),
 		provider.DecodeKubeVirtGenericReq,
@@ -9768,7 +10612,9 @@ func (r Routing) listKubevirtStorageClasses() http.Handler {
 func (r Routing) getExternalClusterKubeconfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
+			middleware.TokenOperation(authtypes.AdminKubeconfigTokenOperation),
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GetKubeconfigEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -9793,6 +10639,7 @@ func (r Routing) getAdminRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.PrivilegedRuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
 		)(rulegroupadmin.GetEndpoint(r.userInfoGetter)),
@@ -9818,6 +10665,7 @@ func (r Routing) listAdminRuleGroups() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.PrivilegedRuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
 		)(rulegroupadmin.ListEndpoint(r.userInfoGetter)),
@@ -9846,7 +10694,9 @@ func (r Routing) createAdminRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.PrivilegedRuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
 		)(rulegroupadmin.CreateEndpoint(r.userInfoGetter)),
 		rulegroupadmin.DecodeCreateReq,
@@ -9874,7 +10724,9 @@ func (r Routing) updateAdminRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.PrivilegedRuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
 		)(rulegroupadmin.UpdateEndpoint(r.userInfoGetter)),
 		rulegroupadmin.DecodeUpdateReq,
@@ -9899,7 +10751,9 @@ func (r Routing) deleteAdminRuleGroup() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.PrivilegedRuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
 		)(rulegroupadmin.DeleteEndpoint(r.userInfoGetter)),
 		rulegroupadmin.DecodeDeleteReq,
@@ -9924,7 +10778,9 @@ func (r Routing) patchExternalClusterMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(externalcluster.PatchMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodePatchMachineDeploymentReq,
 		handler.EncodeJSON,
@@ -9948,6 +10804,7 @@ func (r Routing) getExternalClusterMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GetMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeGetMachineDeploymentReq,
@@ -9972,6 +10829,7 @@ func (r Routing) getExternalClusterMachineDeploymentUpgrades() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GetMachineDeploymentUpgradesEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeGetMachineDeploymentReq,
@@ -9996,6 +10854,7 @@ func (r Routing) listExternalClusterMachineDeploymentNodes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListMachineDeploymentNodesEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeListMachineDeploymentNodesReq,
@@ -10021,6 +10880,7 @@ func (r Routing) listExternalClusterMachineDeploymentMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListMachineDeploymentMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeGetMachineDeploymentReq,
@@ -10046,6 +10906,7 @@ func (r Routing) listExternalClusterMachineDeploymentEvents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.ListMachineDeploymentEventsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
 		externalcluster.DecodeListMachineDeploymentNodesEvents,
@@ -10071,6 +10932,7 @@ func (r Routing) listAKSNodeVersionsNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.AKSNodeVersionsWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -10096,7 +10958,9 @@ func (r Routing) listAKSVMSizesNoCredentials() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(externalcluster.AKSSizesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeAKSNoCredentialReq,
 		handler.EncodeJSON,
@@ -10121,6 +10985,7 @@ func (r Routing) listGKEClusterImages() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEImagesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -10146,6 +11011,7 @@ func (r Routing) listGKEClusterZones() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEZonesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -10171,9 +11037,11 @@ func (r Routing) listGKEClusterSizes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Paginate(),
 		)(externalcluster.GKESizesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetReq,
+		externalcluster.DecodeGKESizesNoCredentialReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -10196,6 +11064,7 @@ func (r Routing) listGKEClusterDiskTypes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(externalcluster.GKEDiskTypesWithClusterCredentialsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
 		externalcluster.DecodeGetReq,
@@ -10220,6 +11089,7 @@ func (r Routing) getBackupDestinationNames() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -10246,6 +11116,7 @@ func (r Routing) listVersionsByCNIPlugin() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(cniversion.ListVersions()),
 		cniversion.DecodeListCNIPluginVersions,
@@ -10270,6 +11141,7 @@ func (r Routing) listCNIPluginVersionsForCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -10296,6 +11168,7 @@ func (r Routing) getNetworkDefaults() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(networkdefaults.GetNetworkDefaultsEndpoint(r.seedsGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
@@ -10321,6 +11194,7 @@ func (r Routing) getDefaultCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(clusterdefault.GetDefaultClusterEndpoint(r.seedsGetter, r.userInfoGetter, r.kubermaticConfigGetter)),
@@ -10375,6 +11249,7 @@ func (r Routing) getProjectQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(resourcequota.GetForProjectEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.resourceQuotaProvider)),
 		common.DecodeGetProject,
@@ -10402,7 +11277,9 @@ func (r Routing) calculateProjectResourceQuotaUpdate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(resourcequota.CalculateProjectQuotaUpdateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.resourceQuotaProvider)),
 		resourcequota.DecodeCalculateProjectResourceQuotaUpdateReq,
 		handler.EncodeJSON,
@@ -10410,6 +11287,87 @@ func (r Routing) calculateProjectResourceQuotaUpdate() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v2/projects/{project_id}/costestimation project estimateClusterCost
+//
+//	Estimates the monthly cost of a cluster with the given machine deployments before it is created.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterCostEstimate
+//	  401: empty
+//	  403: empty
+func (r Routing) estimateClusterCost() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(cost.EstimateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.priceCatalog)),
+		cost.DecodeEstimateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/cost project getProjectCost
+//
+//	Estimates the monthly cost of all clusters of the project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProjectCostEstimate
+//	  401: empty
+//	  403: empty
+func (r Routing) getProjectCost() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(cost.ProjectEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter, r.priceCatalog)),
+		cost.DecodeProjectReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/cost project getClusterCost
+//
+//	Estimates the monthly cost of the cluster from its machine deployments.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterCostEstimate
+//	  401: empty
+//	  403: empty
+func (r Routing) getClusterCost() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cost.ClusterEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.priceCatalog)),
+		cost.DecodeClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/quotas/{quota_name} resourceQuota admin getResourceQuota
 //
 //	Gets a specific Resource Quota.
@@ -10426,6 +11384,7 @@ func (r Routing) getResourceQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(resourcequota.GetResourceQuotaEndpoint(r.userInfoGetter, r.resourceQuotaProvider, r.privilegedProjectProvider)),
 		resourcequota.DecodeResourceQuotasReq,
@@ -10450,6 +11409,7 @@ func (r Routing) listResourceQuotas() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(resourcequota.ListResourceQuotasEndpoint(r.userInfoGetter, r.resourceQuotaProvider, r.projectProvider)),
 		resourcequota.DecodeListResourceQuotasReq,
@@ -10474,7 +11434,9 @@ func (r Routing) createResourceQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(resourcequota.CreateResourceQuotaEndpoint(r.userInfoGetter, r.resourceQuotaProvider)),
 		resourcequota.DecodeCreateResourceQuotasReq,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
@@ -10498,7 +11460,9 @@ func (r Routing) putResourceQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(resourcequota.PutResourceQuotaEndpoint(r.userInfoGetter, r.resourceQuotaProvider)),
 		resourcequota.DecodePutResourceQuotasReq,
 		handler.EncodeJSON,
@@ -10506,6 +11470,32 @@ func (r Routing) putResourceQuota() http.Handler {
 	)
 }
 
+// swagger:route PUT /api/v2/quotas/{quota_name}/alertthresholds resourceQuota admin putResourceQuotaAlertThresholds
+//
+//	Sets the soft thresholds of a Resource Quota at which alerts are raised and notifications are sent.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) putResourceQuotaAlertThresholds() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(resourcequota.PutResourceQuotaAlertThresholdsEndpoint(r.userInfoGetter, r.resourceQuotaProvider)),
+		resourcequota.DecodePutResourceQuotaAlertThresholdsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route DELETE /api/v2/quotas/{quota_name} resourceQuota admin deleteResourceQuota
 //
 //	Removes an existing Resource Quota.
@@ -10522,7 +11512,9 @@ func (r Routing) deleteResourceQuota() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(resourcequota.DeleteResourceQuotaEndpoint(r.userInfoGetter, r.resourceQuotaProvider)),
 		resourcequota.DecodeResourceQuotasReq,
 		handler.EncodeJSON,
@@ -10530,6 +11522,113 @@ func (r Routing) deleteResourceQuota() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/chargeback/schema chargeback getChargebackSchema
+//
+//	Gets the schema of the chargeback attributes of projects and clusters.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ChargebackSchema
+//	  401: empty
+//	  403: empty
+func (r Routing) getChargebackSchema() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(chargeback.GetSchemaEndpoint(r.chargebackSchemaProvider)),
+		common.DecodeEmptyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/chargeback/schema chargeback admin updateChargebackSchema
+//
+//	Updates the schema of the chargeback attributes of projects and clusters. Only available to admins.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ChargebackSchema
+//	  401: empty
+//	  403: empty
+func (r Routing) updateChargebackSchema() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(chargeback.UpdateSchemaEndpoint(r.userInfoGetter, r.chargebackSchemaProvider)),
+		chargeback.DecodeUpdateSchemaReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/chargeback/metering chargeback admin getChargebackMetering
+//
+//	Aggregates the metering reports by the chargeback attributes, followed by the dimensions of `group_by`.
+//	Clusters without an attribute are attributed to the value of their project.
+//
+//	Produces:
+//	- application/json
+//	- text/csv
+//	- application/vnd.apache.parquet
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: MeteringReportAggregation
+//	  401: empty
+//	  403: empty
+func (r Routing) getChargebackMetering() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(chargeback.MeteringEndpoint(r.userInfoGetter, r.chargebackSchemaProvider, r.seedsGetter, r.seedsClientGetter)),
+		chargeback.DecodeMeteringReq,
+		chargeback.EncodeMetering,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/chargeback/quotas chargeback admin getChargebackQuotas
+//
+//	Sums up the resource quotas and their global usage of the projects by the chargeback attributes.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ChargebackQuotaBreakdown
+//	  401: empty
+//	  403: empty
+func (r Routing) getChargebackQuotas() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(chargeback.QuotasEndpoint(r.userInfoGetter, r.chargebackSchemaProvider, r.resourceQuotaProvider, r.projectProvider)),
+		chargeback.DecodeQuotasReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route get /api/v2/projects/{project_id}/groupbindings project listGroupProjectBinding
 //
 //	Lists project's group bindings.
@@ -10546,6 +11645,7 @@ func (r Routing) listGroupProjectBindings() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(groupprojectbinding.ListGroupProjectBindingsEndpoint(
 			r.userInfoGetter,
@@ -10575,6 +11675,7 @@ func (r Routing) getGroupProjectBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(groupprojectbinding.GetGroupProjectBindingEndpoint(
 			r.userInfoGetter,
@@ -10604,7 +11705,9 @@ func (r Routing) createGroupProjectBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(groupprojectbinding.CreateGroupProjectBindingEndpoint(
 			r.userInfoGetter,
 			r.projectProvider,
@@ -10633,7 +11736,9 @@ func (r Routing) deleteGroupProjectBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(groupprojectbinding.DeleteGroupProjectBindingEndpoint(
 			r.userInfoGetter,
 			r.projectProvider,
@@ -10662,7 +11767,9 @@ func (r Routing) patchGroupProjectBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(groupprojectbinding.PatchGroupProjectBindingEndpoint(
 			r.userInfoGetter,
 			r.projectProvider,
@@ -10675,6 +11782,459 @@ func (r Routing) patchGroupProjectBinding() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v2/projects/{project_id}/apply project applyProjectBundle
+//
+//	Applies a bundle of SSH keys, members, group bindings, clusters, machine deployments, addons and applications
+//	to the project. Resources missing in the project are created, differing ones are updated and, if the bundle
+//	sets prune, undeclared ones are deleted. With dryRun the changes are only planned.
+//
+//	Consumes:
+//	- application/json
+//	- application/yaml
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProjectApplyReport
+//	  401: empty
+//	  403: empty
+func (r Routing) applyProjectBundle() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(projectapply.ApplyEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.projectApplyOperations())),
+		projectapply.DecodeApplyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/export project exportProject
+//
+//	Exports the project together with its SSH keys, members, group bindings, cluster templates and clusters.
+//	Credentials are not exported, clusters and cluster templates created from presets reference them by name.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ProjectExport
+//	  401: empty
+//	  403: empty
+func (r Routing) exportProject() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+		)(projectapply.ExportEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider, r.projectApplyOperations())),
+		projectapply.DecodeExportReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/import project importProject
+//
+//	Creates a new project from an exported one. Resources which need a running cluster are skipped for the
+//	new clusters, they are created by applying the bundle of the export to the new project again.
+//
+//	Consumes:
+//	- application/json
+//	- application/yaml
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ProjectImportReport
+//	  401: empty
+//	  403: empty
+func (r Routing) importProject() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(projectapply.ImportEndpoint(r.userInfoGetter, r.projectApplyOperations())),
+		projectapply.DecodeImportReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clone project cloneProject
+//
+//	Exports the project and imports it as a new project with the given name.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ProjectImportReport
+//	  401: empty
+//	  403: empty
+func (r Routing) cloneProject() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
+			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
+		)(projectapply.CloneEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider, r.projectApplyOperations())),
+		projectapply.DecodeCloneReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// projectApplyOperations returns the endpoints used to apply, export and import projects. The user was already verified by the
+// apply endpoint, so only the middlewares providing the clusters and addons are chained. Every change is audited like the
+// request of the user it was planned from.
+func (r Routing) projectApplyOperations() projectapply.Operations {
+	audited := middleware.Audit(r.auditLogger, r.userInfoGetter)
+	clusterProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+	)
+	addonProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+	)
+	constraintProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+	)
+	ruleGroupProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.RuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
+		middleware.PrivilegedRuleGroups(r.clusterProviderGetter, r.ruleGroupProviderGetter, r.seedsGetter),
+	)
+	alertmanagerProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.Alertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
+		middleware.PrivilegedAlertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
+	)
+	etcdBackupConfigProviders := endpoint.Chain(
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.EtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
+		middleware.PrivilegedEtcdBackupConfig(r.clusterProviderGetter, r.etcdBackupConfigProviderGetter, r.seedsGetter),
+	)
+
+	return projectapply.Operations{
+		ListSSHKeys: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v1/projects/{project_id}/sshkeys",
+			Decode:   ssh.DecodeListReq,
+			Endpoint: ssh.ListEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.features),
+		},
+		CreateSSHKey: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v1/projects/{project_id}/sshkeys",
+			Decode:   ssh.DecodeCreateReq,
+			Endpoint: audited(ssh.CreateEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.features)),
+		},
+		DeleteSSHKey: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v1/projects/{project_id}/sshkeys/{key_id}",
+			Decode:   ssh.DecodeDeleteReq,
+			Endpoint: audited(ssh.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.features)),
+		},
+
+		ListMembers: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v1/projects/{project_id}/users",
+			Decode:   common.DecodeGetProject,
+			Endpoint: userv1.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.userInfoGetter),
+		},
+		AddMember: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v1/projects/{project_id}/users",
+			Decode:   userv1.DecodeAddReq,
+			Endpoint: audited(userv1.AddEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter)),
+		},
+		EditMember: handlercommon.Operation{
+			Method:   http.MethodPut,
+			Route:    "/api/v1/projects/{project_id}/users/{user_id}",
+			Decode:   userv1.DecodeEditReq,
+			Endpoint: audited(userv1.EditEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter)),
+		},
+		DeleteMember: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v1/projects/{project_id}/users/{user_id}",
+			Decode:   userv1.DecodeDeleteReq,
+			Endpoint: audited(userv1.DeleteEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter)),
+		},
+
+		ListGroupBindings: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/groupbindings",
+			Decode:   common.DecodeGetProject,
+			Endpoint: groupprojectbinding.ListGroupProjectBindingsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.groupProjectBindingProvider),
+		},
+		CreateGroupBinding: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v2/projects/{project_id}/groupbindings",
+			Decode:   groupprojectbinding.DecodeCreateGroupProjectBindingReq,
+			Endpoint: audited(groupprojectbinding.CreateGroupProjectBindingEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.groupProjectBindingProvider)),
+		},
+		PatchGroupBinding: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/groupbindings/{binding_name}",
+			Decode:   groupprojectbinding.DecodePatchGroupProjectBindingReq,
+			Endpoint: audited(groupprojectbinding.PatchGroupProjectBindingEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.groupProjectBindingProvider)),
+		},
+		DeleteGroupBinding: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/groupbindings/{binding_name}",
+			Decode:   groupprojectbinding.DecodeDeleteGroupProjectBindingReq,
+			Endpoint: audited(groupprojectbinding.DeleteGroupProjectBindingEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.groupProjectBindingProvider)),
+		},
+
+		ListClusters: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters",
+			Decode:   cluster.DecodeListClustersReq,
+			Endpoint: cluster.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter, r.kubermaticConfigGetter),
+		},
+		CreateCluster: handlercommon.Operation{
+			Method: http.MethodPost,
+			Route:  "/api/v2/projects/{project_id}/clusters",
+			Decode: cluster.DecodeCreateReq,
+			Endpoint: audited(clusterProviders(cluster.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter,
+				r.presetProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.caBundle, r.kubermaticConfigGetter, r.features, r.chargebackSchemaProvider))),
+		},
+		PatchCluster: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}",
+			Decode:   cluster.DecodePatchReq,
+			Endpoint: audited(clusterProviders(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.caBundle, r.kubermaticConfigGetter, r.features, r.settingsProvider))),
+		},
+		DeleteCluster: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}",
+			Decode:   cluster.DecodeDeleteReq,
+			Endpoint: audited(clusterProviders(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))),
+		},
+
+		ListMachineDeployments: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments",
+			Decode:   machine.DecodeListMachineDeployments,
+			Endpoint: clusterProviders(machine.ListMachineDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		},
+		CreateMachineDeployment: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments",
+			Decode:   machine.DecodeCreateMachineDeployment,
+			Endpoint: audited(clusterProviders(machine.CreateMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider))),
+		},
+		PatchMachineDeployment: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}",
+			Decode:   machine.DecodePatchMachineDeployment,
+			Endpoint: audited(clusterProviders(machine.PatchMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider))),
+		},
+		DeleteMachineDeployment: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}",
+			Decode:   machine.DecodeDeleteMachineDeployment,
+			Endpoint: audited(clusterProviders(machine.DeleteMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))),
+		},
+
+		ListAddons: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/addons",
+			Decode:   addon.DecodeListAddons,
+			Endpoint: addonProviders(addon.ListAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		},
+		CreateAddon: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/addons",
+			Decode:   addon.DecodeCreateAddon,
+			Endpoint: audited(addonProviders(addon.CreateAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))),
+		},
+		PatchAddon: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}",
+			Decode:   addon.DecodePatchAddon,
+			Endpoint: audited(addonProviders(addon.PatchAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))),
+		},
+		DeleteAddon: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}",
+			Decode:   addon.DecodeGetAddon,
+			Endpoint: audited(addonProviders(addon.DeleteAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))),
+		},
+
+		ListApplications: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations",
+			Decode:   applicationinstallation.DecodeListApplicationInstallations,
+			Endpoint: clusterProviders(applicationinstallation.ListApplicationInstallations(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		},
+		GetApplication: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}",
+			Decode:   applicationinstallation.DecodeGetApplicationInstallation,
+			Endpoint: clusterProviders(applicationinstallation.GetApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		},
+		CreateApplication: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations",
+			Decode:   applicationinstallation.DecodeCreateApplicationInstallation,
+			Endpoint: audited(clusterProviders(applicationinstallation.CreateApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+		UpdateApplication: handlercommon.Operation{
+			Method:   http.MethodPut,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}",
+			Decode:   applicationinstallation.DecodeUpdateApplicationInstallation,
+			Endpoint: audited(clusterProviders(applicationinstallation.UpdateApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+		DeleteApplication: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}",
+			Decode:   applicationinstallation.DecodeDeleteApplicationInstallation,
+			Endpoint: audited(clusterProviders(applicationinstallation.DeleteApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+
+		ListConstraints: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/constraints",
+			Decode:   constraint.DecodeListConstraintsReq,
+			Endpoint: constraintProviders(constraint.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		},
+		CreateConstraint: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/constraints",
+			Decode:   constraint.DecodeCreateConstraintReq,
+			Endpoint: audited(constraintProviders(constraint.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider))),
+		},
+		PatchConstraint: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name}",
+			Decode:   constraint.DecodePatchConstraintReq,
+			Endpoint: audited(constraintProviders(constraint.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider))),
+		},
+		DeleteConstraint: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name}",
+			Decode:   constraint.DecodeConstraintReq,
+			Endpoint: audited(constraintProviders(constraint.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+
+		ListRuleGroups: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/rulegroups",
+			Decode:   rulegroup.DecodeListReq,
+			Endpoint: ruleGroupProviders(rulegroup.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		},
+		CreateRuleGroup: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/rulegroups",
+			Decode:   rulegroup.DecodeCreateReq,
+			Endpoint: audited(ruleGroupProviders(rulegroup.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+		UpdateRuleGroup: handlercommon.Operation{
+			Method:   http.MethodPut,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/rulegroups/{rulegroup_id}",
+			Decode:   rulegroup.DecodeUpdateReq,
+			Endpoint: audited(ruleGroupProviders(rulegroup.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+		DeleteRuleGroup: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/rulegroups/{rulegroup_id}",
+			Decode:   rulegroup.DecodeDeleteReq,
+			Endpoint: audited(ruleGroupProviders(rulegroup.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+
+		GetAlertmanager: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/alertmanager/config",
+			Decode:   alertmanager.DecodeGetAlertmanagerReq,
+			Endpoint: alertmanagerProviders(alertmanager.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		},
+		UpdateAlertmanager: handlercommon.Operation{
+			Method:   http.MethodPut,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/alertmanager/config",
+			Decode:   alertmanager.DecodeUpdateAlertmanagerReq,
+			Endpoint: audited(alertmanagerProviders(alertmanager.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider))),
+		},
+
+		ListEtcdBackupConfigs: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackupconfigs",
+			Decode:   etcdbackupconfig.DecodeListEtcdBackupConfigReq,
+			Endpoint: etcdBackupConfigProviders(etcdbackupconfig.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
+		},
+		CreateEtcdBackupConfig: handlercommon.Operation{
+			Method:   http.MethodPost,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackupconfigs",
+			Decode:   etcdbackupconfig.DecodeCreateEtcdBackupConfigReq,
+			Endpoint: audited(etcdBackupConfigProviders(etcdbackupconfig.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider))),
+		},
+		PatchEtcdBackupConfig: handlercommon.Operation{
+			Method:   http.MethodPatch,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackupconfigs/{ebc_id}",
+			Decode:   etcdbackupconfig.DecodePatchEtcdBackupConfigReq,
+			Endpoint: audited(etcdBackupConfigProviders(etcdbackupconfig.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider))),
+		},
+		DeleteEtcdBackupConfig: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackupconfigs/{ebc_id}",
+			Decode:   etcdbackupconfig.DecodeGetEtcdBackupConfigReq,
+			Endpoint: audited(etcdBackupConfigProviders(etcdbackupconfig.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider))),
+		},
+
+		ListClusterTemplates: handlercommon.Operation{
+			Method:   http.MethodGet,
+			Route:    "/api/v2/projects/{project_id}/clustertemplates",
+			Decode:   clustertemplate.DecodeListReq,
+			Endpoint: clustertemplate.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider),
+		},
+		ImportClusterTemplate: handlercommon.Operation{
+			Method: http.MethodPost,
+			Route:  "/api/v2/projects/{project_id}/clustertemplates/import",
+			Decode: clustertemplate.DecodeImportReq,
+			Endpoint: audited(middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter)(clustertemplate.ImportEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter,
+				r.clusterTemplateProvider, r.seedsGetter, r.presetProvider, r.caBundle, r.exposeStrategy, r.sshKeyProvider, r.kubermaticConfigGetter, r.features, r.settingsProvider))),
+		},
+		DeleteClusterTemplate: handlercommon.Operation{
+			Method:   http.MethodDelete,
+			Route:    "/api/v2/projects/{project_id}/clustertemplates/{template_id}",
+			Decode:   clustertemplate.DecodeGetReq,
+			Endpoint: audited(clustertemplate.DeleteEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider)),
+		},
+
+		CreateProject: handlercommon.Operation{
+			Method: http.MethodPost,
+			Route:  "/api/v1/projects",
+			Decode: projectv1.DecodeCreate,
+			Endpoint: audited(projectv1.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.settingsProvider, r.userProjectMapper,
+				r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userProvider)),
+		},
+	}
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations applications listApplicationInstallations
 //
 //	List ApplicationInstallations which belong to the given cluster
@@ -10691,6 +12251,7 @@ func (r Routing) listApplicationInstallations() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -10720,7 +12281,9 @@ func (r Routing) createApplicationInstallation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(applicationinstallation.CreateApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -10747,7 +12310,9 @@ func (r Routing) deleteApplicationInstallation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(applicationinstallation.DeleteApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -10774,6 +12339,7 @@ func (r Routing) getApplicationInstallation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -10804,7 +12370,9 @@ func (r Routing) updateApplicationInstallation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(applicationinstallation.UpdateApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -10830,6 +12398,7 @@ func (r Routing) listApplicationDefinitions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(applicationdefinition.ListApplicationDefinitions(r.applicationDefinitionProvider)),
 		common.DecodeEmptyReq,
@@ -10855,6 +12424,7 @@ func (r Routing) getApplicationDefinition() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(applicationdefinition.GetApplicationDefinition(r.applicationDefinitionProvider)),
 		applicationdefinition.DecodeGetApplicationDefinition,
@@ -10882,7 +12452,9 @@ func (r Routing) createApplicationDefinition() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(applicationdefinition.CreateApplicationDefinition(r.userInfoGetter, r.applicationDefinitionProvider)),
 		applicationdefinition.DecodeCreateApplicationDefinition,
 		handler.SetStatusCreatedHeader(handler.EncodeJSON),
@@ -10909,7 +12481,9 @@ func (r Routing) updateApplicationDefinition() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(applicationdefinition.UpdateApplicationDefinition(r.userInfoGetter, r.applicationDefinitionProvider)),
 		applicationdefinition.DecodeUpdateApplicationDefinition,
 		handler.EncodeJSON,
@@ -10936,7 +12510,9 @@ func (r Routing) patchApplicationDefinition() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(applicationdefinition.PatchApplicationDefinition(r.userInfoGetter, r.applicationDefinitionProvider)),
 		applicationdefinition.DecodePatchApplicationDefinitionReq,
 		handler.EncodeJSON,
@@ -10961,7 +12537,9 @@ func (r Routing) deleteApplicationDefinition() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(applicationdefinition.DeleteApplicationDefinition(r.userInfoGetter, r.applicationDefinitionProvider)),
 		applicationdefinition.DecodeDeleteApplicationDefinition,
 		handler.EncodeJSON,
@@ -10985,6 +12563,7 @@ func (r Routing) getApplicationSettings() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(applicationsettings.GetApplicationSettingsEndpoint(r.kubermaticConfigGetter)),
 		common.DecodeEmptyReq,
@@ -11009,6 +12588,7 @@ func (r Routing) listIPAMPools() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.PrivilegedIPAMPool(r.privilegedIPAMPoolProviderGetter, r.seedsGetter),
 		)(ipampool.ListIPAMPoolsEndpoint(r.userInfoGetter)),
@@ -11034,6 +12614,7 @@ func (r Routing) getIPAMPool() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.PrivilegedIPAMPool(r.privilegedIPAMPoolProviderGetter, r.seedsGetter),
 		)(ipampool.GetIPAMPoolEndpoint(r.userInfoGetter)),
@@ -11059,7 +12640,9 @@ func (r Routing) createIPAMPool() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.PrivilegedIPAMPool(r.privilegedIPAMPoolProviderGetter, r.seedsGetter),
 		)(ipampool.CreateIPAMPoolEndpoint(r.userInfoGetter)),
 		ipampool.DecodeCreateIPAMPoolReq,
@@ -11084,7 +12667,9 @@ func (r Routing) patchIPAMPool() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.PrivilegedIPAMPool(r.privilegedIPAMPoolProviderGetter, r.seedsGetter),
 		)(ipampool.PatchIPAMPoolEndpoint(r.userInfoGetter)),
 		ipampool.DecodePatchIPAMPoolReq,
@@ -11106,7 +12691,9 @@ func (r Routing) deleteIPAMPool() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.PrivilegedIPAMPool(r.privilegedIPAMPoolProviderGetter, r.seedsGetter),
 		)(ipampool.DeleteIPAMPoolEndpoint(r.userInfoGetter)),
 		ipampool.DecodeIPAMPoolReq,
@@ -11131,6 +12718,7 @@ func (r Routing) listOperatingSystemProfiles() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.PrivilegedOperatingSystemProfile(r.clusterProviderGetter, r.privilegedOperatingSystemProfileProviderGetter, r.seedsGetter),
 		)(operatingsystemprofile.ListOperatingSystemProfilesEndpoint(r.userInfoGetter)),
@@ -11156,6 +12744,7 @@ func (r Routing) listOperatingSystemProfilesForCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.PrivilegedOperatingSystemProfile(r.clusterProviderGetter, r.privilegedOperatingSystemProfileProviderGetter, r.seedsGetter),
@@ -11182,6 +12771,7 @@ func (r Routing) listClusterServiceAccount() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -11211,7 +12801,9 @@ func (r Routing) createClusterServiceAccount() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.CreateClusterSAEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -11238,6 +12830,7 @@ func (r Routing) getClusterServiceAccountKubeconfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -11264,6 +12857,7 @@ func (r Routing) getClusterServiceAccountPermissions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
@@ -11288,7 +12882,9 @@ func (r Routing) deleteClusterServiceAccount() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.DeleteClusterSAKubeconigEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
@@ -11311,6 +12907,7 @@ func (r Routing) getSeedOverview() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(seedoverview.GetSeedOverview(r.userInfoGetter, r.seedsGetter)),
@@ -11336,6 +12933,7 @@ func (r Routing) listSeedStatus() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(seedoverview.ListSeedStatus(r.seedsGetter)),
 		common.DecodeEmptyReq,
@@ -11362,6 +12960,7 @@ func (r Routing) listKyvernoPolicyTemplates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(policytemplate.ListEndpoint(r.userInfoGetter, r.privilegedProjectProvider, r.policyTemplateProvider)),
 		policytemplate.DecodeListPolicyTemplateReq,
@@ -11387,6 +12986,7 @@ func (r Routing) getKyvernoPolicyTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 		)(policytemplate.GetEndpoint(r.userInfoGetter, r.policyTemplateProvider)),
 		policytemplate.DecodeGetPolicyTemplateReq,
@@ -11414,7 +13014,9 @@ func (r Routing) createKyvernoPolicyTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(policytemplate.CreateEndpoint(r.userInfoGetter, r.policyTemplateProvider)),
 		policytemplate.DecodeCreatePolicyTemplateReq,
 		handler.EncodeJSON,
@@ -11442,7 +13044,9 @@ func (r Routing) patchKyvernoPolicyTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(policytemplate.PatchEndpoint(r.userInfoGetter, r.policyTemplateProvider)),
 		policytemplate.DecodePatchPolicyTemplateReq,
 		handler.EncodeJSON,
@@ -11467,7 +13071,9 @@ func (r Routing) deleteKyvernoPolicyTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 		)(policytemplate.DeleteEndpoint(r.userInfoGetter, r.policyTemplateProvider)),
 		policytemplate.DecodeDeletePolicyTemplateReq,
 		handler.EncodeJSON,
@@ -11492,6 +13098,7 @@ func (r Routing) listKyvernoPolicyBindings() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(policybinding.ListEndpoint(r.userInfoGetter)),
@@ -11518,6 +13125,7 @@ func (r Routing) getKyvernoPolicyBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(policybinding.GetEndpoint(r.userInfoGetter)),
@@ -11546,7 +13154,9 @@ func (r Routing) createKyvernoPolicyBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(policybinding.CreateEndpoint(r.userInfoGetter)),
 		policybinding.DecodeCreatePolicyBindingReq,
@@ -11574,7 +13184,9 @@ func (r Routing) patchKyvernoPolicyBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(policybinding.PatchEndpoint(r.userInfoGetter)),
 		policybinding.DecodePatchPolicyBindingReq,
@@ -11597,7 +13209,9 @@ func (r Routing) deleteKyvernoPolicyBinding() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.RateLimit(r.rateLimiter),
 			middleware.UserSaver(r.userProvider),
+			middleware.Audit(r.auditLogger, r.userInfoGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(policybinding.DeleteEndpoint(r.userInfoGetter)),
 		policybinding.DecodeDeletePolicyBindingReq,
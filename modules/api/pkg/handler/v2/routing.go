@@ -27,10 +27,18 @@ import (
 	prometheusapi "github.com/prometheus/client_golang/api"
 	"go.uber.org/zap"
 
+	"k8c.io/dashboard/v2/pkg/audit"
+	"k8c.io/dashboard/v2/pkg/credentials"
 	"k8c.io/dashboard/v2/pkg/handler"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	upgradeworkflow "k8c.io/dashboard/v2/pkg/handler/v2/upgrade_workflow"
+	"k8c.io/dashboard/v2/pkg/healthhistory"
+	"k8c.io/dashboard/v2/pkg/presethealth"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/providercache"
+	"k8c.io/dashboard/v2/pkg/ratelimit"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
 	"k8c.io/dashboard/v2/pkg/watcher"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -43,6 +51,7 @@ type Routing struct {
 	log                                            *zap.SugaredLogger
 	logger                                         log.Logger
 	presetProvider                                 provider.PresetProvider
+	credentialResolver                             *credentials.Resolver
 	seedsGetter                                    provider.SeedsGetter
 	seedsClientGetter                              provider.SeedClientGetter
 	kubermaticConfigGetter                         provider.KubermaticConfigurationGetter
@@ -72,10 +81,17 @@ type Routing struct {
 	exposeStrategy                                 kubermaticv1.ExposeStrategy
 	userInfoGetter                                 provider.UserInfoGetter
 	settingsProvider                               provider.SettingsProvider
+	chargebackSchemaProvider                       provider.ChargebackSchemaProvider
 	adminProvider                                  provider.AdminProvider
 	admissionPluginProvider                        provider.AdmissionPluginsProvider
 	settingsWatcher                                watcher.SettingsWatcher
 	userWatcher                                    watcher.UserWatcher
+	auditLogger                                    *audit.Logger
+	rateLimiter                                    *ratelimit.Limiter
+	healthHistory                                  *healthhistory.History
+	upgradeWorkflows                               *upgradeworkflow.Workflows
+	presetHealthChecker                            *presethealth.Checker
+	priceCatalog                                   pricing.Source
 	externalClusterProvider                        provider.ExternalClusterProvider
 	privilegedExternalClusterProvider              provider.PrivilegedExternalClusterProvider
 	defaultConstraintProvider                      provider.DefaultConstraintProvider
@@ -103,6 +119,7 @@ type Routing struct {
 	oidcIssuerVerifierProviderGetter               provider.OIDCIssuerVerifierGetter
 	oidcIssuerVerifier                             authtypes.OIDCIssuerVerifier
 	versions                                       kubermatic.Versions
+	providerCache                                  *providercache.Cache
 	caBundle                                       *x509.CertPool
 	features                                       features.FeatureGate
 }
@@ -113,6 +130,7 @@ func NewV2Routing(routingParams handler.RoutingParams) Routing {
 		log:                                            routingParams.Log,
 		logger:                                         log.NewLogfmtLogger(os.Stderr),
 		presetProvider:                                 routingParams.PresetProvider,
+		credentialResolver:                             routingParams.CredentialResolver,
 		seedsGetter:                                    routingParams.SeedsGetter,
 		seedsClientGetter:                              routingParams.SeedsClientGetter,
 		kubermaticConfigGetter:                         routingParams.KubermaticConfigurationGetter,
@@ -142,10 +160,17 @@ func NewV2Routing(routingParams handler.RoutingParams) Routing {
 		exposeStrategy:                                 routingParams.ExposeStrategy,
 		userInfoGetter:                                 routingParams.UserInfoGetter,
 		settingsProvider:                               routingParams.SettingsProvider,
+		chargebackSchemaProvider:                       routingParams.ChargebackSchemaProvider,
 		adminProvider:                                  routingParams.AdminProvider,
 		admissionPluginProvider:                        routingParams.AdmissionPluginProvider,
 		settingsWatcher:                                routingParams.SettingsWatcher,
 		userWatcher:                                    routingParams.UserWatcher,
+		auditLogger:                                    routingParams.AuditLogger,
+		rateLimiter:                                    routingParams.RateLimiter,
+		healthHistory:                                  routingParams.HealthHistory,
+		upgradeWorkflows:                               routingParams.UpgradeWorkflows,
+		presetHealthChecker:                            routingParams.PresetHealthChecker,
+		priceCatalog:                                   routingParams.PriceCatalog,
 		externalClusterProvider:                        routingParams.ExternalClusterProvider,
 		privilegedExternalClusterProvider:              routingParams.PrivilegedExternalClusterProvider,
 		defaultConstraintProvider:                      routingParams.DefaultConstraintProvider,
@@ -173,11 +198,26 @@ func NewV2Routing(routingParams handler.RoutingParams) Routing {
 		oidcIssuerVerifierProviderGetter:               routingParams.OIDCIssuerVerifierProviderGetter,
 		oidcIssuerVerifier:                             routingParams.OIDCIssuerVerifier,
 		versions:                                       routingParams.Versions,
+		providerCache:                                  routingParams.ProviderCache,
 		caBundle:                                       routingParams.CABundle,
 		features:                                       routingParams.Features,
 	}
 }
 
+// RunUpgradeWorkflows runs the stages of the cluster upgrade workflows until the context is cancelled, see
+// upgradeworkflow.Workflows.Run.
+func (r Routing) RunUpgradeWorkflows(ctx context.Context) {
+	resolver := r.credentialResolver
+	seedsGetter := r.seedsGetter
+
+	// the stages are run outside of requests, so they get the values of defaultServerOptions here
+	requestContext := func(ctx context.Context) context.Context {
+		ctx = credentials.WithResolver(ctx, resolver)
+		return context.WithValue(ctx, middleware.SeedsGetterContextKey, seedsGetter)
+	}
+	r.upgradeWorkflows.Run(ctx, r.upgradeWorkflowOperations(), r.userProvider, requestContext, r.log)
+}
+
 func (r Routing) defaultServerOptions() []httptransport.ServerOption {
 	var req *http.Request
 
@@ -186,15 +226,21 @@ func (r Routing) defaultServerOptions() []httptransport.ServerOption {
 	provider := func() *http.Request {
 		return req
 	}
+	resolver := r.credentialResolver
 
 	return []httptransport.ServerOption{
 		httptransport.ServerBefore(func(c context.Context, r *http.Request) context.Context {
 			req = r
-			return c
+			// the credentials read during the request are resolved where they are used
+			return credentials.WithResolver(c, resolver)
 		}),
 		httptransport.ServerErrorHandler(handler.NewRequestErrorHandler(r.log, provider)),
 		httptransport.ServerErrorEncoder(handler.ErrorEncoder),
 		httptransport.ServerBefore(middleware.TokenExtractor(r.tokenExtractors)),
+		httptransport.ServerBefore(middleware.SetRequestInfo()),
+		httptransport.ServerBefore(middleware.StartEndpointSpan()),
+		httptransport.ServerFinalizer(middleware.EndEndpointSpan()),
+		httptransport.ServerBefore(middleware.SetProviderCacheRefresh()),
 		httptransport.ServerBefore(middleware.SetSeedsGetter(r.seedsGetter)),
 	}
 }
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradeworkflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+const (
+	actionPause  = "pause"
+	actionResume = "resume"
+	actionAbort  = "abort"
+)
+
+// getReq defines HTTP request for getClusterUpgradeWorkflow
+// swagger:parameters getClusterUpgradeWorkflow
+type getReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+}
+
+// createReq defines HTTP request for createClusterUpgradeWorkflow
+// swagger:parameters createClusterUpgradeWorkflow
+type createReq struct {
+	getReq
+	// in: body
+	// required: true
+	Body apiv2.ClusterUpgradeWorkflowBody
+}
+
+// actionReq defines HTTP request for controlClusterUpgradeWorkflow
+// swagger:parameters controlClusterUpgradeWorkflow
+type actionReq struct {
+	getReq
+	// in: path
+	// required: true
+	// enum: pause,resume,abort
+	Action string `json:"action"`
+}
+
+func DecodeGetReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req getReq
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	return req, nil
+}
+
+func DecodeCreateReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req createReq
+
+	clusterReq, err := DecodeGetReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.getReq = clusterReq.(getReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("invalid body: %v", err)
+	}
+
+	return req, nil
+}
+
+func DecodeActionReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req actionReq
+
+	clusterReq, err := DecodeGetReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.getReq = clusterReq.(getReq)
+
+	req.Action = mux.Vars(r)["action"]
+	switch req.Action {
+	case actionPause, actionResume, actionAbort:
+	default:
+		return nil, utilerrors.NewBadRequest("unknown action %q, must be one of %s, %s or %s", req.Action, actionPause, actionResume, actionAbort)
+	}
+
+	return req, nil
+}
+
+// Validate validates the body and returns the stage timeout.
+func (r createReq) Validate() (time.Duration, error) {
+	if r.Body.Version == "" {
+		return 0, utilerrors.NewBadRequest("the version is required")
+	}
+	if r.Body.BatchSize < 0 {
+		return 0, utilerrors.NewBadRequest("the batch size must not be negative")
+	}
+	if r.Body.StageTimeout == "" {
+		return defaultStageTimeout, nil
+	}
+	timeout, err := time.ParseDuration(r.Body.StageTimeout)
+	if err != nil || timeout <= 0 {
+		return 0, utilerrors.NewBadRequest("the stage timeout must be a positive duration, e.g. 45m")
+	}
+	return timeout, nil
+}
+
+// editor returns the email of the user, who must be allowed to change the cluster. The cluster is read
+// through the operation, which checks that the user is a member of its project.
+func editor(ctx context.Context, userInfoGetter provider.UserInfoGetter, operations Operations, req getReq) (string, error) {
+	vars := map[string]string{"project_id": req.ProjectID, "cluster_id": req.ClusterID}
+	if err := operations.GetCluster.Call(ctx, vars, nil, nil, nil); err != nil {
+		return "", err
+	}
+
+	userInfo, err := userInfoGetter(ctx, req.ProjectID)
+	if err != nil {
+		return "", common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin && userInfo.Roles.Has(provider.ViewersRole) && userInfo.Roles.Len() == 1 {
+		return "", utilerrors.New(http.StatusForbidden, "viewers cannot upgrade clusters")
+	}
+	return userInfo.Email, nil
+}
+
+// CreateEndpoint plans the upgrade of the cluster and stores a workflow running its steps.
+func CreateEndpoint(userInfoGetter provider.UserInfoGetter, operations Operations, workflows *Workflows) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(createReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+		stageTimeout, err := req.Validate()
+		if err != nil {
+			return nil, err
+		}
+		batchSize := req.Body.BatchSize
+		if batchSize == 0 {
+			batchSize = 1
+		}
+
+		email, err := editor(ctx, userInfoGetter, operations, req.getReq)
+		if err != nil {
+			return nil, err
+		}
+
+		plan := &apiv1.ClusterUpgradePlan{}
+		vars := map[string]string{"project_id": req.ProjectID, "cluster_id": req.ClusterID}
+		if err := operations.GetUpgradePlan.Call(ctx, vars, url.Values{"version": {req.Body.Version}}, nil, plan); err != nil {
+			return nil, err
+		}
+
+		var failed, warnings []string
+		for _, check := range plan.Checks {
+			switch check.Status {
+			case apiv1.UpgradePreflightCheckFailed:
+				failed = append(failed, fmt.Sprintf("%s: %s", check.Name, check.Message))
+			case apiv1.UpgradePreflightCheckWarning:
+				warnings = append(warnings, fmt.Sprintf("Preflight check %s: %s", check.Name, check.Message))
+			}
+		}
+		if plan.Verdict == apiv1.UpgradePlanBlocked {
+			return nil, utilerrors.NewWithDetails(http.StatusBadRequest, "the upgrade is blocked by failed preflight checks", failed)
+		}
+
+		stages := stagesFromPlan(plan.Steps, batchSize)
+		if len(stages) == 0 {
+			return nil, utilerrors.NewBadRequest("the control plane and all machine deployments already run %s", plan.TargetVersion)
+		}
+
+		return workflows.start(ctx, options{
+			projectID:     req.ProjectID,
+			clusterID:     req.ClusterID,
+			createdBy:     email,
+			targetVersion: plan.TargetVersion,
+			batchSize:     batchSize,
+			stageTimeout:  stageTimeout,
+			stages:        stages,
+			warnings:      warnings,
+		})
+	}
+}
+
+// GetEndpoint returns the latest upgrade workflow of the cluster.
+func GetEndpoint(operations Operations, workflows *Workflows) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		vars := map[string]string{"project_id": req.ProjectID, "cluster_id": req.ClusterID}
+		if err := operations.GetCluster.Call(ctx, vars, nil, nil, nil); err != nil {
+			return nil, err
+		}
+
+		return workflows.get(ctx, req.ClusterID)
+	}
+}
+
+// ActionEndpoint pauses, resumes or aborts the upgrade workflow of the cluster.
+func ActionEndpoint(userInfoGetter provider.UserInfoGetter, operations Operations, workflows *Workflows) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(actionReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		email, err := editor(ctx, userInfoGetter, operations, req.getReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return workflows.update(ctx, req.ClusterID, func(wf *workflow) error {
+			switch req.Action {
+			case actionPause:
+				return wf.pause(email)
+			case actionResume:
+				return wf.resume(email)
+			default:
+				return wf.abort(email)
+			}
+		})
+	}
+}
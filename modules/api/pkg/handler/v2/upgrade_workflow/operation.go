@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradeworkflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+// Operations are the endpoints used to plan and run the upgrades.
+type Operations struct {
+	GetUpgradePlan   handlercommon.Operation
+	GetCluster       handlercommon.Operation
+	PatchCluster     handlercommon.Operation
+	GetClusterHealth handlercommon.Operation
+
+	GetMachineDeployment       handlercommon.Operation
+	ListMachineDeploymentNodes handlercommon.Operation
+	PatchMachineDeployment     handlercommon.Operation
+}
+
+// operationStepsFunc returns the steps of the workflows, which call the operations on behalf of the users who
+// started the workflows, as if they had sent the requests.
+func operationStepsFunc(operations Operations, userProvider provider.UserProvider, requestContext func(context.Context) context.Context) stepsFunc {
+	return func(ctx context.Context, wf *workflow) (context.Context, steps, error) {
+		user, err := userProvider.UserByEmail(ctx, wf.status.CreatedBy)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ctx = context.WithValue(ctx, middleware.AuthenticatedUserContextKey, apiv1.User{
+			ObjectMeta: apiv1.ObjectMeta{
+				Name: user.Spec.Name,
+			},
+			Email:  user.Spec.Email,
+			Groups: user.Spec.Groups,
+		})
+		ctx = context.WithValue(ctx, middleware.UserCRContextKey, user)
+		// the audit log tells the calls of the workflow apart from the ones of the user
+		ctx = context.WithValue(ctx, middleware.RequestInfoContextKey, middleware.RequestInfo{UserAgent: "upgrade-workflow/" + wf.status.ID})
+		ctx = requestContext(ctx)
+
+		return ctx, operationSteps{
+			operations: operations,
+			projectID:  wf.projectID,
+			clusterID:  wf.clusterID,
+		}, nil
+	}
+}
+
+// operationSteps runs the stages of a cluster through the operations.
+type operationSteps struct {
+	operations Operations
+	projectID  string
+	clusterID  string
+}
+
+func (s operationSteps) vars() map[string]string {
+	return map[string]string{"project_id": s.projectID, "cluster_id": s.clusterID}
+}
+
+func (s operationSteps) machineDeploymentVars(name string) map[string]string {
+	vars := s.vars()
+	vars["machinedeployment_id"] = name
+	return vars
+}
+
+func (s operationSteps) upgradeControlPlane(ctx context.Context, version string) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{"version": version},
+	}
+	return s.operations.PatchCluster.Call(ctx, s.vars(), nil, patch, nil)
+}
+
+func (s operationSteps) upgradeMachineDeployment(ctx context.Context, name, version string) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"versions": map[string]interface{}{"kubelet": version},
+			},
+		},
+	}
+	return s.operations.PatchMachineDeployment.Call(ctx, s.machineDeploymentVars(name), nil, patch, nil)
+}
+
+func (s operationSteps) controlPlaneUpgraded(ctx context.Context, version string) (bool, string, error) {
+	cluster := &apiv1.Cluster{}
+	if err := s.operations.GetCluster.Call(ctx, s.vars(), nil, nil, cluster); err != nil {
+		return false, "", err
+	}
+	if current := cluster.Status.Version.String(); current != version {
+		return false, fmt.Sprintf("the control plane runs %s", current), nil
+	}
+	return true, "", nil
+}
+
+// machineDeploymentUpgraded tells whether all replicas of the machine deployment were replaced and their nodes
+// run the kubelet version.
+func (s operationSteps) machineDeploymentUpgraded(ctx context.Context, name, version string) (bool, string, error) {
+	md := &apiv1.NodeDeployment{}
+	if err := s.operations.GetMachineDeployment.Call(ctx, s.machineDeploymentVars(name), nil, nil, md); err != nil {
+		return false, "", err
+	}
+	replicas := md.Spec.Replicas
+	if md.Status.Replicas != replicas || md.Status.UpdatedReplicas != replicas || md.Status.AvailableReplicas != replicas {
+		return false, fmt.Sprintf("%s has %d of %d replicas updated and %d available", name, md.Status.UpdatedReplicas, replicas, md.Status.AvailableReplicas), nil
+	}
+
+	var nodes []apiv1.Node
+	if err := s.operations.ListMachineDeploymentNodes.Call(ctx, s.machineDeploymentVars(name), nil, nil, &nodes); err != nil {
+		return false, "", err
+	}
+	for _, node := range nodes {
+		if kubelet := strings.TrimPrefix(node.Status.NodeInfo.KubeletVersion, "v"); kubelet != version {
+			return false, fmt.Sprintf("the node %s of %s runs kubelet %q", node.Name, name, kubelet), nil
+		}
+	}
+	return true, "", nil
+}
+
+// healthy tells whether the control plane components required by every cluster are up.
+func (s operationSteps) healthy(ctx context.Context) (bool, string, error) {
+	health := &apiv1.ClusterHealth{}
+	if err := s.operations.GetClusterHealth.Call(ctx, s.vars(), nil, nil, health); err != nil {
+		return false, "", err
+	}
+
+	components := map[string]kubermaticv1.HealthStatus{
+		"apiserver":                    health.Apiserver,
+		"scheduler":                    health.Scheduler,
+		"controller":                   health.Controller,
+		"machineController":            health.MachineController,
+		"etcd":                         health.Etcd,
+		"cloudProviderInfrastructure":  health.CloudProviderInfrastructure,
+		"userClusterControllerManager": health.UserClusterControllerManager,
+	}
+
+	var unhealthy []string
+	for name, status := range components {
+		if status != kubermaticv1.HealthStatusUp {
+			unhealthy = append(unhealthy, name)
+		}
+	}
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		return false, fmt.Sprintf("the components %s are not healthy", strings.Join(unhealthy, ", ")), nil
+	}
+	return true, "", nil
+}
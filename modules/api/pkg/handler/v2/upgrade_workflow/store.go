@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradeworkflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// workflowLabelKey marks the ConfigMaps of the workflows, its value is the ID of the cluster.
+	workflowLabelKey = "dashboard.kubermatic.io/upgrade-workflow"
+
+	// The keys of the ConfigMap of a workflow.
+	projectIDKey = "projectID"
+	workflowKey  = "workflow"
+)
+
+// Workflows are the upgrade workflows of the clusters, the latest one per cluster. The workflows are stored in
+// ConfigMaps, so that every replica of the API server can return and control them, and are run by the replica
+// which is the leader.
+type Workflows struct {
+	client       ctrlruntimeclient.Client
+	namespace    string
+	pollInterval time.Duration
+}
+
+func NewWorkflows(client ctrlruntimeclient.Client, namespace string) *Workflows {
+	return &Workflows{
+		client:       client,
+		namespace:    namespace,
+		pollInterval: defaultPollInterval,
+	}
+}
+
+func configMapName(clusterID string) string {
+	return "upgrade-workflow-" + clusterID
+}
+
+func (w *Workflows) key(clusterID string) types.NamespacedName {
+	return types.NamespacedName{Namespace: w.namespace, Name: configMapName(clusterID)}
+}
+
+func decode(configMap *corev1.ConfigMap) (*workflow, error) {
+	wf := &workflow{
+		projectID: configMap.Data[projectIDKey],
+		clusterID: configMap.Labels[workflowLabelKey],
+	}
+	if err := json.Unmarshal([]byte(configMap.Data[workflowKey]), &wf.status); err != nil {
+		return nil, fmt.Errorf("invalid upgrade workflow %s: %w", configMap.Name, err)
+	}
+	return wf, nil
+}
+
+// encode stores the workflow in the ConfigMap and returns whether it changed.
+func encode(wf *workflow, configMap *corev1.ConfigMap) (bool, error) {
+	data, err := json.Marshal(wf.status)
+	if err != nil {
+		return false, err
+	}
+
+	changed := configMap.Data[workflowKey] != string(data) || configMap.Data[projectIDKey] != wf.projectID
+	configMap.Data = map[string]string{
+		projectIDKey: wf.projectID,
+		workflowKey:  string(data),
+	}
+	return changed, nil
+}
+
+// start stores a new workflow for the cluster, unless the previous one is still active. Its stages are run by the
+// leader.
+func (w *Workflows) start(ctx context.Context, opts options) (apiv2.ClusterUpgradeWorkflow, error) {
+	wf := newWorkflow(opts)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName(opts.clusterID),
+			Namespace: w.namespace,
+			Labels:    map[string]string{workflowLabelKey: opts.clusterID},
+		},
+	}
+	if _, err := encode(wf, configMap); err != nil {
+		return apiv2.ClusterUpgradeWorkflow{}, err
+	}
+	err := w.client.Create(ctx, configMap)
+	if err == nil {
+		return wf.status, nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return apiv2.ClusterUpgradeWorkflow{}, err
+	}
+
+	// the previous workflow is replaced once it ended
+	_, err = w.update(ctx, opts.clusterID, func(previous *workflow) error {
+		if previous.active() {
+			return utilerrors.New(http.StatusConflict, fmt.Sprintf("the upgrade workflow %s is still %s", previous.status.ID, strings.ToLower(previous.status.Phase)))
+		}
+		*previous = *wf
+		return nil
+	})
+	return wf.status, err
+}
+
+// get returns the latest workflow of the cluster.
+func (w *Workflows) get(ctx context.Context, clusterID string) (apiv2.ClusterUpgradeWorkflow, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := w.client.Get(ctx, w.key(clusterID), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return apiv2.ClusterUpgradeWorkflow{}, utilerrors.New(http.StatusNotFound, "the cluster has no upgrade workflow")
+		}
+		return apiv2.ClusterUpgradeWorkflow{}, err
+	}
+
+	wf, err := decode(configMap)
+	if err != nil {
+		return apiv2.ClusterUpgradeWorkflow{}, err
+	}
+	return wf.status, nil
+}
+
+// update changes the latest workflow of the cluster. The change is retried if the workflow was changed
+// concurrently, e.g. by the leader running its stages.
+func (w *Workflows) update(ctx context.Context, clusterID string, change func(*workflow) error) (apiv2.ClusterUpgradeWorkflow, error) {
+	var status apiv2.ClusterUpgradeWorkflow
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap := &corev1.ConfigMap{}
+		if err := w.client.Get(ctx, w.key(clusterID), configMap); err != nil {
+			if apierrors.IsNotFound(err) {
+				return utilerrors.New(http.StatusNotFound, "the cluster has no upgrade workflow")
+			}
+			return err
+		}
+
+		wf, err := decode(configMap)
+		if err != nil {
+			return err
+		}
+		if err := change(wf); err != nil {
+			return err
+		}
+		if _, err := encode(wf, configMap); err != nil {
+			return err
+		}
+		if err := w.client.Update(ctx, configMap); err != nil {
+			return err
+		}
+
+		status = wf.status
+		return nil
+	})
+	return status, err
+}
+
+// stepsFunc returns the steps of a workflow and the context to run them in.
+type stepsFunc func(ctx context.Context, wf *workflow) (context.Context, steps, error)
+
+// Run runs the stages of the active workflows through the operations until the context is cancelled. It is run by
+// the leader of the API replicas only, the other replicas only store the workflows started, paused, resumed and
+// aborted by the users. requestContext adds the values to the context which the server adds to the requests.
+func (w *Workflows) Run(ctx context.Context, operations Operations, userProvider provider.UserProvider, requestContext func(context.Context) context.Context, log *zap.SugaredLogger) {
+	newSteps := operationStepsFunc(operations, userProvider, requestContext)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		w.reconcile(ctx, newSteps, log)
+	}, w.pollInterval)
+}
+
+// reconcile advances the active workflows by one step each.
+func (w *Workflows) reconcile(ctx context.Context, newSteps stepsFunc, log *zap.SugaredLogger) {
+	configMaps := &corev1.ConfigMapList{}
+	if err := w.client.List(ctx, configMaps, ctrlruntimeclient.InNamespace(w.namespace), ctrlruntimeclient.HasLabels{workflowLabelKey}); err != nil {
+		log.Errorw("failed to list the cluster upgrade workflows", "error", err)
+		return
+	}
+
+	for i := range configMaps.Items {
+		configMap := &configMaps.Items[i]
+		if err := w.advance(ctx, configMap, newSteps); err != nil {
+			log.Warnw("failed to advance the cluster upgrade workflow", "cluster", configMap.Labels[workflowLabelKey], "error", err)
+		}
+	}
+}
+
+// advance runs the next step of the workflow and stores it. If the workflow was changed meanwhile, e.g. paused by
+// a user, the update fails and the step is repeated the next time.
+func (w *Workflows) advance(ctx context.Context, configMap *corev1.ConfigMap, newSteps stepsFunc) error {
+	wf, err := decode(configMap)
+	if err != nil {
+		return err
+	}
+	if !wf.active() {
+		return nil
+	}
+
+	stepsCtx, steps, err := newSteps(ctx, wf)
+	if err != nil {
+		wf.event(apiv2.UpgradeWorkflowEventWarning, "Cannot act on behalf of %s: %v", wf.status.CreatedBy, err)
+		wf.finish(apiv2.UpgradeWorkflowFailed)
+	} else {
+		wf.advance(stepsCtx, steps)
+	}
+
+	changed, err := encode(wf, configMap)
+	if err != nil || !changed {
+		return err
+	}
+	return w.client.Update(ctx, configMap)
+}
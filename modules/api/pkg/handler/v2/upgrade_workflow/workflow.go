@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package upgradeworkflow upgrades the control plane and then the machine deployments of a cluster in stages,
+// waiting for the cluster to become healthy again after each stage.
+package upgradeworkflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+const (
+	defaultStageTimeout = 30 * time.Minute
+	defaultPollInterval = 15 * time.Second
+	// maxEvents is the number of events kept per workflow.
+	maxEvents = 200
+)
+
+// steps performs the upgrades of the stages and tells when they are done.
+type steps interface {
+	upgradeControlPlane(ctx context.Context, version string) error
+	upgradeMachineDeployment(ctx context.Context, name, version string) error
+
+	// The checks return whether the upgrade is done and otherwise what is awaited.
+	controlPlaneUpgraded(ctx context.Context, version string) (bool, string, error)
+	machineDeploymentUpgraded(ctx context.Context, name, version string) (bool, string, error)
+	healthy(ctx context.Context) (bool, string, error)
+}
+
+// stagesFromPlan turns the steps of an upgrade plan into stages. Consecutive machine deployment upgrades to the
+// same version are done in batches of the given size.
+func stagesFromPlan(plan []apiv1.UpgradePlanStep, batchSize int) []apiv2.ClusterUpgradeWorkflowStage {
+	var stages []apiv2.ClusterUpgradeWorkflowStage
+
+	for _, step := range plan {
+		if step.Kind == apiv1.UpgradePlanStepControlPlane {
+			stages = append(stages, apiv2.ClusterUpgradeWorkflowStage{
+				Kind:    apiv2.UpgradeWorkflowStageControlPlane,
+				Version: step.To,
+			})
+			continue
+		}
+
+		last := len(stages) - 1
+		if last < 0 || stages[last].Kind != apiv2.UpgradeWorkflowStageMachineDeployments || stages[last].Version != step.To || len(stages[last].MachineDeployments) >= batchSize {
+			stages = append(stages, apiv2.ClusterUpgradeWorkflowStage{
+				Kind:    apiv2.UpgradeWorkflowStageMachineDeployments,
+				Version: step.To,
+			})
+			last++
+		}
+		stages[last].MachineDeployments = append(stages[last].MachineDeployments, step.Name)
+	}
+
+	for i := range stages {
+		stages[i].Name = fmt.Sprintf("%d-%s", i+1, strings.ToLower(stages[i].Kind))
+		stages[i].Phase = apiv2.UpgradeWorkflowStagePending
+	}
+	return stages
+}
+
+// options are the settings of a new workflow.
+type options struct {
+	projectID     string
+	clusterID     string
+	createdBy     string
+	targetVersion string
+	batchSize     int
+	stageTimeout  time.Duration
+	stages        []apiv2.ClusterUpgradeWorkflowStage
+	// warnings are added as the first events, e.g. the warnings of the upgrade plan.
+	warnings []string
+}
+
+// workflow is the upgrade of a cluster. It is a copy of the stored state, which is changed by the replicas
+// serving the requests of the users and by the leader running the stages.
+type workflow struct {
+	projectID string
+	clusterID string
+	status    apiv2.ClusterUpgradeWorkflow
+}
+
+func newWorkflow(opts options) *workflow {
+	w := &workflow{
+		projectID: opts.projectID,
+		clusterID: opts.clusterID,
+		status: apiv2.ClusterUpgradeWorkflow{
+			ID:                uuid.New().String(),
+			TargetVersion:     opts.targetVersion,
+			BatchSize:         opts.batchSize,
+			StageTimeout:      opts.stageTimeout.String(),
+			Phase:             apiv2.UpgradeWorkflowRunning,
+			CreatedBy:         opts.createdBy,
+			CreationTimestamp: apiv1.NewTime(time.Now()),
+			Stages:            opts.stages,
+			Events:            []apiv2.ClusterUpgradeWorkflowEvent{},
+		},
+	}
+
+	w.event(apiv2.UpgradeWorkflowEventNormal, "Started by %s to upgrade to %s in %d stages", opts.createdBy, opts.targetVersion, len(opts.stages))
+	for _, warning := range opts.warnings {
+		w.event(apiv2.UpgradeWorkflowEventWarning, "%s", warning)
+	}
+	return w
+}
+
+func (w *workflow) active() bool {
+	return w.status.Phase == apiv2.UpgradeWorkflowRunning || w.status.Phase == apiv2.UpgradeWorkflowPaused
+}
+
+// pause stops the workflow before the next stage, a running stage is completed.
+func (w *workflow) pause(user string) error {
+	if w.status.Phase != apiv2.UpgradeWorkflowRunning {
+		return utilerrors.New(http.StatusConflict, fmt.Sprintf("only running workflows can be paused, the workflow is %s", strings.ToLower(w.status.Phase)))
+	}
+	w.status.Phase = apiv2.UpgradeWorkflowPaused
+	w.event(apiv2.UpgradeWorkflowEventNormal, "Paused by %s, a running stage is completed", user)
+	return nil
+}
+
+// resume continues a paused workflow.
+func (w *workflow) resume(user string) error {
+	if w.status.Phase != apiv2.UpgradeWorkflowPaused {
+		return utilerrors.New(http.StatusConflict, fmt.Sprintf("only paused workflows can be resumed, the workflow is %s", strings.ToLower(w.status.Phase)))
+	}
+	w.status.Phase = apiv2.UpgradeWorkflowRunning
+	w.event(apiv2.UpgradeWorkflowEventNormal, "Resumed by %s", user)
+	return nil
+}
+
+// abort ends the workflow, no further upgrades are started. The upgrades which were already done are kept.
+func (w *workflow) abort(user string) error {
+	if !w.active() {
+		return utilerrors.New(http.StatusConflict, "the workflow has already ended")
+	}
+
+	w.event(apiv2.UpgradeWorkflowEventWarning, "Aborted by %s", user)
+	if i := w.currentStage(); i >= 0 && w.status.Stages[i].Phase == apiv2.UpgradeWorkflowStageRunning {
+		w.updateStage(i, apiv2.UpgradeWorkflowStageAborted, "The workflow was aborted")
+	}
+	w.finish(apiv2.UpgradeWorkflowAborted)
+	return nil
+}
+
+// currentStage returns the index of the first stage which did not succeed, or -1 if all did.
+func (w *workflow) currentStage() int {
+	for i, stage := range w.status.Stages {
+		if stage.Phase != apiv2.UpgradeWorkflowStageSucceeded {
+			return i
+		}
+	}
+	return -1
+}
+
+func (w *workflow) stageTimeout() time.Duration {
+	timeout, err := time.ParseDuration(w.status.StageTimeout)
+	if err != nil {
+		return defaultStageTimeout
+	}
+	return timeout
+}
+
+func (w *workflow) event(eventType, format string, args ...interface{}) {
+	w.status.Events = append(w.status.Events, apiv2.ClusterUpgradeWorkflowEvent{
+		Timestamp: apiv1.NewTime(time.Now()),
+		Type:      eventType,
+		Message:   fmt.Sprintf(format, args...),
+	})
+	if len(w.status.Events) > maxEvents {
+		w.status.Events = w.status.Events[len(w.status.Events)-maxEvents:]
+	}
+}
+
+func (w *workflow) updateStage(i int, phase, message string) {
+	stage := &w.status.Stages[i]
+	now := apiv1.NewTime(time.Now())
+
+	switch phase {
+	case apiv2.UpgradeWorkflowStageRunning:
+		if stage.StartTimestamp == nil {
+			stage.StartTimestamp = &now
+			w.event(apiv2.UpgradeWorkflowEventNormal, "Stage %s started", stage.Name)
+		}
+	case apiv2.UpgradeWorkflowStageSucceeded:
+		stage.CompletionTimestamp = &now
+		w.status.CompletedStages++
+		w.event(apiv2.UpgradeWorkflowEventNormal, "Stage %s succeeded", stage.Name)
+	default:
+		stage.CompletionTimestamp = &now
+		w.event(apiv2.UpgradeWorkflowEventWarning, "Stage %s %s: %s", stage.Name, strings.ToLower(phase), message)
+	}
+
+	stage.Phase = phase
+	stage.Message = message
+}
+
+func (w *workflow) finish(phase string) {
+	now := apiv1.NewTime(time.Now())
+	w.status.Phase = phase
+	w.status.CompletionTimestamp = &now
+
+	eventType := apiv2.UpgradeWorkflowEventNormal
+	if phase != apiv2.UpgradeWorkflowSucceeded {
+		eventType = apiv2.UpgradeWorkflowEventWarning
+	}
+	w.event(eventType, "The workflow %s", strings.ToLower(phase))
+}
+
+// advance runs the next step of an active workflow: it starts the upgrades of a pending stage, unless the workflow
+// is paused, or checks whether the running stage is done. The upgrades are patches to the target version, so
+// starting a stage again after the state could not be stored does not change the outcome.
+func (w *workflow) advance(ctx context.Context, steps steps) {
+	i := w.currentStage()
+	if i < 0 {
+		w.finish(apiv2.UpgradeWorkflowSucceeded)
+		return
+	}
+	stage := w.status.Stages[i]
+
+	switch stage.Phase {
+	case apiv2.UpgradeWorkflowStagePending:
+		if w.status.Phase == apiv2.UpgradeWorkflowPaused {
+			return
+		}
+		w.updateStage(i, apiv2.UpgradeWorkflowStageRunning, "")
+		if err := w.startStage(ctx, steps, stage); err != nil {
+			w.updateStage(i, apiv2.UpgradeWorkflowStageFailed, err.Error())
+			w.finish(apiv2.UpgradeWorkflowFailed)
+		}
+
+	case apiv2.UpgradeWorkflowStageRunning:
+		done, message, err := stageDone(ctx, steps, stage)
+		// the cluster is expected to be unavailable at times during the upgrade, so errors are retried
+		if err != nil {
+			message = err.Error()
+		}
+
+		switch {
+		case done:
+			w.updateStage(i, apiv2.UpgradeWorkflowStageSucceeded, "")
+			if i == len(w.status.Stages)-1 {
+				w.finish(apiv2.UpgradeWorkflowSucceeded)
+			}
+		case stage.StartTimestamp != nil && time.Since(stage.StartTimestamp.Time) > w.stageTimeout():
+			w.updateStage(i, apiv2.UpgradeWorkflowStageFailed, fmt.Sprintf("the stage did not finish within %s: %s", w.status.StageTimeout, message))
+			w.finish(apiv2.UpgradeWorkflowFailed)
+		case stage.Message != "Waiting: "+message:
+			w.updateStage(i, apiv2.UpgradeWorkflowStageRunning, "Waiting: "+message)
+		}
+
+	default:
+		// a stage which failed or was aborted ends the workflow
+		w.finish(apiv2.UpgradeWorkflowFailed)
+	}
+}
+
+// startStage upgrades the control plane or the machine deployments of the stage.
+func (w *workflow) startStage(ctx context.Context, steps steps, stage apiv2.ClusterUpgradeWorkflowStage) error {
+	if stage.Kind == apiv2.UpgradeWorkflowStageControlPlane {
+		if err := steps.upgradeControlPlane(ctx, stage.Version); err != nil {
+			return fmt.Errorf("failed to upgrade the control plane: %w", err)
+		}
+		w.event(apiv2.UpgradeWorkflowEventNormal, "Upgrading the control plane to %s", stage.Version)
+		return nil
+	}
+
+	for _, name := range stage.MachineDeployments {
+		if err := steps.upgradeMachineDeployment(ctx, name, stage.Version); err != nil {
+			return fmt.Errorf("failed to upgrade the machine deployment %s: %w", name, err)
+		}
+		w.event(apiv2.UpgradeWorkflowEventNormal, "Upgrading the machine deployment %s to %s", name, stage.Version)
+	}
+	return nil
+}
+
+// stageDone tells whether the control plane or the machine deployments of the stage are upgraded and the cluster
+// is healthy.
+func stageDone(ctx context.Context, steps steps, stage apiv2.ClusterUpgradeWorkflowStage) (bool, string, error) {
+	if stage.Kind == apiv2.UpgradeWorkflowStageControlPlane {
+		if done, message, err := steps.controlPlaneUpgraded(ctx, stage.Version); !done || err != nil {
+			return done, message, err
+		}
+	}
+	for _, name := range stage.MachineDeployments {
+		if done, message, err := steps.machineDeploymentUpgraded(ctx, name, stage.Version); !done || err != nil {
+			return done, message, err
+		}
+	}
+	return steps.healthy(ctx)
+}
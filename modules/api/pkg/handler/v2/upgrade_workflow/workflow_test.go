@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgradeworkflow
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+)
+
+// fakeSteps records the upgrades, which are done as soon as ready is true.
+type fakeSteps struct {
+	lock     sync.Mutex
+	upgrades []string
+	ready    bool
+}
+
+func (f *fakeSteps) upgradeControlPlane(_ context.Context, version string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.upgrades = append(f.upgrades, "control-plane="+version)
+	return nil
+}
+
+func (f *fakeSteps) upgradeMachineDeployment(_ context.Context, name, version string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.upgrades = append(f.upgrades, name+"="+version)
+	return nil
+}
+
+func (f *fakeSteps) done() (bool, string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.ready, "still rolling out", nil
+}
+
+func (f *fakeSteps) controlPlaneUpgraded(context.Context, string) (bool, string, error) {
+	return f.done()
+}
+
+func (f *fakeSteps) machineDeploymentUpgraded(context.Context, string, string) (bool, string, error) {
+	return f.done()
+}
+
+func (f *fakeSteps) healthy(context.Context) (bool, string, error) {
+	return f.done()
+}
+
+func (f *fakeSteps) setReady(ready bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.ready = ready
+}
+
+func (f *fakeSteps) upgraded() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]string{}, f.upgrades...)
+}
+
+func testStages() []apiv2.ClusterUpgradeWorkflowStage {
+	return stagesFromPlan([]apiv1.UpgradePlanStep{
+		{Kind: apiv1.UpgradePlanStepControlPlane, Name: "abc123", From: "1.30.5", To: "1.31.2"},
+		{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "a", From: "1.30.5", To: "1.31.2"},
+		{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "b", From: "1.30.5", To: "1.31.2"},
+	}, 1)
+}
+
+func startWorkflow(t *testing.T, stageTimeout time.Duration) *Workflows {
+	workflows := NewWorkflows(fake.NewClientBuilder().Build(), "kubermatic")
+
+	opts := options{
+		projectID:     "my-first-project-ID",
+		clusterID:     "abc123",
+		createdBy:     "bob@acme.com",
+		targetVersion: "1.31.2",
+		batchSize:     1,
+		stageTimeout:  stageTimeout,
+		stages:        testStages(),
+	}
+	if _, err := workflows.start(context.Background(), opts); err != nil {
+		t.Fatalf("failed to start the workflow: %v", err)
+	}
+	if _, err := workflows.start(context.Background(), opts); err == nil {
+		t.Error("expected a second workflow for the cluster to be rejected")
+	}
+	return workflows
+}
+
+// runSteps runs the given number of steps of the workflows, like the leader does.
+func runSteps(workflows *Workflows, fake *fakeSteps, times int) apiv2.ClusterUpgradeWorkflow {
+	newSteps := func(ctx context.Context, _ *workflow) (context.Context, steps, error) {
+		return ctx, fake, nil
+	}
+	for range times {
+		workflows.reconcile(context.Background(), newSteps, zap.NewNop().Sugar())
+	}
+
+	status, _ := workflows.get(context.Background(), "abc123")
+	return status
+}
+
+func control(t *testing.T, workflows *Workflows, change func(*workflow) error) error {
+	t.Helper()
+	_, err := workflows.update(context.Background(), "abc123", change)
+	return err
+}
+
+func TestStagesFromPlan(t *testing.T) {
+	stages := stagesFromPlan([]apiv1.UpgradePlanStep{
+		{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "legacy", To: "1.30.5"},
+		{Kind: apiv1.UpgradePlanStepControlPlane, Name: "abc123", To: "1.31.2"},
+		{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "a", To: "1.31.2"},
+		{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "b", To: "1.31.2"},
+		{Kind: apiv1.UpgradePlanStepMachineDeployment, Name: "legacy", To: "1.31.2"},
+	}, 2)
+
+	var names []string
+	for _, stage := range stages {
+		names = append(names, stage.Name+":"+strings.Join(stage.MachineDeployments, ","))
+	}
+	expected := []string{"1-machinedeployments:legacy", "2-controlplane:", "3-machinedeployments:a,b", "4-machinedeployments:legacy"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected stages %v, got %v", expected, names)
+	}
+}
+
+func TestWorkflowPauseResume(t *testing.T) {
+	steps := &fakeSteps{}
+	workflows := startWorkflow(t, time.Minute)
+
+	status := runSteps(workflows, steps, 2)
+	if stage := status.Stages[0]; stage.Phase != apiv2.UpgradeWorkflowStageRunning || !strings.HasPrefix(stage.Message, "Waiting") {
+		t.Fatalf("expected the first stage to wait for the upgrade, got %+v", stage)
+	}
+	if err := control(t, workflows, func(wf *workflow) error { return wf.resume("alice@acme.com") }); err == nil {
+		t.Error("expected a running workflow not to be resumable")
+	}
+	if err := control(t, workflows, func(wf *workflow) error { return wf.pause("alice@acme.com") }); err != nil {
+		t.Fatalf("failed to pause: %v", err)
+	}
+	steps.setReady(true)
+
+	// the running stage is completed, the next one is not started
+	status = runSteps(workflows, steps, 3)
+	if status.CompletedStages != 1 || status.Phase != apiv2.UpgradeWorkflowPaused {
+		t.Fatalf("expected the paused workflow to complete the running stage, got %+v", status)
+	}
+	if upgraded := steps.upgraded(); !reflect.DeepEqual(upgraded, []string{"control-plane=1.31.2"}) {
+		t.Fatalf("expected only the control plane to be upgraded while paused, got %v", upgraded)
+	}
+
+	if err := control(t, workflows, func(wf *workflow) error { return wf.resume("alice@acme.com") }); err != nil {
+		t.Fatalf("failed to resume: %v", err)
+	}
+	status = runSteps(workflows, steps, 4)
+
+	if status.Phase != apiv2.UpgradeWorkflowSucceeded || status.CompletedStages != 3 {
+		t.Errorf("expected the workflow to succeed with 3 stages, got %s with %d", status.Phase, status.CompletedStages)
+	}
+	if upgraded := steps.upgraded(); !reflect.DeepEqual(upgraded, []string{"control-plane=1.31.2", "a=1.31.2", "b=1.31.2"}) {
+		t.Errorf("unexpected order of the upgrades %v", upgraded)
+	}
+	if err := control(t, workflows, func(wf *workflow) error { return wf.abort("alice@acme.com") }); err == nil {
+		t.Error("expected an ended workflow not to be abortable")
+	}
+	if _, err := workflows.start(context.Background(), options{clusterID: "abc123", stages: testStages()}); err != nil {
+		t.Errorf("expected an ended workflow to be replaced, got %v", err)
+	}
+}
+
+func TestWorkflowAbort(t *testing.T) {
+	steps := &fakeSteps{}
+	workflows := startWorkflow(t, time.Minute)
+
+	runSteps(workflows, steps, 1)
+	if err := control(t, workflows, func(wf *workflow) error { return wf.abort("alice@acme.com") }); err != nil {
+		t.Fatalf("failed to abort: %v", err)
+	}
+
+	// the leader does not start further upgrades
+	steps.setReady(true)
+	status := runSteps(workflows, steps, 2)
+	if status.Phase != apiv2.UpgradeWorkflowAborted || status.Stages[0].Phase != apiv2.UpgradeWorkflowStageAborted || status.Stages[1].Phase != apiv2.UpgradeWorkflowStagePending {
+		t.Errorf("expected the workflow and its running stage to be aborted, got %+v", status)
+	}
+	if upgraded := steps.upgraded(); len(upgraded) != 1 {
+		t.Errorf("expected no upgrades after the abort, got %v", upgraded)
+	}
+}
+
+func TestWorkflowTimeout(t *testing.T) {
+	workflows := startWorkflow(t, 20*time.Millisecond)
+	steps := &fakeSteps{}
+
+	runSteps(workflows, steps, 1)
+	time.Sleep(30 * time.Millisecond)
+	status := runSteps(workflows, steps, 1)
+
+	if status.Phase != apiv2.UpgradeWorkflowFailed || status.Stages[0].Phase != apiv2.UpgradeWorkflowStageFailed {
+		t.Fatalf("expected the workflow to fail, got %+v", status)
+	}
+	if message := status.Stages[0].Message; !strings.Contains(message, "still rolling out") {
+		t.Errorf("expected the failure to tell what was awaited, got %q", message)
+	}
+}
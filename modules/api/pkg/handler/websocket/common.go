@@ -17,13 +17,47 @@ limitations under the License.
 package websocket
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/gorilla/websocket"
 )
 
+// Conn is the connection a watch stream is written to. It is implemented by websocket connections and by the
+// Server-Sent Events streams, so that every watch can be consumed with both transports.
+type Conn interface {
+	WriteMessage(messageType int, data []byte) error
+	WriteControl(messageType int, data []byte, deadline time.Time) error
+	SetWriteDeadline(t time.Time) error
+	SetCloseHandler(h func(code int, text string) error)
+	Close() error
+}
+
+// EventWriter is implemented by connections which can send an ID along with a message.
+// Clients use the ID of the last received message to resume the stream.
+type EventWriter interface {
+	WriteEvent(id string, data []byte) error
+}
+
+// writeEvent writes the message together with its ID if the connection supports it.
+func writeEvent(ws Conn, id string, data []byte) error {
+	if w, ok := ws.(EventWriter); ok {
+		return w.WriteEvent(id, data)
+	}
+	return ws.WriteMessage(websocket.TextMessage, data)
+}
+
+// stateEventID returns the ID of a message of a watch which sends the whole state of an object with every change,
+// like the settings and the user. The ID is derived from the message, so a client which reconnects with the ID of
+// the last received message only gets the state again if it changed meanwhile.
+func stateEventID(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
 // writes close control message to the websocket connection. Same as the default websocket close handler.
-func writeCloseMessage(ws *websocket.Conn, code int) error {
+func writeCloseMessage(ws Conn, code int) error {
 	message := websocket.FormatCloseMessage(code, "")
 	return ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second))
 }
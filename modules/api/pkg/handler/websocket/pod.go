@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/kubectl/pkg/scheme"
+)
+
+const (
+	PodExecEnded TerminalConnStatus = "POD_EXEC_ENDED"
+	PodLogsEnded TerminalConnStatus = "POD_LOGS_ENDED"
+
+	// defaultContainerAnnotation selects the container of pods with several containers, like kubectl does.
+	defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+)
+
+var defaultExecCommand = []string{"/bin/sh"}
+
+// PodExecOptions are the options of an exec session in a workload pod.
+type PodExecOptions struct {
+	// Container is the container the command is executed in, the default container of the pod if empty.
+	Container string
+	Command   []string
+	TTY       bool
+}
+
+// DecodePodExecOptions reads the exec options from the query, the command is passed as repeated command parameters.
+func DecodePodExecOptions(r *http.Request) (PodExecOptions, error) {
+	query := r.URL.Query()
+	options := PodExecOptions{
+		Container: query.Get("container"),
+		Command:   query["command"],
+		TTY:       true,
+	}
+
+	if len(options.Command) == 0 {
+		options.Command = defaultExecCommand
+	}
+	if tty := query.Get("tty"); tty != "" {
+		var err error
+		if options.TTY, err = strconv.ParseBool(tty); err != nil {
+			return options, utilerrors.NewBadRequest("invalid value for tty: %v", err)
+		}
+	}
+
+	return options, nil
+}
+
+// DecodePodLogOptions reads the log options from the query.
+func DecodePodLogOptions(r *http.Request) (*corev1.PodLogOptions, error) {
+	query := r.URL.Query()
+	options := &corev1.PodLogOptions{
+		Container: query.Get("container"),
+	}
+
+	for name, value := range map[string]*bool{
+		"follow":     &options.Follow,
+		"previous":   &options.Previous,
+		"timestamps": &options.Timestamps,
+	} {
+		if raw := query.Get(name); raw != "" {
+			parsed, err := strconv.ParseBool(raw)
+			if err != nil {
+				return nil, utilerrors.NewBadRequest("invalid value for %s: %v", name, err)
+			}
+			*value = parsed
+		}
+	}
+
+	if raw := query.Get("tailLines"); raw != "" {
+		tailLines, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || tailLines < 0 {
+			return nil, utilerrors.NewBadRequest("tailLines must be a non-negative number")
+		}
+		options.TailLines = &tailLines
+	}
+
+	if raw := query.Get("sinceSeconds"); raw != "" {
+		sinceSeconds, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || sinceSeconds <= 0 {
+			return nil, utilerrors.NewBadRequest("sinceSeconds must be a positive number")
+		}
+		options.SinceSeconds = &sinceSeconds
+	}
+
+	if raw := query.Get("sinceTime"); raw != "" {
+		if options.SinceSeconds != nil {
+			return nil, utilerrors.NewBadRequest("only one of sinceSeconds and sinceTime can be set")
+		}
+		sinceTime, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("sinceTime must be a RFC3339 timestamp: %v", err)
+		}
+		options.SinceTime = &metav1.Time{Time: sinceTime}
+	}
+
+	return options, nil
+}
+
+// SelectContainer returns the container of the pod with the given name. If no name is given, it returns the
+// container selected by the default container annotation, or the first container.
+func SelectContainer(pod *corev1.Pod, name string) (string, error) {
+	if name == "" {
+		if annotated := pod.Annotations[defaultContainerAnnotation]; annotated != "" {
+			name = annotated
+		} else if len(pod.Spec.Containers) > 0 {
+			return pod.Spec.Containers[0].Name, nil
+		}
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return name, nil
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == name {
+			return name, nil
+		}
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		if container.Name == name {
+			return name, nil
+		}
+	}
+
+	return "", utilerrors.NewBadRequest("container %q not found in pod %s/%s", name, pod.Namespace, pod.Name)
+}
+
+// PodExec executes the command in the container of the pod and connects it with the websocket using the
+// TerminalMessage protocol. Errors are sent to the client as message, the caller must not write to the websocket.
+func PodExec(ctx context.Context, ws *websocket.Conn, cfg *rest.Config, pod *corev1.Pod, options PodExecOptions) error {
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		_ = SendMessage(ws, err.Error())
+		return err
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(pod.Namespace).
+		SubResource("exec")
+
+	req.VersionedParams(&corev1.PodExecOptions{
+		Container: options.Container,
+		Command:   options.Command,
+		Stdin:     true,
+		Stdout:    true,
+		Stderr:    !options.TTY,
+		TTY:       options.TTY,
+	}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(cfg, http.MethodPost, req.URL())
+	if err != nil {
+		_ = SendMessage(ws, err.Error())
+		return err
+	}
+
+	session, streamOptions := newPodExecSession(ws, options.TTY)
+	defer close(session.doneChan)
+
+	go session.pingRoutine(pingInterval)
+
+	if err := exec.StreamWithContext(ctx, streamOptions); err != nil {
+		// the error is sent through the session, the ping routine may still be writing
+		_ = session.SendMessage(err.Error())
+		return err
+	}
+
+	return session.SendMessage(string(PodExecEnded))
+}
+
+// newPodExecSession returns the session of an exec and the options which stream the exec through it. Without TTY,
+// stdout and stderr are written from different goroutines, so all writes to the websocket are serialized.
+func newPodExecSession(ws *websocket.Conn, tty bool) (TerminalSession, remotecommand.StreamOptions) {
+	session := TerminalSession{
+		websocketConn: ws,
+		doneChan:      make(chan struct{}),
+		writeLock:     &sync.Mutex{},
+	}
+	if tty {
+		session.sizeChan = make(chan remotecommand.TerminalSize)
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  session,
+		Stdout: session,
+		Tty:    tty,
+	}
+	if tty {
+		streamOptions.TerminalSizeQueue = session
+	} else {
+		streamOptions.Stderr = session
+	}
+
+	return session, streamOptions
+}
+
+// PodLogs streams the logs of the container of the pod into the websocket. Every chunk of the log is sent
+// as a stdout message.
+func PodLogs(ctx context.Context, ws *websocket.Conn, client kubernetes.Interface, pod *corev1.Pod, options *corev1.PodLogOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the client only answers pings, a failed read means it disconnected
+	go func() {
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	stream, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	lines := make(chan string)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		reader := bufio.NewReader(stream)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	// all messages are written here, websocket connections do not support concurrent writers
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ping.C:
+			if err := SendMessage(ws, pingMessage); err != nil {
+				return nil
+			}
+		case line, ok := <-lines:
+			if !ok {
+				var err error
+				select {
+				case err = <-readErr:
+				default:
+				}
+				if err != nil && !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					return fmt.Errorf("failed to read logs: %w", err)
+				}
+				return SendMessage(ws, string(PodLogsEnded))
+			}
+			if err := ws.WriteJSON(TerminalMessage{Op: "stdout", Data: line}); err != nil {
+				return nil
+			}
+		}
+	}
+}
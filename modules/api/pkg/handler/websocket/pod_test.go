@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDecodePodExecOptions(t *testing.T) {
+	options, err := DecodePodExecOptions(httptest.NewRequest("GET", "/exec", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !options.TTY || !reflect.DeepEqual(options.Command, defaultExecCommand) {
+		t.Errorf("expected an interactive shell by default, got %+v", options)
+	}
+
+	options, err = DecodePodExecOptions(httptest.NewRequest("GET", "/exec?container=app&command=ls&command=-la&tty=false", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := PodExecOptions{Container: "app", Command: []string{"ls", "-la"}, TTY: false}
+	if !reflect.DeepEqual(options, expected) {
+		t.Errorf("expected %+v, got %+v", expected, options)
+	}
+
+	if _, err := DecodePodExecOptions(httptest.NewRequest("GET", "/exec?tty=maybe", nil)); err == nil {
+		t.Error("expected an error for an invalid tty value")
+	}
+}
+
+func TestDecodePodLogOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		query       string
+		expectError bool
+		check       func(*corev1.PodLogOptions) bool
+	}{
+		{
+			name:  "follow the tail of the logs",
+			query: "container=app&follow=true&tailLines=100&timestamps=true",
+			check: func(o *corev1.PodLogOptions) bool {
+				return o.Container == "app" && o.Follow && o.Timestamps && !o.Previous && *o.TailLines == 100
+			},
+		},
+		{
+			name:  "since seconds",
+			query: "sinceSeconds=60",
+			check: func(o *corev1.PodLogOptions) bool { return *o.SinceSeconds == 60 && o.SinceTime == nil },
+		},
+		{
+			name:  "since time",
+			query: "sinceTime=2026-01-02T03:04:05Z",
+			check: func(o *corev1.PodLogOptions) bool { return o.SinceTime.Unix() == 1767323045 },
+		},
+		{name: "negative tail", query: "tailLines=-1", expectError: true},
+		{name: "zero since seconds", query: "sinceSeconds=0", expectError: true},
+		{name: "invalid since time", query: "sinceTime=yesterday", expectError: true},
+		{name: "both since options", query: "sinceSeconds=60&sinceTime=2026-01-02T03:04:05Z", expectError: true},
+		{name: "invalid follow", query: "follow=sometimes", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options, err := DecodePodLogOptions(httptest.NewRequest("GET", "/logs?"+tc.query, nil))
+			if (err != nil) != tc.expectError {
+				t.Fatalf("expected error=%v, got %v", tc.expectError, err)
+			}
+			if !tc.expectError && !tc.check(options) {
+				t.Errorf("unexpected options %+v", options)
+			}
+		})
+	}
+}
+
+func TestSelectContainer(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			InitContainers:      []corev1.Container{{Name: "init"}},
+			Containers:          []corev1.Container{{Name: "proxy"}, {Name: "app"}},
+			EphemeralContainers: []corev1.EphemeralContainer{{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"}}},
+		},
+	}
+
+	testCases := map[string]string{
+		"":         "proxy",
+		"app":      "app",
+		"init":     "init",
+		"debugger": "debugger",
+	}
+	for name, expected := range testCases {
+		if container, err := SelectContainer(pod, name); err != nil || container != expected {
+			t.Errorf("expected %q for %q, got %q, %v", expected, name, container, err)
+		}
+	}
+
+	if _, err := SelectContainer(pod, "missing"); err == nil {
+		t.Error("expected an error for an unknown container")
+	}
+
+	pod.Annotations = map[string]string{defaultContainerAnnotation: "app"}
+	if container, err := SelectContainer(pod, ""); err != nil || container != "app" {
+		t.Errorf("expected the annotated default container, got %q, %v", container, err)
+	}
+}
+
+// TestPodExecSessionConcurrentWrites writes to stdout and stderr of an exec without TTY while pings are sent, like
+// remotecommand does. Run it with -race, gorilla panics on concurrent writes to a connection.
+func TestPodExecSessionConcurrentWrites(t *testing.T) {
+	const writes = 100
+
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade: %v", err)
+			return
+		}
+		defer ws.Close()
+
+		session, streamOptions := newPodExecSession(ws, false)
+		if streamOptions.Stderr == nil {
+			t.Error("expected stderr to be streamed without TTY")
+			return
+		}
+		go session.pingRoutine(time.Millisecond)
+
+		var wg sync.WaitGroup
+		for _, stream := range []struct {
+			name   string
+			writer io.Writer
+		}{{"stdout", streamOptions.Stdout}, {"stderr", streamOptions.Stderr}} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < writes; i++ {
+					if _, err := fmt.Fprintf(stream.writer, "%s %d", stream.name, i); err != nil {
+						t.Errorf("failed to write to %s: %v", stream.name, err)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		close(session.doneChan)
+
+		if err := session.SendMessage(string(PodExecEnded)); err != nil {
+			t.Errorf("failed to end the session: %v", err)
+		}
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	output := map[string]int{}
+	for {
+		_, data, err := client.ReadMessage()
+		if err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		msg := TerminalMessage{}
+		if err := json.Unmarshal(data, &msg); err != nil {
+			t.Fatalf("failed to decode %q: %v", data, err)
+		}
+		if msg.Op == "msg" && msg.Data == string(PodExecEnded) {
+			break
+		}
+		if msg.Op == "stdout" {
+			output[strings.Fields(msg.Data)[0]]++
+		}
+	}
+
+	if output["stdout"] != writes || output["stderr"] != writes {
+		t.Errorf("expected %d messages of stdout and stderr each, got %v", writes, output)
+	}
+}
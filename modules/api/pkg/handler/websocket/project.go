@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"k8c.io/dashboard/v2/pkg/watcher"
+	"k8c.io/kubermatic/v2/pkg/log"
+)
+
+const (
+	// projectAccessCheckInterval is the interval in which the access of the user to the watched project is verified again.
+	projectAccessCheckInterval = time.Minute
+	projectWriteTimeout        = 10 * time.Second
+)
+
+// ProjectAccessChecker returns an error if the user is no longer allowed to read the project.
+type ProjectAccessChecker func(ctx context.Context) error
+
+func WriteProjectEvents(ctx context.Context, providers watcher.Providers, ws Conn, projectID, resourceVersion string, checkAccess ProjectAccessChecker) {
+	unSub := providers.ProjectWatcher.Subscribe(projectID, resourceVersion, func(rawEvent interface{}) {
+		event, ok := rawEvent.(watcher.ProjectEvent)
+		if !ok {
+			log.Logger.Warn("cannot convert event for project watch: %v", rawEvent)
+			return
+		}
+
+		response, err := json.Marshal(event)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		_ = ws.SetWriteDeadline(time.Now().Add(projectWriteTimeout))
+		if err := writeEvent(ws, event.ResourceVersion, response); err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+	})
+	defer unSub()
+
+	ws.SetCloseHandler(func(code int, text string) error {
+		unSub()
+		return writeCloseMessage(ws, code)
+	})
+
+	ticker := time.NewTicker(projectAccessCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := checkAccess(ctx); err != nil {
+				log.Logger.Debug(err)
+				_ = writeCloseMessage(ws, websocket.ClosePolicyViolation)
+				_ = ws.Close()
+				return
+			}
+		}
+	}
+}
@@ -20,8 +20,6 @@ import (
 	"context"
 	"encoding/json"
 
-	"github.com/gorilla/websocket"
-
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/v1/admin"
 	"k8c.io/dashboard/v2/pkg/watcher"
@@ -29,7 +27,9 @@ import (
 	"k8c.io/kubermatic/v2/pkg/log"
 )
 
-func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websocket.Conn) {
+// WriteSettings streams the global settings until the context is cancelled. The current settings are skipped if the
+// client resumes the stream with the ID of the last received settings and they did not change.
+func WriteSettings(ctx context.Context, providers watcher.Providers, ws Conn, lastEventID string) {
 	// There can be a race here if the settings change between getting the initial data and setting up the subscription
 	initialSettings, err := providers.SettingsProvider.GetGlobalSettings(ctx)
 	if err != nil {
@@ -43,9 +43,11 @@ func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websock
 		return
 	}
 
-	if err := ws.WriteMessage(websocket.TextMessage, initialResponse); err != nil {
-		log.Logger.Debug(err)
-		return
+	if id := stateEventID(initialResponse); id != lastEventID {
+		if err := writeEvent(ws, id, initialResponse); err != nil {
+			log.Logger.Debug(err)
+			return
+		}
 	}
 
 	unSub := providers.SettingsWatcher.Subscribe(func(settings interface{}) {
@@ -74,14 +76,18 @@ func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websock
 			}
 		}
 
-		if err := ws.WriteMessage(websocket.TextMessage, response); err != nil {
+		if err := writeEvent(ws, stateEventID(response), response); err != nil {
 			log.Logger.Debug(err)
 			return
 		}
 	})
+	defer unSub()
 
 	ws.SetCloseHandler(func(code int, text string) error {
 		unSub()
 		return writeCloseMessage(ws, code)
 	})
+
+	// the subscription is released once the client disconnected
+	<-ctx.Done()
 }
@@ -0,0 +1,442 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"k8c.io/dashboard/v2/pkg/recording"
+	"k8c.io/kubermatic/v2/pkg/log"
+)
+
+const (
+	// SharedSessionReadOnly guests only see the output of the session.
+	SharedSessionReadOnly = "read-only"
+	// SharedSessionReadWrite guests type into the shell of the owner, so their commands run with the credentials
+	// of the owner.
+	SharedSessionReadWrite = "read-write"
+
+	// scrollbackSize is the amount of output sent to guests when they join, so they see the current screen.
+	scrollbackSize = 64 << 10
+	// guestQueueSize is the number of messages buffered for a guest, slower guests are disconnected.
+	guestQueueSize = 256
+)
+
+const (
+	SharedSessionEnded     TerminalConnStatus = "SHARED_SESSION_ENDED"
+	SharedSessionNotFound  TerminalConnStatus = "SHARED_SESSION_NOT_FOUND"
+	SharedSessionForbidden TerminalConnStatus = "SHARED_SESSION_FORBIDDEN"
+)
+
+var (
+	ErrSharedSessionNotFound  = errors.New("shared terminal session not found")
+	ErrSharedSessionForbidden = errors.New("not invited to the shared terminal session")
+	// ErrSharedSessionOtherReplica is returned for sessions of another replica of the API server.
+	ErrSharedSessionOtherReplica = errors.New("the shared terminal session is served by another replica")
+)
+
+// SharedSessions holds the web terminal sessions of this replica of the API server which other project members
+// can join. The sessions are attached to the websocket connection of the owner and only exist in memory, so guests
+// have to be connected to the same replica as the owner. The ID of a session names its replica and joining it on
+// another replica is rejected, deployments with several replicas have to route the guests to the replica of the
+// session, e.g. by enabling session affinity in the ingress.
+type SharedSessions struct {
+	// replica identifies this replica of the API server, e.g. by the name of its pod
+	replica string
+
+	lock     sync.Mutex
+	sessions map[string]*SharedSession
+}
+
+func NewSharedSessions(replica string) *SharedSessions {
+	return &SharedSessions{
+		replica:  replica,
+		sessions: make(map[string]*SharedSession),
+	}
+}
+
+// Start registers a new session of the owner, which ends with Close.
+func (s *SharedSessions) Start(owner, projectID, clusterID string, recorder *recording.Recorder) (*SharedSession, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	session := &SharedSession{
+		ID:        hex.EncodeToString(id) + "." + s.replica,
+		Owner:     owner,
+		ProjectID: projectID,
+		ClusterID: clusterID,
+		registry:  s,
+		recorder:  recorder,
+		invited:   make(map[string]string),
+		guests:    make(map[*guest]struct{}),
+		input:     make(chan []byte),
+	}
+
+	s.lock.Lock()
+	s.sessions[session.ID] = session
+	s.lock.Unlock()
+
+	return session, nil
+}
+
+// Get returns the running session of the cluster. Sessions of other replicas return ErrSharedSessionOtherReplica.
+func (s *SharedSessions) Get(clusterID, id string) (*SharedSession, error) {
+	if _, replica, ok := strings.Cut(id, "."); ok && replica != s.replica {
+		return nil, ErrSharedSessionOtherReplica
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok || session.ClusterID != clusterID {
+		return nil, ErrSharedSessionNotFound
+	}
+	return session, nil
+}
+
+func (s *SharedSessions) remove(id string) {
+	s.lock.Lock()
+	delete(s.sessions, id)
+	s.lock.Unlock()
+}
+
+// SharedSession fans out the output of a web terminal session to the invited guests and forwards the input
+// of read-write guests into the session.
+type SharedSession struct {
+	ID        string
+	Owner     string
+	ProjectID string
+	ClusterID string
+
+	registry *SharedSessions
+	recorder *recording.Recorder
+	// input carries the keystrokes of read-write guests to the process
+	input chan []byte
+
+	lock       sync.Mutex
+	closed     bool
+	invited    map[string]string
+	guests     map[*guest]struct{}
+	scrollback []byte
+	// lastTyping is the guest whose input was forwarded last, see auditInput
+	lastTyping string
+}
+
+type guest struct {
+	email string
+	mode  string
+	queue chan TerminalMessage
+	// gone is closed when the guest is removed from the session
+	gone chan struct{}
+}
+
+// Invite allows the project member to join the session in the given mode and returns the notice for the owner.
+// Inviting a member again changes the mode.
+func (s *SharedSession) Invite(email, mode string) (string, error) {
+	if email == "" {
+		return "", errors.New("the email of the invited user is required")
+	}
+	if email == s.Owner {
+		return "", errors.New("the owner cannot be invited to the own session")
+	}
+	switch mode {
+	case "":
+		mode = SharedSessionReadOnly
+	case SharedSessionReadOnly, SharedSessionReadWrite:
+	default:
+		return "", fmt.Errorf("invalid mode %q, expected %q or %q", mode, SharedSessionReadOnly, SharedSessionReadWrite)
+	}
+
+	s.lock.Lock()
+	s.invited[email] = mode
+	s.lock.Unlock()
+
+	s.audit("invite", email, mode)
+	return inviteNotice(s.Owner, email, mode), nil
+}
+
+// inviteNotice tells the owner, and the guest when joining, what the guest can do in the session.
+func inviteNotice(owner, email, mode string) string {
+	if mode == SharedSessionReadWrite {
+		return fmt.Sprintf("%s can type into the shell of %s: the commands run with the credentials of %s and are audited", email, owner, owner)
+	}
+	return fmt.Sprintf("%s can watch the shell of %s", email, owner)
+}
+
+// CanJoin checks that the guest was invited to the running session, so that the caller can reject the guest before
+// upgrading the connection. Join checks it again.
+func (s *SharedSession) CanJoin(email string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return ErrSharedSessionNotFound
+	}
+	if _, ok := s.invited[email]; !ok {
+		return ErrSharedSessionForbidden
+	}
+	return nil
+}
+
+// Join connects the guest with the session until the guest disconnects or the session ends.
+// The caller has to check that the guest is a member of the project.
+func (s *SharedSession) Join(ws *websocket.Conn, email string) error {
+	s.lock.Lock()
+	mode, ok := s.invited[email]
+	if !ok || s.closed {
+		s.lock.Unlock()
+		if !ok {
+			return ErrSharedSessionForbidden
+		}
+		return ErrSharedSessionNotFound
+	}
+
+	g := &guest{
+		email: email,
+		mode:  mode,
+		queue: make(chan TerminalMessage, guestQueueSize),
+		gone:  make(chan struct{}),
+	}
+	g.queue <- TerminalMessage{Op: "toast", Data: inviteNotice(s.Owner, email, mode)}
+	if len(s.scrollback) > 0 {
+		g.queue <- TerminalMessage{Op: "stdout", Data: string(s.scrollback)}
+	}
+	s.guests[g] = struct{}{}
+	s.lock.Unlock()
+
+	s.audit("join", email, mode)
+	defer func() {
+		s.leave(g)
+		s.audit("leave", email, mode)
+	}()
+
+	go s.readGuest(ws, g)
+
+	// all messages to the guest are written here, websocket connections do not support concurrent writers
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-g.gone:
+			// the session ended or the guest disconnected, send what is left
+			for {
+				select {
+				case msg := <-g.queue:
+					if err := ws.WriteJSON(msg); err != nil {
+						return nil
+					}
+				default:
+					return nil
+				}
+			}
+		case <-ping.C:
+			if err := SendMessage(ws, pingMessage); err != nil {
+				return nil
+			}
+		case msg := <-g.queue:
+			if err := ws.WriteJSON(msg); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// readGuest handles the messages of the guest, only stdin of read-write guests is used. The input is audited, as it
+// runs with the credentials of the owner.
+func (s *SharedSession) readGuest(ws *websocket.Conn, g *guest) {
+	defer s.leave(g)
+
+	for {
+		var msg TerminalMessage
+		if err := ws.ReadJSON(&msg); err != nil {
+			return
+		}
+		if msg.Op != "stdin" || g.mode != SharedSessionReadWrite {
+			continue
+		}
+
+		select {
+		case s.input <- []byte(msg.Data):
+			s.auditInput(g, msg.Data)
+		case <-g.gone:
+			return
+		}
+	}
+}
+
+// Output sends the output of the process to the guests.
+func (s *SharedSession) Output(p []byte) {
+	if s == nil || len(p) == 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.scrollback = append(s.scrollback, p...)
+	if len(s.scrollback) > scrollbackSize {
+		s.scrollback = s.scrollback[len(s.scrollback)-scrollbackSize:]
+	}
+
+	for g := range s.guests {
+		select {
+		case g.queue <- TerminalMessage{Op: "stdout", Data: string(p)}:
+		default:
+			log.Logger.Debugw("Disconnecting a slow guest from the shared terminal session", "session", s.ID, "user", g.email)
+			s.removeLocked(g)
+		}
+	}
+}
+
+// Close ends the session for all guests and unregisters it.
+func (s *SharedSession) Close() {
+	if s == nil {
+		return
+	}
+
+	s.registry.remove(s.ID)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	for g := range s.guests {
+		select {
+		case g.queue <- TerminalMessage{Op: "msg", Data: string(SharedSessionEnded)}:
+		default:
+		}
+		s.removeLocked(g)
+	}
+}
+
+func (s *SharedSession) leave(g *guest) {
+	s.lock.Lock()
+	s.removeLocked(g)
+	s.lock.Unlock()
+}
+
+func (s *SharedSession) removeLocked(g *guest) {
+	if _, ok := s.guests[g]; ok {
+		delete(s.guests, g)
+		close(g.gone)
+	}
+}
+
+// audit logs the event and marks it in the recording of the session.
+func (s *SharedSession) audit(event, email, mode string) {
+	log.Logger.Infow("Shared terminal session "+event, "session", s.ID, "owner", s.Owner, "user", email, "mode", mode, "project", s.ProjectID, "cluster", s.ClusterID)
+	s.recorder.Marker(fmt.Sprintf("%s %s (%s)", event, email, mode))
+}
+
+// auditInput records the input of the guest. The keystrokes are only kept in the recording, as they may contain
+// credentials; the log only tells which guest started typing, whenever the input switches to another guest or back
+// from the owner, and the recording is marked alike.
+func (s *SharedSession) auditInput(g *guest, data string) {
+	s.lock.Lock()
+	mark := s.lastTyping != g.email
+	s.lastTyping = g.email
+	s.lock.Unlock()
+
+	if mark {
+		log.Logger.Infow("Shared terminal session input", "session", s.ID, "owner", s.Owner, "user", g.email, "project", s.ProjectID, "cluster", s.ClusterID, "bytes", len(data))
+		s.recorder.Marker(fmt.Sprintf("input %s (%s)", g.email, g.mode))
+	}
+	s.recorder.Input([]byte(data))
+}
+
+// ownerTyped lets the next input of a guest be marked in the recording again.
+func (s *SharedSession) ownerTyped() {
+	s.lock.Lock()
+	s.lastTyping = ""
+	s.lock.Unlock()
+}
+
+// sharedPty merges the input of the owner and of the read-write guests of a shared session.
+type sharedPty struct {
+	TerminalSession
+	shared *SharedSession
+
+	reads   chan ptyRead
+	pending []byte
+}
+
+type ptyRead struct {
+	data []byte
+	err  error
+}
+
+func newSharedPty(session TerminalSession) *sharedPty {
+	p := &sharedPty{
+		TerminalSession: session,
+		shared:          session.shared,
+		reads:           make(chan ptyRead),
+	}
+
+	// the owner websocket is read in the background, so guest input does not wait for the owner
+	go func() {
+		buf := make([]byte, 32<<10)
+		for {
+			n, err := session.Read(buf)
+			data := append([]byte(nil), buf[:n]...)
+			select {
+			case p.reads <- ptyRead{data: data, err: err}:
+			case <-session.doneChan:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return p
+}
+
+func (p *sharedPty) Read(b []byte) (int, error) {
+	if len(p.pending) == 0 {
+		select {
+		case read := <-p.reads:
+			if read.err != nil {
+				return copy(b, read.data), read.err
+			}
+			p.shared.ownerTyped()
+			p.pending = read.data
+		case data := <-p.shared.input:
+			p.pending = data
+		}
+	}
+
+	n := copy(b, p.pending)
+	p.pending = p.pending[n:]
+	return n, nil
+}
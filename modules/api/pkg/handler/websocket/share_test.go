@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// joinSession connects the guest to the session through a websocket and returns the client side of it.
+func joinSession(t *testing.T, session *SharedSession, email string) (*websocket.Conn, chan error) {
+	joined := make(chan error, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			joined <- err
+			return
+		}
+		defer ws.Close()
+		joined <- session.Join(ws, email)
+	}))
+	t.Cleanup(server.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	return client, joined
+}
+
+func waitForGuests(t *testing.T, session *SharedSession, count int) {
+	for i := 0; i < 100; i++ {
+		session.lock.Lock()
+		guests := len(session.guests)
+		session.lock.Unlock()
+		if guests == count {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected %d guests", count)
+}
+
+func waitForInput(t *testing.T, session *SharedSession, email string) {
+	for i := 0; i < 100; i++ {
+		session.lock.Lock()
+		typing := session.lastTyping
+		session.lock.Unlock()
+		if typing == email {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected the input of %s to be audited", email)
+}
+
+func TestSharedSession(t *testing.T) {
+	sessions := NewSharedSessions("api-0")
+	session, err := sessions.Start("bob@acme.com", "my-project", "abc123", nil)
+	if err != nil {
+		t.Fatalf("failed to start the session: %v", err)
+	}
+
+	if _, err := sessions.Get("other", session.ID); !errors.Is(err, ErrSharedSessionNotFound) {
+		t.Errorf("expected the session not to be found for another cluster, got %v", err)
+	}
+	if _, err := NewSharedSessions("api-1").Get("abc123", session.ID); !errors.Is(err, ErrSharedSessionOtherReplica) {
+		t.Errorf("expected the session to be rejected by another replica, got %v", err)
+	}
+	if _, err := session.Invite("bob@acme.com", ""); err == nil {
+		t.Error("expected an error when the owner is invited")
+	}
+	if _, err := session.Invite("alice@acme.com", "admin"); err == nil {
+		t.Error("expected an error for an invalid mode")
+	}
+
+	if err := session.CanJoin("mallory@acme.com"); !errors.Is(err, ErrSharedSessionForbidden) {
+		t.Errorf("expected uninvited users not to be able to join, got %v", err)
+	}
+	_, joined := joinSession(t, session, "mallory@acme.com")
+	if err := <-joined; !errors.Is(err, ErrSharedSessionForbidden) {
+		t.Errorf("expected uninvited users to be rejected, got %v", err)
+	}
+
+	notice, err := session.Invite("alice@acme.com", SharedSessionReadWrite)
+	if err != nil {
+		t.Fatalf("failed to invite: %v", err)
+	}
+	if !strings.Contains(notice, "credentials of bob@acme.com") {
+		t.Errorf("expected the notice to name the credentials of the owner, got %q", notice)
+	}
+	if err := session.CanJoin("alice@acme.com"); err != nil {
+		t.Errorf("expected invited users to be able to join, got %v", err)
+	}
+	session.Output([]byte("before join\r\n"))
+
+	client, joined := joinSession(t, session, "alice@acme.com")
+	waitForGuests(t, session, 1)
+	session.Output([]byte("after join\r\n"))
+
+	var toast TerminalMessage
+	if err := client.ReadJSON(&toast); err != nil {
+		t.Fatalf("failed to read: %v", err)
+	}
+	if toast.Op != "toast" || toast.Data != notice {
+		t.Errorf("expected the guest to be told the mode, got %+v", toast)
+	}
+	for _, expected := range []string{"before join\r\n", "after join\r\n"} {
+		var msg TerminalMessage
+		if err := client.ReadJSON(&msg); err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		if msg.Op != "stdout" || msg.Data != expected {
+			t.Errorf("expected output %q, got %+v", expected, msg)
+		}
+	}
+
+	if err := client.WriteJSON(TerminalMessage{Op: "stdin", Data: "ls\r"}); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	select {
+	case input := <-session.input:
+		if string(input) != "ls\r" {
+			t.Errorf("unexpected input %q", input)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the input of the read-write guest to be forwarded")
+	}
+	waitForInput(t, session, "alice@acme.com")
+
+	session.Close()
+	var msg TerminalMessage
+	if err := client.ReadJSON(&msg); err != nil || msg.Data != string(SharedSessionEnded) {
+		t.Errorf("expected the end of the session to be sent, got %+v, %v", msg, err)
+	}
+	if err := <-joined; err != nil {
+		t.Errorf("unexpected error of the guest: %v", err)
+	}
+	if _, err := sessions.Get("abc123", session.ID); !errors.Is(err, ErrSharedSessionNotFound) {
+		t.Errorf("expected the session to be removed, got %v", err)
+	}
+}
@@ -28,12 +28,14 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/recording"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -91,6 +93,7 @@ const (
 	WebterminalPodFailed    TerminalConnStatus = "WEBTERMINAL_POD_FAILED"
 	ConnectionPoolExceeded  TerminalConnStatus = "CONNECTION_POOL_EXCEEDED"
 	RefreshesLimitExceeded  TerminalConnStatus = "REFRESHES_LIMIT_EXCEEDED"
+	RecordingFailed         TerminalConnStatus = "RECORDING_FAILED"
 )
 
 // PtyHandler is what remote command expects from a pty.
@@ -108,6 +111,14 @@ type TerminalSession struct {
 
 	userEmailID   string
 	clusterClient ctrlruntimeclient.Client
+
+	// recorder records the session if the recording is enabled
+	recorder *recording.Recorder
+	// shared sends the output to the guests of a shared session
+	shared *SharedSession
+	// writeLock serializes the writes of sessions with several writers, e.g. stdout, stderr and pings,
+	// websocket connections do not support concurrent writers
+	writeLock *sync.Mutex
 }
 
 // TerminalMessage is the messaging protocol between ShellController and TerminalSession.
@@ -118,13 +129,19 @@ type TerminalSession struct {
 // resize      fe->be     Rows, Cols     New terminal size.
 // refresh     fe->be                    Signal to extend expiration time.
 // msg         fe->be     Data           Any other necessary message from the frontend to the backend.
+// invite      fe->be     Data, Mode     Invite the project member with the email to join the session read-only or read-write.
 // stdout      be->fe     Data           Output from the process.
 // toast       be->fe     Data           OOB message to be shown to the user.
 // msg         be->fe     Data           Any necessary message from the backend to the frontend.
 // expiration  be->fe     Data           Expiration timestamp in seconds.
+// session     be->fe     Data           ID of the session, which invited members use to join it.
+//
+// Read-write guests type into the shell of the owner, so their commands run with the credentials of the owner. The
+// owner and the guest are told so by a toast.
 type TerminalMessage struct {
 	Op, Data   string
 	Rows, Cols uint16
+	Mode       string `json:",omitempty"`
 }
 
 // TerminalSize handles pty->process resize events.
@@ -154,12 +171,35 @@ func (t TerminalSession) Read(p []byte) (int, error) {
 
 	switch msg.Op {
 	case "stdin":
+		t.recorder.Input([]byte(msg.Data))
 		return copy(p, msg.Data), nil
 	case "resize":
-		t.sizeChan <- remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
+		t.recorder.Resize(msg.Cols, msg.Rows)
+		// sessions without a TTY have no size
+		if t.sizeChan != nil {
+			t.sizeChan <- remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
+		}
 		return 0, nil
 	case "refresh":
+		// only the web terminal pod expires, exec sessions in workload pods do not
+		if t.clusterClient == nil {
+			return 0, nil
+		}
 		return 0, t.extendExpirationTime(context.Background())
+	case "invite":
+		if t.shared == nil {
+			return 0, nil
+		}
+		notice, err := t.shared.Invite(msg.Data, msg.Mode)
+		if err != nil {
+			log.Logger.Debugw("Failed to invite to the shared terminal session", "error", err)
+			return 0, nil
+		}
+		// the owner has to know that read-write guests act with the credentials of the owner
+		if err := t.Toast(notice); err != nil {
+			log.Logger.Debug(err)
+		}
+		return 0, nil
 	case "msg":
 		switch msg.Data {
 		case pongMessage:
@@ -184,9 +224,11 @@ func (t TerminalSession) Write(p []byte) (int, error) {
 		return 0, err
 	}
 
-	if err = t.websocketConn.WriteMessage(websocket.TextMessage, msg); err != nil {
+	if err = t.writeMessage(msg); err != nil {
 		return 0, err
 	}
+	t.recorder.Output(p)
+	t.shared.Output(p)
 
 	return len(p), nil
 }
@@ -202,10 +244,45 @@ func (t TerminalSession) Toast(p string) error {
 		return err
 	}
 
-	if err = t.websocketConn.WriteMessage(websocket.TextMessage, msg); err != nil {
+	return t.writeMessage(msg)
+}
+
+// SendMessage sends an OOB message like SendMessage, but serialized with the other writes of the session.
+func (t TerminalSession) SendMessage(message string) error {
+	msg, err := json.Marshal(TerminalMessage{
+		Op:   "msg",
+		Data: message,
+	})
+	if err != nil {
 		return err
 	}
-	return nil
+
+	return t.writeMessage(msg)
+}
+
+func (t TerminalSession) writeMessage(msg []byte) error {
+	if t.writeLock != nil {
+		t.writeLock.Lock()
+		defer t.writeLock.Unlock()
+	}
+	return t.websocketConn.WriteMessage(websocket.TextMessage, msg)
+}
+
+// pingRoutine sends pings through the session until it is done or the connection is closed.
+func (t TerminalSession) pingRoutine(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.doneChan:
+			return
+		case <-ticker.C:
+			if err := t.SendMessage(pingMessage); err != nil {
+				return
+			}
+		}
+	}
 }
 
 func (t TerminalSession) extendExpirationTime(ctx context.Context) error {
@@ -849,8 +926,23 @@ func getVolumeMounts() []corev1.VolumeMount {
 	}
 }
 
-// Terminal is called for any new websocket connection.
-func Terminal(ctx context.Context, ws *websocket.Conn, client, seedClient ctrlruntimeclient.Client, k8sClient kubernetes.Interface, cfg *rest.Config, userEmailID string, cluster *kubermaticv1.Cluster, options *kubermaticv1.WebTerminalOptions, oidcIssuerVerifier authtypes.OIDCIssuerVerifier, kubeconfigSecret *corev1.Secret, overwriteRegistry string) {
+// Terminal is called for any new websocket connection. The session is recorded if a recorder is passed.
+func Terminal(ctx context.Context, ws *websocket.Conn, client, seedClient ctrlruntimeclient.Client, k8sClient kubernetes.Interface, cfg *rest.Config, userEmailID string, cluster *kubermaticv1.Cluster, options *kubermaticv1.WebTerminalOptions, oidcIssuerVerifier authtypes.OIDCIssuerVerifier, kubeconfigSecret *corev1.Secret, overwriteRegistry string, recorder *recording.Recorder, shared *SharedSession) {
+	session := TerminalSession{
+		websocketConn: ws,
+		userEmailID:   userEmailID,
+		clusterClient: client,
+		sizeChan:      make(chan remotecommand.TerminalSize),
+		doneChan:      make(chan struct{}),
+		recorder:      recorder,
+		shared:        shared,
+	}
+
+	var pty PtyHandler = session
+	if shared != nil {
+		pty = newSharedPty(session)
+	}
+
 	if err := startProcess(
 		ctx,
 		client,
@@ -864,13 +956,7 @@ func Terminal(ctx context.Context, ws *websocket.Conn, client, seedClient ctrlru
 		kubeconfigSecret,
 		overwriteRegistry,
 		[]string{"bash", "-c", "cd /data/terminal && /bin/bash"},
-		TerminalSession{
-			websocketConn: ws,
-			userEmailID:   userEmailID,
-			clusterClient: client,
-			sizeChan:      make(chan remotecommand.TerminalSize),
-			doneChan:      make(chan struct{}),
-		},
+		pty,
 		ws); err != nil {
 		log.Logger.Debug(err)
 		return
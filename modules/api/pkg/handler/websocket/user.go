@@ -21,7 +21,6 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/go-pubsub"
-	"github.com/gorilla/websocket"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/watcher"
@@ -29,7 +28,9 @@ import (
 	"k8c.io/kubermatic/v2/pkg/log"
 )
 
-func WriteUser(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, userEmail string) {
+// WriteUser streams the user with the given email until the context is cancelled. The current user is skipped if the
+// client resumes the stream with the ID of the last received user and it did not change.
+func WriteUser(ctx context.Context, providers watcher.Providers, ws Conn, userEmail, lastEventID string) {
 	// There can be a race here if the user changes between getting the initial data and setting up the subscription
 	initialUser, err := providers.UserProvider.UserByEmail(ctx, userEmail)
 	if err != nil {
@@ -55,9 +56,11 @@ func WriteUser(ctx context.Context, providers watcher.Providers, ws *websocket.C
 		return
 	}
 
-	if err := ws.WriteMessage(websocket.TextMessage, initialResponse); err != nil {
-		log.Logger.Debug(err)
-		return
+	if id := stateEventID(initialResponse); id != lastEventID {
+		if err := writeEvent(ws, id, initialResponse); err != nil {
+			log.Logger.Debug(err)
+			return
+		}
 	}
 
 	hashID, err := providers.UserWatcher.CalculateHash(userEmail)
@@ -104,14 +107,18 @@ func WriteUser(ctx context.Context, providers watcher.Providers, ws *websocket.C
 			}
 		}
 
-		if err := ws.WriteMessage(websocket.TextMessage, response); err != nil {
+		if err := writeEvent(ws, stateEventID(response), response); err != nil {
 			log.Logger.Debug(err)
 			return
 		}
 	}, pubsub.WithPath([]uint64{hashID}))
+	defer unSub()
 
 	ws.SetCloseHandler(func(code int, text string) error {
 		unSub()
 		return writeCloseMessage(ws, code)
 	})
+
+	// the subscription is released once the client disconnected
+	<-ctx.Done()
 }
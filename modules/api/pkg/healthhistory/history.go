@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package healthhistory samples the control plane component health of the user clusters and computes
+// the uptime of the components over time windows.
+//
+// The history is best-effort. Every API replica samples all clusters on its own and keeps the samples in
+// memory only, so the history starts over when a replica restarts and the replicas behind a load balancer
+// may report slightly different uptimes. It is meant to spot unstable components in the dashboard, not for
+// SLA reporting, which should use the metrics of the seed monitoring instead.
+package healthhistory
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+const (
+	DefaultInterval  = time.Minute
+	DefaultRetention = 7 * 24 * time.Hour
+)
+
+// DefaultWindows are the windows reported if the client does not ask for others.
+var DefaultWindows = []time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour}
+
+// Config configures the sampling of the cluster health.
+type Config struct {
+	// Interval is the time between two samples, the sampling is disabled if it is 0.
+	Interval time.Duration
+	// Retention is the time the samples are kept for, which is the longest window that can be reported.
+	Retention time.Duration
+}
+
+func (c Config) Enabled() bool {
+	return c.Interval > 0
+}
+
+func (c Config) Validate() error {
+	if c.Interval < 0 {
+		return errors.New("the interval must not be negative")
+	}
+	if c.Enabled() && c.Retention < c.Interval {
+		return errors.New("the retention must be at least the interval")
+	}
+	return nil
+}
+
+// capacity is the number of samples kept per cluster.
+func (c Config) capacity() int {
+	return int(c.Retention / c.Interval)
+}
+
+// components are the control plane components whose health is sampled, named like the fields of the
+// ClusterHealth API type. There are at most 32, as their states are stored in bitmasks.
+var components = []string{
+	"apiserver",
+	"applicationController",
+	"scheduler",
+	"controller",
+	"machineController",
+	"etcd",
+	"cloudProviderInfrastructure",
+	"userClusterControllerManager",
+	"gatekeeperController",
+	"gatekeeperAudit",
+	"monitoring",
+	"logging",
+	"alertmanagerConfig",
+	"mlaGateway",
+	"operatingSystemManager",
+	"kubernetesDashboard",
+	"kubelb",
+	"kyverno",
+}
+
+// statuses returns the status of the components in the order of components, nil if a component is not deployed.
+func statuses(h *kubermaticv1.ExtendedClusterHealth) []*kubermaticv1.HealthStatus {
+	return []*kubermaticv1.HealthStatus{
+		&h.Apiserver,
+		&h.ApplicationController,
+		&h.Scheduler,
+		&h.Controller,
+		&h.MachineController,
+		&h.Etcd,
+		&h.CloudProviderInfrastructure,
+		&h.UserClusterControllerManager,
+		h.GatekeeperController,
+		h.GatekeeperAudit,
+		h.Monitoring,
+		h.Logging,
+		h.AlertmanagerConfig,
+		h.MLAGateway,
+		h.OperatingSystemManager,
+		h.KubernetesDashboard,
+		h.KubeLB,
+		h.Kyverno,
+	}
+}
+
+// sample is the health of the components at one point in time. A component is counted if its bit in
+// known is set, and it was up if its bit in up is set. Components which are not deployed or still
+// provisioning are not counted.
+type sample struct {
+	time  int64
+	known uint32
+	up    uint32
+}
+
+func newSample(at time.Time, health *kubermaticv1.ExtendedClusterHealth) sample {
+	s := sample{time: at.Unix()}
+	for i, status := range statuses(health) {
+		if status == nil {
+			continue
+		}
+		switch *status {
+		case kubermaticv1.HealthStatusUp:
+			s.known |= 1 << i
+			s.up |= 1 << i
+		case kubermaticv1.HealthStatusDown:
+			s.known |= 1 << i
+		}
+	}
+	return s
+}
+
+// ring keeps the latest samples of a cluster.
+type ring struct {
+	samples []sample
+	next    int
+	full    bool
+}
+
+func (r *ring) add(s sample) {
+	r.samples[r.next] = s
+	r.next = (r.next + 1) % len(r.samples)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// each calls f for the samples from the newest to the oldest until f returns false.
+func (r *ring) each(f func(sample) bool) {
+	count := r.next
+	if r.full {
+		count = len(r.samples)
+	}
+	for i := 1; i <= count; i++ {
+		if !f(r.samples[(r.next-i+len(r.samples))%len(r.samples)]) {
+			return
+		}
+	}
+}
+
+// History keeps the health samples of the clusters in the memory of the replica, they are lost on restart.
+// It is safe for concurrent use.
+type History struct {
+	lock      sync.RWMutex
+	capacity  int
+	interval  time.Duration
+	retention time.Duration
+	clusters  map[string]*ring
+}
+
+func New(config Config) *History {
+	return &History{
+		capacity:  config.capacity(),
+		interval:  config.Interval,
+		retention: config.Retention,
+		clusters:  make(map[string]*ring),
+	}
+}
+
+// Interval is the time between two samples.
+func (h *History) Interval() time.Duration {
+	return h.interval
+}
+
+// Record adds a sample of the health of the cluster.
+func (h *History) Record(clusterID string, at time.Time, health kubermaticv1.ExtendedClusterHealth) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	r, ok := h.clusters[clusterID]
+	if !ok {
+		r = &ring{samples: make([]sample, h.capacity)}
+		h.clusters[clusterID] = r
+	}
+	r.add(newSample(at, &health))
+}
+
+// Retain drops the samples of all clusters which are not in the set, e.g. deleted clusters.
+func (h *History) Retain(clusterIDs map[string]struct{}) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for clusterID := range h.clusters {
+		if _, ok := clusterIDs[clusterID]; !ok {
+			delete(h.clusters, clusterID)
+		}
+	}
+}
+
+// Window is the uptime of the components of a cluster in a time window.
+type Window struct {
+	Duration time.Duration
+	// Samples is the number of samples in the window, it is lower than expected if the window is
+	// longer than the time the cluster was sampled for.
+	Samples int
+	// Uptime is the percentage of the samples in which a component was up, by component name.
+	// Components without samples in the window are missing.
+	Uptime map[string]float64
+}
+
+// Report returns the uptime of the components of the cluster for each window ending now.
+func (h *History) Report(clusterID string, now time.Time, windows []time.Duration) []Window {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	result := make([]Window, 0, len(windows))
+	r := h.clusters[clusterID]
+
+	for _, duration := range windows {
+		window := Window{Duration: duration, Uptime: map[string]float64{}}
+		if r == nil {
+			result = append(result, window)
+			continue
+		}
+
+		var known, up [32]int
+		since := now.Add(-duration).Unix()
+		r.each(func(s sample) bool {
+			if s.time <= since {
+				return false
+			}
+			window.Samples++
+			for i := range components {
+				if s.known&(1<<i) != 0 {
+					known[i]++
+					if s.up&(1<<i) != 0 {
+						up[i]++
+					}
+				}
+			}
+			return true
+		})
+
+		for i, name := range components {
+			if known[i] > 0 {
+				// rounded to two decimals, like SLAs are usually stated
+				window.Uptime[name] = math.Round(float64(up[i])/float64(known[i])*10000) / 100
+			}
+		}
+		result = append(result, window)
+	}
+
+	return result
+}
+
+// ParseWindows parses a comma separated list of windows, e.g. "1h,24h,168h". Without windows, the default
+// windows within the retention are returned.
+func (h *History) ParseWindows(raw string) ([]time.Duration, error) {
+	var windows []time.Duration
+
+	if raw == "" {
+		for _, window := range DefaultWindows {
+			if window <= h.retention {
+				windows = append(windows, window)
+			}
+		}
+		if len(windows) == 0 {
+			windows = append(windows, h.retention)
+		}
+		return windows, nil
+	}
+
+	for _, part := range strings.Split(raw, ",") {
+		window, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid window %q: %w", part, err)
+		}
+		if window <= 0 || window > h.retention {
+			return nil, fmt.Errorf("window %s must be positive and not longer than the retention of %s", window, h.retention)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
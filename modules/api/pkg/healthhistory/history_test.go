@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthhistory
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+func healthy() kubermaticv1.ExtendedClusterHealth {
+	return kubermaticv1.ExtendedClusterHealth{
+		Apiserver:                    kubermaticv1.HealthStatusUp,
+		ApplicationController:        kubermaticv1.HealthStatusUp,
+		Scheduler:                    kubermaticv1.HealthStatusUp,
+		Controller:                   kubermaticv1.HealthStatusUp,
+		MachineController:            kubermaticv1.HealthStatusUp,
+		Etcd:                         kubermaticv1.HealthStatusUp,
+		CloudProviderInfrastructure:  kubermaticv1.HealthStatusUp,
+		UserClusterControllerManager: kubermaticv1.HealthStatusUp,
+	}
+}
+
+func TestReport(t *testing.T) {
+	history := New(Config{Interval: time.Minute, Retention: 2 * time.Hour})
+	start := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// three hours of samples, the oldest hour is dropped from the ring
+	for i := 0; i < 180; i++ {
+		health := healthy()
+		at := start.Add(time.Duration(i) * time.Minute)
+		// etcd is down for 6 of the last 60 minutes
+		if i >= 170 && i < 176 {
+			health.Etcd = kubermaticv1.HealthStatusDown
+		}
+		// gatekeeper is deployed in the last hour only and provisioning at first
+		if i >= 120 {
+			health.GatekeeperController = ptr.To(kubermaticv1.HealthStatusUp)
+		}
+		if i >= 120 && i < 130 {
+			health.GatekeeperController = ptr.To(kubermaticv1.HealthStatusProvisioning)
+		}
+		history.Record("abc123", at, health)
+	}
+
+	now := start.Add(179 * time.Minute)
+	windows := history.Report("abc123", now, []time.Duration{time.Hour, 2 * time.Hour})
+
+	if windows[0].Samples != 60 || windows[1].Samples != 120 {
+		t.Errorf("expected 60 and 120 samples, got %d and %d", windows[0].Samples, windows[1].Samples)
+	}
+	if uptime := windows[0].Uptime["etcd"]; uptime != 90 {
+		t.Errorf("expected 90%% etcd uptime in the last hour, got %v", uptime)
+	}
+	if uptime := windows[1].Uptime["etcd"]; uptime != 95 {
+		t.Errorf("expected 95%% etcd uptime in the last 2 hours, got %v", uptime)
+	}
+	if uptime := windows[1].Uptime["apiserver"]; uptime != 100 {
+		t.Errorf("expected 100%% apiserver uptime, got %v", uptime)
+	}
+	if uptime, ok := windows[1].Uptime["gatekeeperController"]; !ok || uptime != 100 {
+		t.Errorf("expected provisioning samples not to be counted, got %v", uptime)
+	}
+	if _, ok := windows[1].Uptime["kyverno"]; ok {
+		t.Error("expected components which are not deployed to be missing")
+	}
+
+	if windows := history.Report("unknown", now, []time.Duration{time.Hour}); windows[0].Samples != 0 || len(windows[0].Uptime) != 0 {
+		t.Errorf("expected an empty report for an unknown cluster, got %+v", windows)
+	}
+
+	history.Retain(map[string]struct{}{})
+	if windows := history.Report("abc123", now, []time.Duration{time.Hour}); windows[0].Samples != 0 {
+		t.Errorf("expected the samples of deleted clusters to be dropped, got %+v", windows)
+	}
+}
+
+func TestParseWindows(t *testing.T) {
+	history := New(Config{Interval: time.Minute, Retention: 48 * time.Hour})
+
+	windows, err := history.ParseWindows("")
+	if err != nil || !reflect.DeepEqual(windows, []time.Duration{time.Hour, 24 * time.Hour}) {
+		t.Errorf("expected the default windows within the retention, got %v, %v", windows, err)
+	}
+	if windows, err = history.ParseWindows("30m, 48h"); err != nil || !reflect.DeepEqual(windows, []time.Duration{30 * time.Minute, 48 * time.Hour}) {
+		t.Errorf("unexpected windows %v, %v", windows, err)
+	}
+	for _, invalid := range []string{"72h", "-1h", "0s", "a day"} {
+		if _, err := history.ParseWindows(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
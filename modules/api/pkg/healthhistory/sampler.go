@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthhistory
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Sampler periodically records the health of the clusters of all seeds into the history.
+type Sampler struct {
+	history          *History
+	interval         time.Duration
+	seedsGetter      provider.SeedsGetter
+	seedClientGetter provider.SeedClientGetter
+	log              *zap.SugaredLogger
+	now              func() time.Time
+}
+
+func NewSampler(config Config, history *History, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter, log *zap.SugaredLogger) *Sampler {
+	return &Sampler{
+		history:          history,
+		interval:         config.Interval,
+		seedsGetter:      seedsGetter,
+		seedClientGetter: seedClientGetter,
+		log:              log,
+		now:              time.Now,
+	}
+}
+
+// Start samples the clusters until the context is cancelled.
+func (s *Sampler) Start(ctx context.Context) {
+	go wait.UntilWithContext(ctx, s.sample, s.interval)
+}
+
+func (s *Sampler) sample(ctx context.Context) {
+	seeds, err := s.seedsGetter()
+	if err != nil {
+		s.log.Warnw("Failed to get the seeds for sampling the cluster health", zap.Error(err))
+		return
+	}
+
+	now := s.now()
+	sampled := map[string]struct{}{}
+	complete := true
+
+	for _, seed := range seeds {
+		client, err := s.seedClientGetter(seed)
+		if err != nil {
+			s.log.Warnw("Failed to get the seed client for sampling the cluster health", "seed", seed.Name, zap.Error(err))
+			complete = false
+			continue
+		}
+
+		clusters := &kubermaticv1.ClusterList{}
+		if err := client.List(ctx, clusters); err != nil {
+			s.log.Warnw("Failed to list the clusters for sampling the cluster health", "seed", seed.Name, zap.Error(err))
+			complete = false
+			continue
+		}
+
+		for _, cluster := range clusters.Items {
+			s.history.Record(cluster.Name, now, cluster.Status.ExtendedHealth)
+			sampled[cluster.Name] = struct{}{}
+		}
+	}
+
+	// the samples of an unreachable seed are kept, its clusters still exist
+	if complete {
+		s.history.Retain(sampled)
+	}
+}
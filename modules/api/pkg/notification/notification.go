@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notification delivers notifications, like the alerts of resource quotas, through pluggable channels:
+// webhooks, Slack-compatible webhooks and mails sent via an SMTP relay.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"sort"
+	"strings"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/tracing"
+)
+
+// DefaultTimeout is the timeout for delivering a single notification through a channel.
+const DefaultTimeout = 10 * time.Second
+
+// Message is a notification.
+type Message struct {
+	// Subject is a short summary of the notification.
+	Subject string `json:"subject"`
+	// Text describes the notification in a human-readable form.
+	Text string `json:"text"`
+	// Labels are the machine-readable details of the notification, e.g. the ID of the project.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Notifier delivers notifications through a channel.
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+// Config configures the channels of the notifications. Channels with an empty address are disabled.
+type Config struct {
+	// WebhookURL is the URL to which the messages are sent as JSON in a POST request.
+	WebhookURL string
+	// SlackWebhookURL is the URL of a Slack-compatible incoming webhook.
+	SlackWebhookURL string
+	// SMTPAddress is the host:port of the SMTP relay, e.g. a local relay which takes care of the authentication.
+	SMTPAddress string
+	// SMTPFrom is the sender of the mails.
+	SMTPFrom string
+	// SMTPTo are the recipients of the mails.
+	SMTPTo []string
+	// Timeout is the timeout for delivering a single notification, DefaultTimeout if zero.
+	Timeout time.Duration
+}
+
+// Enabled returns true if at least one channel is configured.
+func (c Config) Enabled() bool {
+	return c.WebhookURL != "" || c.SlackWebhookURL != "" || c.SMTPAddress != ""
+}
+
+// New returns a notifier delivering the messages through all configured channels, or nil if there are none.
+func New(config Config) (Notifier, error) {
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	var notifiers Notifiers
+	if config.WebhookURL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(config.WebhookURL, timeout))
+	}
+	if config.SlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlackNotifier(config.SlackWebhookURL, timeout))
+	}
+	if config.SMTPAddress != "" {
+		if config.SMTPFrom == "" || len(config.SMTPTo) == 0 {
+			return nil, errors.New("the sender and the recipients of the mails are required for the SMTP relay")
+		}
+		notifiers = append(notifiers, NewSMTPNotifier(config.SMTPAddress, config.SMTPFrom, config.SMTPTo, timeout))
+	}
+
+	if len(notifiers) == 0 {
+		return nil, nil
+	}
+	return notifiers, nil
+}
+
+// Notifiers delivers the messages through all of its notifiers.
+type Notifiers []Notifier
+
+var _ Notifier = Notifiers{}
+
+// Notify delivers the message through all notifiers, even if some of them fail.
+func (n Notifiers) Notify(ctx context.Context, message Message) error {
+	var errs []error
+	for _, notifier := range n {
+		if err := notifier.Notify(ctx, message); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WebhookNotifier sends the messages as JSON in a POST request to the URL.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+var _ Notifier = &WebhookNotifier{}
+
+func NewWebhookNotifier(url string, timeout time.Duration) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: timeout, Transport: tracing.NewTransport()},
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, message Message) error {
+	return postJSON(ctx, n.client, n.url, message)
+}
+
+// SlackNotifier sends the messages to a Slack-compatible incoming webhook.
+type SlackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+var _ Notifier = &SlackNotifier{}
+
+func NewSlackNotifier(url string, timeout time.Duration) *SlackNotifier {
+	return &SlackNotifier{
+		url:    url,
+		client: &http.Client{Timeout: timeout, Transport: tracing.NewTransport()},
+	}
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (n *SlackNotifier) Notify(ctx context.Context, message Message) error {
+	return postJSON(ctx, n.client, n.url, slackMessage{Text: fmt.Sprintf("*%s*\n%s", message.Subject, message.Text)})
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the notification: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("the notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// SMTPNotifier sends the messages as mails via an SMTP relay. The relay is expected to accept the mails without
+// authentication, e.g. a relay running next to the API.
+type SMTPNotifier struct {
+	address string
+	from    string
+	to      []string
+	timeout time.Duration
+	dialer  *net.Dialer
+}
+
+var _ Notifier = &SMTPNotifier{}
+
+func NewSMTPNotifier(address, from string, to []string, timeout time.Duration) *SMTPNotifier {
+	return &SMTPNotifier{
+		address: address,
+		from:    from,
+		to:      to,
+		timeout: timeout,
+		dialer:  &net.Dialer{},
+	}
+}
+
+// Notify sends the mail within the timeout of the notifier and the deadline of the context. The connection is
+// closed when the context is cancelled, so a relay which does not respond cannot block the caller.
+func (n *SMTPNotifier) Notify(ctx context.Context, message Message) error {
+	if err := n.send(ctx, n.mail(message)); err != nil {
+		return fmt.Errorf("failed to send the notification mail: %w", err)
+	}
+	return nil
+}
+
+// send is smtp.SendMail with a context.
+func (n *SMTPNotifier) send(ctx context.Context, mail []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, n.timeout)
+	defer cancel()
+
+	conn, err := n.dialer.DialContext(ctx, "tcp", n.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	host, _, err := net.SplitHostPort(n.address)
+	if err != nil {
+		return err
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(n.from); err != nil {
+		return err
+	}
+	for _, to := range n.to {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(mail); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (n *SMTPNotifier) mail(message Message) []byte {
+	// the subject must not break the headers
+	subject := strings.NewReplacer("\r", " ", "\n", " ").Replace(message.Subject)
+
+	var mail bytes.Buffer
+	fmt.Fprintf(&mail, "From: %s\r\n", n.from)
+	fmt.Fprintf(&mail, "To: %s\r\n", strings.Join(n.to, ", "))
+	fmt.Fprintf(&mail, "Subject: %s\r\n", subject)
+	mail.WriteString("MIME-Version: 1.0\r\n")
+	mail.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	mail.WriteString("\r\n")
+	mail.WriteString(strings.ReplaceAll(message.Text, "\n", "\r\n"))
+	mail.WriteString("\r\n")
+
+	if len(message.Labels) > 0 {
+		keys := make([]string, 0, len(message.Labels))
+		for key := range message.Labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		mail.WriteString("\r\n")
+		for _, key := range keys {
+			fmt.Fprintf(&mail, "%s: %s\r\n", key, message.Labels[key])
+		}
+	}
+	return mail.Bytes()
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testMessage = Message{
+	Subject: "Quota alert",
+	Text:    "The CPU usage of project p1 reached 80%.",
+	Labels:  map[string]string{"project": "p1", "resource": "cpu"},
+}
+
+func TestWebhookNotifiers(t *testing.T) {
+	var webhookBody Message
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&webhookBody); err != nil {
+			t.Errorf("failed to decode the webhook request: %v", err)
+		}
+	}))
+	defer webhook.Close()
+
+	var slackBody slackMessage
+	slack := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&slackBody); err != nil {
+			t.Errorf("failed to decode the Slack request: %v", err)
+		}
+	}))
+	defer slack.Close()
+
+	notifier, err := New(Config{WebhookURL: webhook.URL, SlackWebhookURL: slack.URL})
+	if err != nil {
+		t.Fatalf("failed to create the notifier: %v", err)
+	}
+	if err := notifier.Notify(context.Background(), testMessage); err != nil {
+		t.Fatalf("failed to notify: %v", err)
+	}
+
+	if !reflect.DeepEqual(webhookBody, testMessage) {
+		t.Errorf("expected the webhook to receive %+v, got %+v", testMessage, webhookBody)
+	}
+	if expected := "*Quota alert*\nThe CPU usage of project p1 reached 80%."; slackBody.Text != expected {
+		t.Errorf("expected the Slack message %q, got %q", expected, slackBody.Text)
+	}
+}
+
+func TestNotifiersContinueOnError(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	received := false
+	working := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer working.Close()
+
+	notifier, err := New(Config{WebhookURL: failing.URL, SlackWebhookURL: working.URL})
+	if err != nil {
+		t.Fatalf("failed to create the notifier: %v", err)
+	}
+	if err := notifier.Notify(context.Background(), testMessage); err == nil {
+		t.Error("expected the error of the failing webhook")
+	}
+	if !received {
+		t.Error("expected the message to be delivered through the working channel")
+	}
+}
+
+// fakeRelay is a minimal SMTP relay which records the envelope and the content of the mails.
+type fakeRelay struct {
+	listener net.Listener
+	mails    chan string
+}
+
+func newFakeRelay(t *testing.T, greet bool) *fakeRelay {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	relay := &fakeRelay{listener: listener, mails: make(chan string, 1)}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			if !greet {
+				// a relay which never responds
+				t.Cleanup(func() { conn.Close() })
+				continue
+			}
+			go relay.serve(conn)
+		}
+	}()
+	return relay
+}
+
+func (r *fakeRelay) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	fmt.Fprint(conn, "220 localhost ESMTP\r\n")
+
+	var envelope strings.Builder
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"):
+			fmt.Fprint(conn, "250 localhost\r\n")
+		case strings.HasPrefix(command, "MAIL FROM:"), strings.HasPrefix(command, "RCPT TO:"):
+			envelope.WriteString(strings.TrimSpace(line) + "\n")
+			fmt.Fprint(conn, "250 OK\r\n")
+		case command == "DATA":
+			fmt.Fprint(conn, "354 go ahead\r\n")
+			var data strings.Builder
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil || line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			r.mails <- envelope.String() + data.String()
+			fmt.Fprint(conn, "250 OK\r\n")
+		case command == "QUIT":
+			fmt.Fprint(conn, "221 bye\r\n")
+			return
+		default:
+			fmt.Fprint(conn, "502 not implemented\r\n")
+		}
+	}
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	relay := newFakeRelay(t, true)
+	notifier := NewSMTPNotifier(relay.listener.Addr().String(), "kkp@example.com", []string{"ops@example.com", "finance@example.com"}, DefaultTimeout)
+
+	message := testMessage
+	message.Subject = "Quota alert\r\nBcc: attacker@example.com"
+	if err := notifier.Notify(context.Background(), message); err != nil {
+		t.Fatalf("failed to notify: %v", err)
+	}
+
+	expected := "MAIL FROM:<kkp@example.com>\n" +
+		"RCPT TO:<ops@example.com>\n" +
+		"RCPT TO:<finance@example.com>\n" +
+		"From: kkp@example.com\r\n" +
+		"To: ops@example.com, finance@example.com\r\n" +
+		"Subject: Quota alert  Bcc: attacker@example.com\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" +
+		"The CPU usage of project p1 reached 80%.\r\n" +
+		"\r\n" +
+		"project: p1\r\n" +
+		"resource: cpu\r\n"
+	if sent := <-relay.mails; sent != expected {
+		t.Errorf("expected the mail\n%q\ngot\n%q", expected, sent)
+	}
+}
+
+func TestSMTPNotifierContext(t *testing.T) {
+	relay := newFakeRelay(t, false)
+	notifier := NewSMTPNotifier(relay.listener.Addr().String(), "kkp@example.com", []string{"ops@example.com"}, DefaultTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	if err := notifier.Notify(ctx, testMessage); err == nil {
+		t.Fatal("expected an error from a relay which does not respond")
+	}
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Errorf("expected the deadline of the context to be kept, took %v", elapsed)
+	}
+}
+
+func TestNew(t *testing.T) {
+	notifier, err := New(Config{})
+	if err != nil || notifier != nil {
+		t.Errorf("expected no notifier without channels, got %v, %v", notifier, err)
+	}
+
+	if _, err := New(Config{SMTPAddress: "localhost:25"}); err == nil || !strings.Contains(err.Error(), "recipients") {
+		t.Errorf("expected an error without the sender and recipients of the mails, got %v", err)
+	}
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package presethealth checks the credentials of presets with cheap authenticated calls to the providers, so
+// that wrong or expired credentials are noticed before a cluster is created with them. The results of the last
+// checks are stored in an annotation of the preset and shared by all replicas of the API.
+package presethealth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// HealthAnnotation is the annotation of a preset with the results of the last checks of its credentials as JSON.
+	HealthAnnotation = "dashboard.kubermatic.io/credential-health"
+
+	// checkTimeout is the time the check of the credentials of a single provider may take.
+	checkTimeout = 30 * time.Second
+)
+
+// adminUserInfo is used to read all presets, regardless of the emails they are restricted to.
+var adminUserInfo = &provider.UserInfo{IsAdmin: true}
+
+// Checker checks the credentials of the presets.
+type Checker struct {
+	presetProvider provider.PresetProvider
+	seedsGetter    provider.SeedsGetter
+	caBundle       *x509.CertPool
+	validators     map[kubermaticv1.ProviderType]validator
+	now            func() time.Time
+}
+
+// NewChecker returns a checker for the credentials of the presets. The CA bundle is used for the providers whose
+// endpoint is configured in the datacenter.
+func NewChecker(presetProvider provider.PresetProvider, seedsGetter provider.SeedsGetter, caBundle *x509.CertPool) *Checker {
+	return &Checker{
+		presetProvider: presetProvider,
+		seedsGetter:    seedsGetter,
+		caBundle:       caBundle,
+		validators:     validators,
+		now:            time.Now,
+	}
+}
+
+// Check validates the credentials of all providers of the preset and stores the results in the preset.
+func (c *Checker) Check(ctx context.Context, preset *kubermaticv1.Preset) (*apiv2.PresetHealth, error) {
+	providers := c.check(ctx, preset)
+	if err := c.store(ctx, preset.Name, providers); err != nil {
+		return nil, err
+	}
+	return &apiv2.PresetHealth{PresetName: preset.Name, Providers: providers}, nil
+}
+
+// Health returns the results of the last checks of the credentials of the preset. Providers which were not checked
+// yet have the status Unknown.
+func (c *Checker) Health(preset *kubermaticv1.Preset) *apiv2.PresetHealth {
+	stored := map[kubermaticv1.ProviderType]apiv2.PresetProviderHealth{}
+	if data, ok := preset.Annotations[HealthAnnotation]; ok {
+		providers := []apiv2.PresetProviderHealth{}
+		// an invalid annotation is overwritten by the next check
+		if err := json.Unmarshal([]byte(data), &providers); err == nil {
+			for _, health := range providers {
+				stored[health.Provider] = health
+			}
+		}
+	}
+
+	result := &apiv2.PresetHealth{PresetName: preset.Name, Providers: []apiv2.PresetProviderHealth{}}
+	for _, providerType := range c.providers(preset) {
+		health, ok := stored[providerType]
+		if !ok {
+			health = apiv2.PresetProviderHealth{
+				Provider: providerType,
+				Status:   apiv2.UnknownPresetCredentialStatus,
+				Message:  "the credentials were not checked yet",
+			}
+		}
+		result.Providers = append(result.Providers, health)
+	}
+	return result
+}
+
+// Run checks the credentials of all presets in the interval until the context is cancelled. It is run by the
+// leader of the API replicas only, so the credentials are not checked by every replica.
+func (c *Checker) Run(ctx context.Context, interval time.Duration, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		c.checkAll(ctx, interval, log)
+	}, interval)
+}
+
+func (c *Checker) checkAll(ctx context.Context, interval time.Duration, log *zap.SugaredLogger) {
+	presets, err := c.presetProvider.GetPresets(ctx, adminUserInfo, nil)
+	if err != nil {
+		log.Warnw("Failed to get the presets for checking their credentials", zap.Error(err))
+		return
+	}
+
+	for i := range presets {
+		preset := &presets[i]
+		// presets checked recently, e.g. by the previous leader or through the endpoints, are skipped
+		if !c.needsCheck(preset, interval) {
+			continue
+		}
+
+		providers := c.check(ctx, preset)
+		for _, health := range providers {
+			if health.Status == apiv2.InvalidPresetCredentialStatus {
+				log.Infow("Invalid preset credentials", "preset", preset.Name, "provider", health.Provider, "message", health.Message)
+			}
+		}
+		if err := c.store(ctx, preset.Name, providers); err != nil {
+			log.Warnw("Failed to store the health of the preset credentials", "preset", preset.Name, zap.Error(err))
+		}
+	}
+}
+
+// needsCheck returns true if a provider of the preset was not checked within the last half of the interval.
+func (c *Checker) needsCheck(preset *kubermaticv1.Preset, interval time.Duration) bool {
+	threshold := c.now().Add(-interval / 2)
+	for _, health := range c.Health(preset).Providers {
+		if health.LastChecked.Time.Before(threshold) {
+			return true
+		}
+	}
+	return false
+}
+
+// providers returns the providers of the preset whose credentials can be checked, sorted by name.
+func (c *Checker) providers(preset *kubermaticv1.Preset) []kubermaticv1.ProviderType {
+	providers := []kubermaticv1.ProviderType{}
+	for providerType, v := range c.validators {
+		if v.preset(&preset.Spec) != nil {
+			providers = append(providers, providerType)
+		}
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i] < providers[j]
+	})
+	return providers
+}
+
+func (c *Checker) check(ctx context.Context, preset *kubermaticv1.Preset) []apiv2.PresetProviderHealth {
+	result := []apiv2.PresetProviderHealth{}
+	for _, providerType := range c.providers(preset) {
+		result = append(result, c.CheckProvider(ctx, preset, providerType))
+	}
+	return result
+}
+
+// CheckProvider validates the credentials of a provider of the preset without storing the result.
+func (c *Checker) CheckProvider(ctx context.Context, preset *kubermaticv1.Preset, providerType kubermaticv1.ProviderType) apiv2.PresetProviderHealth {
+	health := apiv2.PresetProviderHealth{Provider: providerType}
+
+	v, ok := c.validators[providerType]
+	if !ok {
+		return c.result(health, apiv2.UnknownPresetCredentialStatus, "the credentials of the provider cannot be checked")
+	}
+	providerPreset := v.preset(&preset.Spec)
+	if providerPreset == nil {
+		return c.result(health, apiv2.InvalidPresetCredentialStatus, "the preset has no credentials for the provider")
+	}
+
+	var dc *kubermaticv1.Datacenter
+	if v.datacenter != nil {
+		seeds, err := c.seedsGetter()
+		if err != nil {
+			return c.result(health, apiv2.UnknownPresetCredentialStatus, fmt.Sprintf("failed to get the datacenters: %v", err))
+		}
+
+		health.Datacenter, dc = findDatacenter(seeds, providerPreset.Datacenter, v.datacenter)
+		if dc == nil {
+			message := "there is no datacenter of the provider to check the credentials against"
+			if providerPreset.Datacenter != "" {
+				message = fmt.Sprintf("the datacenter %q of the preset does not exist", providerPreset.Datacenter)
+			}
+			return c.result(health, apiv2.UnknownPresetCredentialStatus, message)
+		}
+	}
+
+	return c.checkProvider(ctx, preset, providerType, v, dc, health)
+}
+
+func (c *Checker) checkProvider(ctx context.Context, preset *kubermaticv1.Preset, providerType kubermaticv1.ProviderType, v validator, dc *kubermaticv1.Datacenter, health apiv2.PresetProviderHealth) apiv2.PresetProviderHealth {
+	resolved, err := c.presetProvider.ResolveCredentials(ctx, preset, string(providerType))
+	if err != nil {
+		return c.result(health, apiv2.InvalidPresetCredentialStatus, err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	err = v.validate(ctx, &resolved.Spec, dc, c.caBundle)
+	switch {
+	case err == nil:
+		return c.result(health, apiv2.ValidPresetCredentialStatus, "")
+	case errors.Is(err, errUncheckable):
+		return c.result(health, apiv2.UnknownPresetCredentialStatus, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return c.result(health, apiv2.UnknownPresetCredentialStatus, fmt.Sprintf("the provider did not respond within %v", checkTimeout))
+	default:
+		return c.result(health, apiv2.InvalidPresetCredentialStatus, err.Error())
+	}
+}
+
+func (c *Checker) result(health apiv2.PresetProviderHealth, status apiv2.PresetCredentialStatus, message string) apiv2.PresetProviderHealth {
+	health.Status = status
+	health.Message = message
+	health.LastChecked = apiv1.NewTime(c.now())
+	return health
+}
+
+func (c *Checker) store(ctx context.Context, presetName string, providers []apiv2.PresetProviderHealth) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		preset, err := c.presetProvider.GetPreset(ctx, adminUserInfo, nil, presetName)
+		if err != nil {
+			return err
+		}
+		if err := setHealth(preset, providers); err != nil {
+			return err
+		}
+
+		_, err = c.presetProvider.UpdatePreset(ctx, preset)
+		return err
+	})
+}
+
+// SetHealth records the result of a check of the credentials of a provider in the preset, e.g. before the preset is
+// updated with the checked credentials. The results of the other providers are kept.
+func SetHealth(preset *kubermaticv1.Preset, health apiv2.PresetProviderHealth) error {
+	providers := []apiv2.PresetProviderHealth{}
+	if data, ok := preset.Annotations[HealthAnnotation]; ok {
+		// an invalid annotation is overwritten
+		_ = json.Unmarshal([]byte(data), &providers)
+	}
+
+	result := []apiv2.PresetProviderHealth{}
+	for _, stored := range providers {
+		if stored.Provider != health.Provider {
+			result = append(result, stored)
+		}
+	}
+	return setHealth(preset, append(result, health))
+}
+
+func setHealth(preset *kubermaticv1.Preset, providers []apiv2.PresetProviderHealth) error {
+	data, err := json.Marshal(providers)
+	if err != nil {
+		return err
+	}
+	if preset.Annotations == nil {
+		preset.Annotations = map[string]string{}
+	}
+	preset.Annotations[HealthAnnotation] = string(data)
+	return nil
+}
+
+// findDatacenter returns the datacenter of the preset, or the first datacenter of the provider by name if the
+// preset is not restricted to a datacenter.
+func findDatacenter(seeds map[string]*kubermaticv1.Seed, name string, matches func(spec *kubermaticv1.DatacenterSpec) bool) (string, *kubermaticv1.Datacenter) {
+	datacenters := map[string]kubermaticv1.Datacenter{}
+	for _, seed := range seeds {
+		for dcName, dc := range seed.Spec.Datacenters {
+			if matches(&dc.Spec) && (name == "" || dcName == name) {
+				datacenters[dcName] = dc
+			}
+		}
+	}
+	if len(datacenters) == 0 {
+		return "", nil
+	}
+
+	names := make([]string, 0, len(datacenters))
+	for dcName := range datacenters {
+		names = append(names, dcName)
+	}
+	sort.Strings(names)
+
+	dc := datacenters[names[0]]
+	return names[0], &dc
+}
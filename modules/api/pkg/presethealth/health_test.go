@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package presethealth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testTime = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func testSeeds() map[string]*kubermaticv1.Seed {
+	return map[string]*kubermaticv1.Seed{
+		"europe": {
+			Spec: kubermaticv1.SeedSpec{
+				Datacenters: map[string]kubermaticv1.Datacenter{
+					"hamburg":   {Spec: kubermaticv1.DatacenterSpec{Openstack: &kubermaticv1.DatacenterSpecOpenstack{AuthURL: "https://hamburg"}}},
+					"frankfurt": {Spec: kubermaticv1.DatacenterSpec{Openstack: &kubermaticv1.DatacenterSpecOpenstack{AuthURL: "https://frankfurt"}}},
+					"aws-eu":    {Spec: kubermaticv1.DatacenterSpec{AWS: &kubermaticv1.DatacenterSpecAWS{Region: "eu-central-1"}}},
+				},
+			},
+		},
+	}
+}
+
+// inUTC converts the times of the health to UTC, they are decoded in the local time zone.
+func inUTC(health *apiv2.PresetHealth) *apiv2.PresetHealth {
+	for i, provider := range health.Providers {
+		health.Providers[i].LastChecked = apiv1.NewTime(provider.LastChecked.UTC())
+	}
+	return health
+}
+
+func TestFindDatacenter(t *testing.T) {
+	isOpenstack := func(spec *kubermaticv1.DatacenterSpec) bool { return spec.Openstack != nil }
+	isVSphere := func(spec *kubermaticv1.DatacenterSpec) bool { return spec.VSphere != nil }
+
+	testCases := []struct {
+		name       string
+		datacenter string
+		matches    func(spec *kubermaticv1.DatacenterSpec) bool
+		expected   string
+	}{
+		{name: "first datacenter by name", matches: isOpenstack, expected: "frankfurt"},
+		{name: "datacenter of the preset", datacenter: "hamburg", matches: isOpenstack, expected: "hamburg"},
+		{name: "datacenter of another provider", datacenter: "aws-eu", matches: isOpenstack},
+		{name: "no datacenter of the provider", matches: isVSphere},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, dc := findDatacenter(testSeeds(), tc.datacenter, tc.matches)
+			if name != tc.expected {
+				t.Fatalf("expected datacenter %q, got %q", tc.expected, name)
+			}
+			if (dc == nil) != (tc.expected == "") {
+				t.Fatalf("expected a datacenter: %t, got %v", tc.expected != "", dc)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	preset := &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec: kubermaticv1.PresetSpec{
+			Hetzner:      &kubermaticv1.Hetzner{Token: "valid"},
+			Digitalocean: &kubermaticv1.Digitalocean{Token: "expired"},
+			Openstack:    &kubermaticv1.Openstack{ProviderPreset: kubermaticv1.ProviderPreset{Datacenter: "hamburg"}, Username: "admin"},
+			VSphere:      &kubermaticv1.VSphere{Username: "admin"},
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(preset).Build()
+	presetProvider, err := kubernetes.NewPresetProvider(client, nil)
+	if err != nil {
+		t.Fatalf("failed to create the preset provider: %v", err)
+	}
+
+	checker := NewChecker(presetProvider, func() (map[string]*kubermaticv1.Seed, error) { return testSeeds(), nil }, nil)
+	checker.now = func() time.Time { return testTime }
+	validateToken := func(_ context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+		if spec.Hetzner != nil && spec.Hetzner.Token == "valid" {
+			return nil
+		}
+		return errors.New("unauthorized")
+	}
+	checker.validators = map[kubermaticv1.ProviderType]validator{
+		kubermaticv1.HetznerCloudProvider: {
+			preset:   validators[kubermaticv1.HetznerCloudProvider].preset,
+			validate: validateToken,
+		},
+		kubermaticv1.DigitaloceanCloudProvider: {
+			preset:   validators[kubermaticv1.DigitaloceanCloudProvider].preset,
+			validate: validateToken,
+		},
+		kubermaticv1.OpenstackCloudProvider: {
+			preset:     validators[kubermaticv1.OpenstackCloudProvider].preset,
+			datacenter: validators[kubermaticv1.OpenstackCloudProvider].datacenter,
+			validate: func(_ context.Context, _ *kubermaticv1.PresetSpec, dc *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+				if dc.Spec.Openstack.AuthURL != "https://hamburg" {
+					return errors.New("unexpected datacenter")
+				}
+				return nil
+			},
+		},
+		kubermaticv1.VSphereCloudProvider: validators[kubermaticv1.VSphereCloudProvider],
+	}
+
+	health, err := checker.Check(context.Background(), preset)
+	if err != nil {
+		t.Fatalf("failed to check the preset: %v", err)
+	}
+
+	lastChecked := apiv1.NewTime(testTime)
+	expected := &apiv2.PresetHealth{
+		PresetName: "team",
+		Providers: []apiv2.PresetProviderHealth{
+			{Provider: kubermaticv1.DigitaloceanCloudProvider, Status: apiv2.InvalidPresetCredentialStatus, Message: "unauthorized", LastChecked: lastChecked},
+			{Provider: kubermaticv1.HetznerCloudProvider, Status: apiv2.ValidPresetCredentialStatus, LastChecked: lastChecked},
+			{Provider: kubermaticv1.OpenstackCloudProvider, Status: apiv2.ValidPresetCredentialStatus, Datacenter: "hamburg", LastChecked: lastChecked},
+			{Provider: kubermaticv1.VSphereCloudProvider, Status: apiv2.UnknownPresetCredentialStatus, Message: "there is no datacenter of the provider to check the credentials against", LastChecked: lastChecked},
+		},
+	}
+	if !equality.Semantic.DeepEqual(health, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, health)
+	}
+
+	// the results are stored in the preset
+	stored := &kubermaticv1.Preset{}
+	if err := client.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(preset), stored); err != nil {
+		t.Fatalf("failed to get the preset: %v", err)
+	}
+	if health := inUTC(checker.Health(stored)); !equality.Semantic.DeepEqual(health, expected) {
+		t.Fatalf("expected the stored health %+v, got %+v", expected, health)
+	}
+
+	if checker.needsCheck(stored, time.Hour) {
+		t.Error("expected a recently checked preset to be skipped")
+	}
+	checker.now = func() time.Time { return testTime.Add(time.Hour) }
+	if !checker.needsCheck(stored, time.Hour) {
+		t.Error("expected the preset to be checked after the interval")
+	}
+}
+
+func TestHealth(t *testing.T) {
+	checker := NewChecker(nil, nil, nil)
+	preset := &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team",
+			Annotations: map[string]string{
+				HealthAnnotation: `[{"provider":"hetzner","status":"Valid","lastChecked":"2026-01-01T12:00:00Z"},{"provider":"aws","status":"Invalid","lastChecked":"2026-01-01T12:00:00Z"}]`,
+			},
+		},
+		Spec: kubermaticv1.PresetSpec{
+			Hetzner: &kubermaticv1.Hetzner{Token: "token"},
+			GCP:     &kubermaticv1.GCP{ServiceAccount: "sa"},
+			Fake:    &kubermaticv1.Fake{Token: "token"},
+		},
+	}
+
+	expected := &apiv2.PresetHealth{
+		PresetName: "team",
+		Providers: []apiv2.PresetProviderHealth{
+			{Provider: kubermaticv1.GCPCloudProvider, Status: apiv2.UnknownPresetCredentialStatus, Message: "the credentials were not checked yet"},
+			{Provider: kubermaticv1.HetznerCloudProvider, Status: apiv2.ValidPresetCredentialStatus, LastChecked: apiv1.NewTime(testTime)},
+		},
+	}
+	if health := inUTC(checker.Health(preset)); !equality.Semantic.DeepEqual(health, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, health)
+	}
+
+	if !checker.needsCheck(preset, time.Hour) {
+		t.Error("expected a preset with unchecked providers to be checked")
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package presethealth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	"k8c.io/dashboard/v2/pkg/provider/cloud/aks"
+	awsprovider "k8c.io/dashboard/v2/pkg/provider/cloud/aws"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/azure"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/digitalocean"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/gcp"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/gke"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/hetzner"
+	"k8c.io/dashboard/v2/pkg/provider/cloud/openstack"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/nutanix"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/vmwareclouddirector"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/vsphere"
+	"k8c.io/kubermatic/v2/pkg/resources"
+)
+
+// errUncheckable is returned by validators for credentials which cannot be checked without a user.
+var errUncheckable = errors.New("the preset uses the token of the user, which can only be checked when a cluster is created")
+
+// validator checks the credentials of a provider with a cheap authenticated call.
+type validator struct {
+	// preset returns the preset of the provider, nil if the preset has no credentials for the provider.
+	preset func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset
+	// datacenter returns true for the datacenters of the provider. It is nil if the endpoint of the provider is
+	// not configured in the datacenter.
+	datacenter func(spec *kubermaticv1.DatacenterSpec) bool
+	// validate calls the provider with the credentials. The datacenter is nil if it is not needed.
+	validate func(ctx context.Context, spec *kubermaticv1.PresetSpec, dc *kubermaticv1.Datacenter, caBundle *x509.CertPool) error
+}
+
+// validators are the validators of the providers whose credentials are checked.
+var validators = map[kubermaticv1.ProviderType]validator{
+	kubermaticv1.AWSCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.AWS == nil {
+				return nil
+			}
+			return &spec.AWS.ProviderPreset
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			return awsprovider.ValidateCredentials(ctx, spec.AWS.AccessKeyID, spec.AWS.SecretAccessKey)
+		},
+	},
+	kubermaticv1.GCPCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.GCP == nil {
+				return nil
+			}
+			return &spec.GCP.ProviderPreset
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			return gcp.ValidateCredentials(ctx, spec.GCP.ServiceAccount)
+		},
+	},
+	kubermaticv1.AzureCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.Azure == nil {
+				return nil
+			}
+			return &spec.Azure.ProviderPreset
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			cred, err := azure.Credentials{
+				TenantID:       spec.Azure.TenantID,
+				SubscriptionID: spec.Azure.SubscriptionID,
+				ClientID:       spec.Azure.ClientID,
+				ClientSecret:   spec.Azure.ClientSecret,
+			}.ToAzureCredential()
+			if err != nil {
+				return err
+			}
+			return azure.ValidateCredentials(ctx, cred, spec.Azure.SubscriptionID)
+		},
+	},
+	kubermaticv1.OpenstackCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.Openstack == nil {
+				return nil
+			}
+			return &spec.Openstack.ProviderPreset
+		},
+		datacenter: func(spec *kubermaticv1.DatacenterSpec) bool {
+			return spec.Openstack != nil
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, dc *kubermaticv1.Datacenter, caBundle *x509.CertPool) error {
+			credentials := spec.Openstack
+			if credentials.UseToken {
+				return errUncheckable
+			}
+			return openstack.ValidateCredentials(ctx, dc.Spec.Openstack.AuthURL, dc.Spec.Openstack.Region, &resources.OpenstackCredentials{
+				Username:                    credentials.Username,
+				Password:                    credentials.Password,
+				Project:                     credentials.Project,
+				ProjectID:                   credentials.ProjectID,
+				Domain:                      credentials.Domain,
+				ApplicationCredentialID:     credentials.ApplicationCredentialID,
+				ApplicationCredentialSecret: credentials.ApplicationCredentialSecret,
+			}, caBundle)
+		},
+	},
+	kubermaticv1.VSphereCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.VSphere == nil {
+				return nil
+			}
+			return &spec.VSphere.ProviderPreset
+		},
+		datacenter: func(spec *kubermaticv1.DatacenterSpec) bool {
+			return spec.VSphere != nil
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, dc *kubermaticv1.Datacenter, caBundle *x509.CertPool) error {
+			return vsphere.ValidateCredentials(ctx, dc.Spec.VSphere, spec.VSphere.Username, spec.VSphere.Password, caBundle)
+		},
+	},
+	kubermaticv1.HetznerCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.Hetzner == nil {
+				return nil
+			}
+			return &spec.Hetzner.ProviderPreset
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			return hetzner.ValidateCredentials(ctx, spec.Hetzner.Token)
+		},
+	},
+	kubermaticv1.DigitaloceanCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.Digitalocean == nil {
+				return nil
+			}
+			return &spec.Digitalocean.ProviderPreset
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			return digitalocean.ValidateCredentials(ctx, spec.Digitalocean.Token)
+		},
+	},
+	kubermaticv1.NutanixCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.Nutanix == nil {
+				return nil
+			}
+			return &spec.Nutanix.ProviderPreset
+		},
+		datacenter: func(spec *kubermaticv1.DatacenterSpec) bool {
+			return spec.Nutanix != nil
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, dc *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			dcSpec := dc.Spec.Nutanix
+			return nutanix.ValidateCredentials(ctx, dcSpec.Endpoint, dcSpec.Port, &dcSpec.AllowInsecure, spec.Nutanix.ProxyURL, spec.Nutanix.Username, spec.Nutanix.Password)
+		},
+	},
+	kubermaticv1.VMwareCloudDirectorCloudProvider: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.VMwareCloudDirector == nil {
+				return nil
+			}
+			return &spec.VMwareCloudDirector.ProviderPreset
+		},
+		datacenter: func(spec *kubermaticv1.DatacenterSpec) bool {
+			return spec.VMwareCloudDirector != nil
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, dc *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			credentials := spec.VMwareCloudDirector
+			return vmwareclouddirector.ValidateCredentials(ctx, dc.Spec.VMwareCloudDirector, credentials.Username, credentials.Password, credentials.APIToken, credentials.Organization, credentials.VDC)
+		},
+	},
+	kubermaticv1.EKSProviderType: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.EKS == nil {
+				return nil
+			}
+			return &spec.EKS.ProviderPreset
+		},
+		// the preset has no region to list the clusters in, the credentials are checked like those of AWS
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			return awsprovider.ValidateCredentials(ctx, spec.EKS.AccessKeyID, spec.EKS.SecretAccessKey)
+		},
+	},
+	kubermaticv1.AKSProviderType: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.AKS == nil {
+				return nil
+			}
+			return &spec.AKS.ProviderPreset
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			return aks.ValidateCredentials(ctx, resources.AKSCredentials{
+				TenantID:       spec.AKS.TenantID,
+				SubscriptionID: spec.AKS.SubscriptionID,
+				ClientID:       spec.AKS.ClientID,
+				ClientSecret:   spec.AKS.ClientSecret,
+			})
+		},
+	},
+	kubermaticv1.GKEProviderType: {
+		preset: func(spec *kubermaticv1.PresetSpec) *kubermaticv1.ProviderPreset {
+			if spec.GKE == nil {
+				return nil
+			}
+			return &spec.GKE.ProviderPreset
+		},
+		validate: func(ctx context.Context, spec *kubermaticv1.PresetSpec, _ *kubermaticv1.Datacenter, _ *x509.CertPool) error {
+			return gke.ValidateCredentials(ctx, spec.GKE.ServiceAccount)
+		},
+	},
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pricing estimates the monthly cost of machine deployments, clusters and projects from a catalog
+// with the prices of the cloud providers.
+package pricing
+
+import (
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// HoursPerMonth is the average number of hours in a month, which hourly prices are multiplied with.
+const HoursPerMonth = 730
+
+// Source provides the current price catalog.
+type Source interface {
+	Catalog() *Catalog
+}
+
+// Catalog are the prices of the cloud providers, e.g.
+//
+//	currency: EUR
+//	providers:
+//	  aws:
+//	    instanceTypes:
+//	      t3.large: 0.0912
+//	    storageGiBMonth: 0.0952
+//	    datacenters:
+//	      aws-eu-west-1:
+//	        instanceTypes:
+//	          t3.large: 0.0864
+//	  vsphere:
+//	    cpuHour: 0.02
+//	    memoryGiBHour: 0.005
+//	    storageGiBMonth: 0.05
+type Catalog struct {
+	// Currency is the currency of all prices.
+	Currency string `json:"currency"`
+	// Providers are the prices of the cloud providers by their names, e.g. aws.
+	Providers map[string]ProviderPrices `json:"providers"`
+}
+
+// ProviderPrices are the prices of a cloud provider, which can be overridden in single datacenters.
+type ProviderPrices struct {
+	Prices
+	// Datacenters are the prices overridden in the datacenters by their names.
+	Datacenters map[string]Prices `json:"datacenters,omitempty"`
+}
+
+// Prices are the prices of the nodes and control planes. The nodes of providers with instance types are priced
+// by their instance type, the nodes of the others by their CPUs and memory. All prices are optional.
+type Prices struct {
+	// InstanceTypes are the prices per hour of the instance types, flavors or sizes by their names.
+	InstanceTypes map[string]float64 `json:"instanceTypes,omitempty"`
+	// CPUHour is the price of a CPU per hour.
+	CPUHour *float64 `json:"cpuHour,omitempty"`
+	// MemoryGiBHour is the price of a GiB of memory per hour.
+	MemoryGiBHour *float64 `json:"memoryGiBHour,omitempty"`
+	// StorageGiBMonth is the price of a GiB of disk per month, which is added to the price of the nodes.
+	StorageGiBMonth *float64 `json:"storageGiBMonth,omitempty"`
+	// ControlPlaneMonth is the price of the control plane of a cluster per month.
+	ControlPlaneMonth *float64 `json:"controlPlaneMonth,omitempty"`
+}
+
+// Catalog returns the catalog itself, so a catalog is a source which never changes.
+func (c *Catalog) Catalog() *Catalog {
+	return c
+}
+
+// ParseCatalog parses a catalog in YAML and validates it.
+func ParseCatalog(data []byte) (*Catalog, error) {
+	catalog := &Catalog{}
+	if err := yaml.UnmarshalStrict(data, catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse the price catalog: %w", err)
+	}
+	if err := catalog.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid price catalog: %w", err)
+	}
+	return catalog, nil
+}
+
+func (c *Catalog) Validate() error {
+	if c.Currency == "" {
+		return errors.New("the currency is required")
+	}
+	for name, provider := range c.Providers {
+		if err := provider.validate(); err != nil {
+			return fmt.Errorf("provider %s: %w", name, err)
+		}
+		for dc, prices := range provider.Datacenters {
+			if err := prices.validate(); err != nil {
+				return fmt.Errorf("provider %s, datacenter %s: %w", name, dc, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (p Prices) validate() error {
+	for name, price := range p.InstanceTypes {
+		if price < 0 {
+			return fmt.Errorf("the price of the instance type %s must not be negative", name)
+		}
+	}
+	prices := map[string]*float64{
+		"cpuHour":           p.CPUHour,
+		"memoryGiBHour":     p.MemoryGiBHour,
+		"storageGiBMonth":   p.StorageGiBMonth,
+		"controlPlaneMonth": p.ControlPlaneMonth,
+	}
+	for name, price := range prices {
+		if price != nil && *price < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	return nil
+}
+
+// Prices returns the prices of the provider in the datacenter, which are those of the provider overridden by
+// those of the datacenter. It returns false if the catalog has no prices for the provider.
+func (c *Catalog) Prices(provider, datacenter string) (Prices, bool) {
+	providerPrices, ok := c.Providers[provider]
+	if !ok {
+		return Prices{}, false
+	}
+
+	prices := providerPrices.Prices
+	override, ok := providerPrices.Datacenters[datacenter]
+	if !ok {
+		return prices, true
+	}
+
+	instanceTypes := make(map[string]float64, len(prices.InstanceTypes)+len(override.InstanceTypes))
+	for name, price := range prices.InstanceTypes {
+		instanceTypes[name] = price
+	}
+	for name, price := range override.InstanceTypes {
+		instanceTypes[name] = price
+	}
+	prices.InstanceTypes = instanceTypes
+
+	if override.CPUHour != nil {
+		prices.CPUHour = override.CPUHour
+	}
+	if override.MemoryGiBHour != nil {
+		prices.MemoryGiBHour = override.MemoryGiBHour
+	}
+	if override.StorageGiBMonth != nil {
+		prices.StorageGiBMonth = override.StorageGiBMonth
+	}
+	if override.ControlPlaneMonth != nil {
+		prices.ControlPlaneMonth = override.ControlPlaneMonth
+	}
+	return prices, true
+}
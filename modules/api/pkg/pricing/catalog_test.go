@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const testCatalog = `
+currency: EUR
+providers:
+  aws:
+    instanceTypes:
+      t3.large: 0.1
+      m5.xlarge: 0.2
+    storageGiBMonth: 0.1
+    controlPlaneMonth: 50
+    datacenters:
+      aws-eu-west-1:
+        instanceTypes:
+          t3.large: 0.08
+        controlPlaneMonth: 40
+  vsphere:
+    cpuHour: 0.02
+    memoryGiBHour: 0.005
+    storageGiBMonth: 0.05
+`
+
+func TestParseCatalog(t *testing.T) {
+	testCases := []struct {
+		name    string
+		catalog string
+		valid   bool
+	}{
+		{
+			name:    "valid",
+			catalog: testCatalog,
+			valid:   true,
+		},
+		{
+			name:    "no currency",
+			catalog: "providers: {}",
+		},
+		{
+			name:    "negative price",
+			catalog: "currency: EUR\nproviders:\n  vsphere:\n    datacenters:\n      dc:\n        cpuHour: -1",
+		},
+		{
+			name:    "unknown field",
+			catalog: "currency: EUR\nproviders:\n  aws:\n    instanceType:\n      t3.large: 0.1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseCatalog([]byte(tc.catalog))
+			if tc.valid && err != nil {
+				t.Errorf("expected the catalog to be valid, got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected the catalog to be invalid")
+			}
+		})
+	}
+}
+
+func TestCatalogPrices(t *testing.T) {
+	catalog, err := ParseCatalog([]byte(testCatalog))
+	if err != nil {
+		t.Fatalf("failed to parse the catalog: %v", err)
+	}
+
+	prices, ok := catalog.Prices("aws", "aws-eu-west-1")
+	if !ok {
+		t.Fatal("expected prices for aws")
+	}
+	if prices.InstanceTypes["t3.large"] != 0.08 || prices.InstanceTypes["m5.xlarge"] != 0.2 {
+		t.Errorf("expected the datacenter to override only its instance types, got %v", prices.InstanceTypes)
+	}
+	if *prices.ControlPlaneMonth != 40 || *prices.StorageGiBMonth != 0.1 {
+		t.Errorf("expected the datacenter to override only its prices, got %+v", prices)
+	}
+
+	if prices, _ := catalog.Prices("aws", "aws-us-east-1"); prices.InstanceTypes["t3.large"] != 0.1 {
+		t.Errorf("expected the prices of the provider in other datacenters, got %v", prices.InstanceTypes)
+	}
+	if _, ok := catalog.Prices("gcp", ""); ok {
+		t.Error("expected no prices for gcp")
+	}
+}
+
+func TestFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	if err := os.WriteFile(path, []byte(testCatalog), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := NewFileSource(path, zap.NewNop().Sugar())
+	if err != nil {
+		t.Fatalf("failed to read the catalog: %v", err)
+	}
+	if currency := source.Catalog().Currency; currency != "EUR" {
+		t.Fatalf("expected EUR, got %s", currency)
+	}
+
+	if err := os.WriteFile(path, []byte("currency: USD"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	modTime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err := source.refresh(); err != nil || !reloaded {
+		t.Fatalf("expected the changed catalog to be reloaded, got %v", err)
+	}
+	if reloaded, _ := source.refresh(); reloaded {
+		t.Error("expected the unchanged catalog not to be reloaded")
+	}
+
+	if err := os.WriteFile(path, []byte("currency: ["), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	modTime = modTime.Add(time.Minute)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.refresh(); err == nil {
+		t.Error("expected the invalid catalog to be rejected")
+	}
+	if currency := source.Catalog().Currency; currency != "USD" {
+		t.Errorf("expected the last valid catalog to be kept, got %s", currency)
+	}
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const gibi = 1 << 30
+
+// nodeSize is the size of the nodes of a machine deployment. The nodes of providers with instance types only
+// have their instance type and disk, as their CPUs and memory are not part of the machine deployment.
+type nodeSize struct {
+	instanceType string
+	cpus         float64
+	memoryGiB    float64
+	storageGiB   float64
+}
+
+// nodeSizeFromSpec returns the size of the nodes with the spec, the storage is the disk which is priced
+// separately from the instance type.
+func nodeSizeFromSpec(spec apiv1.NodeCloudSpec) (nodeSize, error) {
+	switch {
+	case spec.AWS != nil:
+		return nodeSize{instanceType: spec.AWS.InstanceType, storageGiB: float64(spec.AWS.VolumeSize)}, nil
+	case spec.Azure != nil:
+		return nodeSize{instanceType: spec.Azure.Size, storageGiB: float64(spec.Azure.OSDiskSize + spec.Azure.DataDiskSize)}, nil
+	case spec.Digitalocean != nil:
+		return nodeSize{instanceType: spec.Digitalocean.Size}, nil
+	case spec.GCP != nil:
+		return nodeSize{instanceType: spec.GCP.MachineType, storageGiB: float64(spec.GCP.DiskSize)}, nil
+	case spec.Hetzner != nil:
+		return nodeSize{instanceType: spec.Hetzner.Type}, nil
+	case spec.Openstack != nil:
+		size := nodeSize{instanceType: spec.Openstack.Flavor}
+		// the disk of the flavor is part of its price, a custom root disk is a volume
+		if spec.Openstack.RootDiskSizeGB != nil {
+			size.storageGiB = float64(*spec.Openstack.RootDiskSizeGB)
+		}
+		return size, nil
+	case spec.Alibaba != nil:
+		return alibabaNodeSize(spec.Alibaba)
+	case spec.Anexia != nil:
+		return anexiaNodeSize(spec.Anexia), nil
+	case spec.Nutanix != nil:
+		size := nodeSize{cpus: float64(spec.Nutanix.CPUs), memoryGiB: float64(spec.Nutanix.MemoryMB) / 1024}
+		if spec.Nutanix.DiskSize != nil {
+			size.storageGiB = float64(*spec.Nutanix.DiskSize)
+		}
+		return size, nil
+	case spec.VSphere != nil:
+		size := nodeSize{cpus: float64(spec.VSphere.CPUs), memoryGiB: float64(spec.VSphere.Memory) / 1024}
+		if spec.VSphere.DiskSizeGB != nil {
+			size.storageGiB = float64(*spec.VSphere.DiskSizeGB)
+		}
+		return size, nil
+	case spec.VMwareCloudDirector != nil:
+		cpus := spec.VMwareCloudDirector.CPUs
+		if spec.VMwareCloudDirector.CPUCores > 0 {
+			cpus *= spec.VMwareCloudDirector.CPUCores
+		}
+		size := nodeSize{cpus: float64(cpus), memoryGiB: float64(spec.VMwareCloudDirector.MemoryMB) / 1024}
+		if spec.VMwareCloudDirector.DiskSizeGB != nil {
+			size.storageGiB = float64(*spec.VMwareCloudDirector.DiskSizeGB)
+		}
+		return size, nil
+	case spec.Kubevirt != nil:
+		return kubevirtNodeSize(spec.Kubevirt)
+	default:
+		return nodeSize{}, errors.New("the cost of the nodes of this provider cannot be estimated")
+	}
+}
+
+func alibabaNodeSize(spec *apiv1.AlibabaNodeSpec) (nodeSize, error) {
+	size := nodeSize{instanceType: spec.InstanceType}
+	if spec.DiskSize != "" {
+		disk, err := strconv.Atoi(spec.DiskSize)
+		if err != nil {
+			return nodeSize{}, fmt.Errorf("invalid disk size %q", spec.DiskSize)
+		}
+		size.storageGiB = float64(disk)
+	}
+	return size, nil
+}
+
+func anexiaNodeSize(spec *apiv1.AnexiaNodeSpec) nodeSize {
+	size := nodeSize{cpus: float64(spec.CPUs), memoryGiB: float64(spec.Memory) / 1024}
+	if spec.DiskSize != nil {
+		size.storageGiB = float64(*spec.DiskSize)
+	} else {
+		for _, disk := range spec.Disks {
+			size.storageGiB += float64(disk.Size)
+		}
+	}
+	return size
+}
+
+// kubevirtNodeSize returns the instance type of the virtual machines or their CPUs and memory. Sizes without
+// a unit are MiB for the memory and GiB for the disks, like in the resource quota calculation.
+func kubevirtNodeSize(spec *apiv1.KubevirtNodeSpec) (nodeSize, error) {
+	size := nodeSize{}
+	if spec.Instancetype != nil && spec.Instancetype.Name != "" {
+		size.instanceType = spec.Instancetype.Name
+	} else {
+		cpus, err := resource.ParseQuantity(spec.CPUs)
+		if err != nil {
+			return nodeSize{}, fmt.Errorf("invalid CPUs %q", spec.CPUs)
+		}
+		memory, err := parseQuantity(spec.Memory, "Mi")
+		if err != nil {
+			return nodeSize{}, fmt.Errorf("invalid memory %q", spec.Memory)
+		}
+		size.cpus = cpus.AsApproximateFloat64()
+		size.memoryGiB = memory.AsApproximateFloat64() / gibi
+	}
+
+	disks := []string{spec.PrimaryDiskSize}
+	for _, disk := range spec.SecondaryDisks {
+		disks = append(disks, disk.Size)
+	}
+	for _, disk := range disks {
+		if disk == "" {
+			continue
+		}
+		storage, err := parseQuantity(disk, "Gi")
+		if err != nil {
+			return nodeSize{}, fmt.Errorf("invalid disk size %q", disk)
+		}
+		size.storageGiB += storage.AsApproximateFloat64() / gibi
+	}
+	return size, nil
+}
+
+func parseQuantity(value, defaultUnit string) (resource.Quantity, error) {
+	if _, err := strconv.Atoi(value); err == nil {
+		value += defaultUnit
+	}
+	return resource.ParseQuantity(value)
+}
+
+// nodeMonthly returns the cost of a node per month.
+func (p Prices) nodeMonthly(size nodeSize) (float64, error) {
+	var hourly float64
+	if size.instanceType != "" {
+		price, ok := p.InstanceTypes[size.instanceType]
+		if !ok {
+			return 0, fmt.Errorf("no price for the instance type %s", size.instanceType)
+		}
+		hourly = price
+	} else {
+		if p.CPUHour == nil || p.MemoryGiBHour == nil {
+			return 0, errors.New("no prices for CPUs and memory")
+		}
+		hourly = size.cpus*(*p.CPUHour) + size.memoryGiB*(*p.MemoryGiBHour)
+	}
+
+	monthly := hourly * HoursPerMonth
+	if p.StorageGiBMonth != nil {
+		monthly += size.storageGiB * *p.StorageGiBMonth
+	}
+	return monthly, nil
+}
+
+// EstimateMachineDeployment estimates the monthly cost of the machine deployment with the prices. It returns
+// an unpriced estimate and the reason if the catalog has no price for its nodes.
+func (p Prices) EstimateMachineDeployment(md apiv1.NodeDeployment) (apiv2.MachineDeploymentCostEstimate, error) {
+	estimate := apiv2.MachineDeploymentCostEstimate{
+		Name:        md.Name,
+		Replicas:    md.Spec.Replicas,
+		MinReplicas: md.Spec.Replicas,
+		MaxReplicas: md.Spec.Replicas,
+	}
+	if md.Spec.MinReplicas != nil {
+		estimate.MinReplicas = int32(*md.Spec.MinReplicas)
+	}
+	if md.Spec.MaxReplicas != nil {
+		estimate.MaxReplicas = int32(*md.Spec.MaxReplicas)
+	}
+
+	size, err := nodeSizeFromSpec(md.Spec.Template.Cloud)
+	if err != nil {
+		return estimate, err
+	}
+	estimate.InstanceType = size.instanceType
+
+	nodeMonthly, err := p.nodeMonthly(size)
+	if err != nil {
+		return estimate, err
+	}
+	estimate.Priced = true
+	estimate.NodeMonthly = round(nodeMonthly)
+	estimate.Monthly = round(nodeMonthly * float64(estimate.Replicas))
+	estimate.MinMonthly = round(nodeMonthly * float64(estimate.MinReplicas))
+	estimate.MaxMonthly = round(nodeMonthly * float64(estimate.MaxReplicas))
+	return estimate, nil
+}
+
+// EstimateCluster estimates the monthly cost of a cluster of the provider in the datacenter with the machine
+// deployments. Machine deployments without a price are part of the estimate with a warning.
+func (c *Catalog) EstimateCluster(provider, datacenter string, mds []apiv1.NodeDeployment) apiv2.ClusterCostEstimate {
+	estimate := apiv2.ClusterCostEstimate{
+		Provider:           provider,
+		Datacenter:         datacenter,
+		Currency:           c.Currency,
+		MachineDeployments: []apiv2.MachineDeploymentCostEstimate{},
+	}
+
+	prices, ok := c.Prices(provider, datacenter)
+	if !ok {
+		estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("The price catalog has no prices for the provider %s.", provider))
+	}
+	if prices.ControlPlaneMonth != nil {
+		estimate.ControlPlane = *prices.ControlPlaneMonth
+	}
+	estimate.Monthly = estimate.ControlPlane
+	estimate.MinMonthly = estimate.ControlPlane
+	estimate.MaxMonthly = estimate.ControlPlane
+
+	for _, md := range mds {
+		mdEstimate, err := prices.EstimateMachineDeployment(md)
+		if err != nil && ok {
+			estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("The cost of the machine deployment %s is unknown: %v.", md.Name, err))
+		}
+		estimate.MachineDeployments = append(estimate.MachineDeployments, mdEstimate)
+		estimate.Monthly += mdEstimate.Monthly
+		estimate.MinMonthly += mdEstimate.MinMonthly
+		estimate.MaxMonthly += mdEstimate.MaxMonthly
+	}
+	sort.Slice(estimate.MachineDeployments, func(i, j int) bool {
+		return estimate.MachineDeployments[i].Name < estimate.MachineDeployments[j].Name
+	})
+
+	estimate.Monthly = round(estimate.Monthly)
+	estimate.MinMonthly = round(estimate.MinMonthly)
+	estimate.MaxMonthly = round(estimate.MaxMonthly)
+	return estimate
+}
+
+// EstimateProject sums up the estimates of the clusters of a project.
+func (c *Catalog) EstimateProject(projectID string, clusters []apiv2.ClusterCostEstimate) apiv2.ProjectCostEstimate {
+	estimate := apiv2.ProjectCostEstimate{
+		ProjectID: projectID,
+		Currency:  c.Currency,
+		Clusters:  clusters,
+	}
+	sort.Slice(estimate.Clusters, func(i, j int) bool {
+		return estimate.Clusters[i].Name < estimate.Clusters[j].Name
+	})
+
+	for _, cluster := range estimate.Clusters {
+		estimate.Monthly += cluster.Monthly
+		estimate.MinMonthly += cluster.MinMonthly
+		estimate.MaxMonthly += cluster.MaxMonthly
+		for _, warning := range cluster.Warnings {
+			estimate.Warnings = append(estimate.Warnings, fmt.Sprintf("Cluster %s: %s", cluster.Name, warning))
+		}
+	}
+
+	estimate.Monthly = round(estimate.Monthly)
+	estimate.MinMonthly = round(estimate.MinMonthly)
+	estimate.MaxMonthly = round(estimate.MaxMonthly)
+	return estimate
+}
+
+// round rounds the cost to cents.
+func round(cost float64) float64 {
+	return math.Round(cost*100) / 100
+}
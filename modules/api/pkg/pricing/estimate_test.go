@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+func nodeDeployment(name string, replicas int32, cloud apiv1.NodeCloudSpec) apiv1.NodeDeployment {
+	return apiv1.NodeDeployment{
+		ObjectMeta: apiv1.ObjectMeta{Name: name},
+		Spec: apiv1.NodeDeploymentSpec{
+			Replicas: replicas,
+			Template: apiv1.NodeSpec{Cloud: cloud},
+		},
+	}
+}
+
+func TestEstimateCluster(t *testing.T) {
+	catalog, err := ParseCatalog([]byte(testCatalog))
+	if err != nil {
+		t.Fatalf("failed to parse the catalog: %v", err)
+	}
+
+	workers := nodeDeployment("workers", 3, apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.large", VolumeSize: 50}})
+	workers.Spec.MinReplicas = ptr.To[uint32](1)
+	workers.Spec.MaxReplicas = ptr.To[uint32](5)
+	gpus := nodeDeployment("gpus", 1, apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.2xlarge"}})
+
+	estimate := catalog.EstimateCluster("aws", "aws-eu-west-1", []apiv1.NodeDeployment{workers, gpus})
+
+	// a node costs 0.08 * 730 + 50 * 0.1 = 63.4
+	if estimate.MachineDeployments[1].NodeMonthly != 63.4 {
+		t.Errorf("expected a node to cost 63.4, got %v", estimate.MachineDeployments[1].NodeMonthly)
+	}
+	if estimate.ControlPlane != 40 || estimate.Monthly != 230.2 || estimate.MinMonthly != 103.4 || estimate.MaxMonthly != 357 {
+		t.Errorf("expected the cluster to cost 40 + 190.2 between 103.4 and 357, got %+v", estimate)
+	}
+	if estimate.MachineDeployments[0].Name != "gpus" || estimate.MachineDeployments[0].Priced {
+		t.Errorf("expected the gpus to be unpriced, got %+v", estimate.MachineDeployments[0])
+	}
+	if len(estimate.Warnings) != 1 {
+		t.Errorf("expected a warning for the gpus, got %v", estimate.Warnings)
+	}
+
+	vsphere := nodeDeployment("workers", 2, apiv1.NodeCloudSpec{VSphere: &apiv1.VSphereNodeSpec{CPUs: 4, Memory: 8192, DiskSizeGB: ptr.To[int64](100)}})
+	estimate = catalog.EstimateCluster("vsphere", "vsphere-ger", []apiv1.NodeDeployment{vsphere})
+
+	// a node costs (4 * 0.02 + 8 * 0.005) * 730 + 100 * 0.05 = 92.6
+	if estimate.Monthly != 185.2 || len(estimate.Warnings) != 0 {
+		t.Errorf("expected the cluster to cost 185.2, got %+v", estimate)
+	}
+
+	estimate = catalog.EstimateCluster("gcp", "gcp-westeurope", []apiv1.NodeDeployment{
+		nodeDeployment("workers", 2, apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "e2-standard-2"}}),
+	})
+	if estimate.Monthly != 0 || len(estimate.Warnings) != 1 {
+		t.Errorf("expected a single warning for a provider without prices, got %+v", estimate)
+	}
+}
+
+func TestKubevirtNodeSize(t *testing.T) {
+	size, err := kubevirtNodeSize(&apiv1.KubevirtNodeSpec{
+		CPUs:            "2",
+		Memory:          "4096",
+		PrimaryDiskSize: "20",
+		SecondaryDisks:  []apiv1.SecondaryDisks{{Size: "10Gi"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if size.cpus != 2 || size.memoryGiB != 4 || size.storageGiB != 30 {
+		t.Errorf("expected 2 CPUs, 4 GiB memory and 30 GiB disks, got %+v", size)
+	}
+
+	if _, err := kubevirtNodeSize(&apiv1.KubevirtNodeSpec{CPUs: "two"}); err == nil {
+		t.Error("expected an error for invalid CPUs")
+	}
+}
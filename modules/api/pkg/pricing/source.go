@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultRefreshInterval is the interval in which the catalog file is checked for changes.
+const DefaultRefreshInterval = time.Minute
+
+// FileSource reads the catalog from a YAML file, e.g. a mounted ConfigMap, and reloads it when the file changes.
+type FileSource struct {
+	path string
+	log  *zap.SugaredLogger
+
+	lock    sync.RWMutex
+	catalog *Catalog
+	modTime time.Time
+}
+
+// NewFileSource reads the catalog from the file, which must exist and be valid.
+func NewFileSource(path string, log *zap.SugaredLogger) (*FileSource, error) {
+	s := &FileSource{path: path, log: log}
+	if _, err := s.refresh(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Start reloads the catalog in the interval until the context is cancelled.
+func (s *FileSource) Start(ctx context.Context, interval time.Duration) {
+	go wait.UntilWithContext(ctx, func(context.Context) {
+		reloaded, err := s.refresh()
+		if err != nil {
+			// the prices of the last valid catalog are used until the file is fixed
+			s.log.Warnw("Failed to reload the price catalog", "file", s.path, zap.Error(err))
+			return
+		}
+		if reloaded {
+			s.log.Infow("Reloaded the price catalog", "file", s.path)
+		}
+	}, interval)
+}
+
+func (s *FileSource) Catalog() *Catalog {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.catalog
+}
+
+// refresh reads the file if it was modified since it was read last and tells whether the catalog changed.
+func (s *FileSource) refresh() (bool, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the price catalog: %w", err)
+	}
+
+	s.lock.RLock()
+	unchanged := s.catalog != nil && info.ModTime().Equal(s.modTime)
+	s.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the price catalog: %w", err)
+	}
+	catalog, err := ParseCatalog(data)
+	if err != nil {
+		return false, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.catalog = catalog
+	s.modTime = info.ModTime()
+	return true, nil
+}
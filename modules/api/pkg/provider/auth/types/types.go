@@ -18,9 +18,11 @@ package types
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/gorilla/securecookie"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
@@ -56,6 +58,14 @@ type OIDCIssuer interface {
 	// RefreshAccessToken uses a refresh token to obtain a new OIDC token.
 	RefreshAccessToken(ctx context.Context, refreshToken string) (OIDCToken, error)
 
+	// DeviceAuth starts an OAuth 2.0 device authorization grant (RFC 8628) for the
+	// required scopes and returns the codes the user has to enter at the OpenID provider.
+	DeviceAuth(ctx context.Context, offlineAsScope bool, scopes ...string) (DeviceAuthorization, error)
+
+	// DeviceAccessToken requests the token of a device authorization once. As long as
+	// the user has not approved the authorization, a *DeviceAuthorizationError is returned.
+	DeviceAccessToken(ctx context.Context, deviceCode string) (OIDCToken, error)
+
 	// OIDCConfig returns the issuers OIDC config
 	OIDCConfig() *OIDCConfiguration
 }
@@ -67,6 +77,50 @@ type TokenVerifier interface {
 	Verify(ctx context.Context, token string) (TokenClaims, error)
 }
 
+type tokenRequestKey struct{}
+
+// TokenOperation names requests which grant more than their HTTP method suggests, e.g. GET
+// requests which open a shell in a cluster. Scoped tokens are checked against it.
+type TokenOperation string
+
+const (
+	// TerminalTokenOperation opens the web terminal of a cluster.
+	TerminalTokenOperation TokenOperation = "terminal"
+	// PodExecTokenOperation executes commands in a pod of a cluster.
+	PodExecTokenOperation TokenOperation = "podExec"
+	// AdminKubeconfigTokenOperation downloads the admin kubeconfig of a cluster.
+	AdminKubeconfigTokenOperation TokenOperation = "adminKubeconfig"
+)
+
+// TokenRequest describes the request a token is verified for, verifiers use it to enforce the scope of a token.
+type TokenRequest struct {
+	Method    string
+	ClusterID string
+	// Operation is set for requests which grant write or admin access regardless of their method.
+	Operation TokenOperation
+}
+
+// WithTokenRequest returns a copy of ctx which carries the TokenRequest of the given HTTP request.
+func WithTokenRequest(ctx context.Context, r *http.Request) context.Context {
+	request, _ := TokenRequestFrom(ctx)
+	request.Method = r.Method
+	request.ClusterID = mux.Vars(r)["cluster_id"]
+	return context.WithValue(ctx, tokenRequestKey{}, request)
+}
+
+// WithTokenOperation returns a copy of ctx whose TokenRequest has the given operation.
+func WithTokenOperation(ctx context.Context, operation TokenOperation) context.Context {
+	request, _ := TokenRequestFrom(ctx)
+	request.Operation = operation
+	return context.WithValue(ctx, tokenRequestKey{}, request)
+}
+
+// TokenRequestFrom returns the TokenRequest stored in ctx by WithTokenRequest.
+func TokenRequestFrom(ctx context.Context) (TokenRequest, bool) {
+	request, ok := ctx.Value(tokenRequestKey{}).(TokenRequest)
+	return request, ok
+}
+
 // TokenExtractorVerifier combines TokenVerifier and TokenExtractor interfaces.
 type TokenExtractorVerifier interface {
 	TokenVerifier
@@ -105,6 +159,37 @@ type OIDCToken struct {
 	IDToken string
 }
 
+// DeviceAuthorization is the response of the OpenID provider to a device authorization request.
+type DeviceAuthorization struct {
+	// DeviceCode is used by the client to poll for the token.
+	DeviceCode string
+	// UserCode is the code the user enters at the verification URI.
+	UserCode string
+	// VerificationURI is the page of the OpenID provider where the user approves the authorization.
+	VerificationURI string
+	// VerificationURIComplete is the verification URI including the user code, it is optional.
+	VerificationURIComplete string
+	// Expiry is the time the device code and the user code expire.
+	Expiry time.Time
+	// Interval is the minimum number of seconds the client waits between polling requests.
+	Interval int64
+}
+
+// DeviceAuthorizationError is an error of a token request for a device authorization,
+// e.g. "authorization_pending" while the user has not approved the authorization yet.
+// See https://datatracker.ietf.org/doc/html/rfc8628#section-3.5 for the error codes.
+type DeviceAuthorizationError struct {
+	Code        string
+	Description string
+}
+
+func (e *DeviceAuthorizationError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Description)
+	}
+	return e.Code
+}
+
 // TokenClaims holds various claims extracted from the id_token.
 type TokenClaims struct {
 	Name    string
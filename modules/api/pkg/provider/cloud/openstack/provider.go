@@ -41,6 +41,7 @@ import (
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/tracing"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -274,7 +275,9 @@ func getAuthClient(authURL string, credentials *resources.OpenstackCredentials,
 
 	if client != nil {
 		// overwrite the default host/root CA Bundle with the proper CA Bundle
-		client.HTTPClient.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caBundle}}
+		transport := tracing.CloneDefaultTransport()
+		transport.TLSClientConfig = &tls.Config{RootCAs: caBundle}
+		client.HTTPClient.Transport = tracing.WrapTransport(transport)
 	}
 
 	err = goopenstack.Authenticate(client, opts)
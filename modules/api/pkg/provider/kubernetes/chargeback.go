@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ChargebackSchemaConfigMapName is the name of the config map with the chargeback schema.
+	ChargebackSchemaConfigMapName = "kubermatic-chargeback-schema"
+	// ChargebackSchemaKey is the key of the schema in the config map.
+	ChargebackSchemaKey = "schema.yaml"
+)
+
+// ChargebackSchemaProvider stores the chargeback schema in a config map in the namespace of KKP.
+type ChargebackSchemaProvider struct {
+	runtimeClient ctrlruntimeclient.Client
+	namespace     string
+}
+
+var _ provider.ChargebackSchemaProvider = &ChargebackSchemaProvider{}
+
+// NewChargebackSchemaProvider returns a chargeback schema provider.
+func NewChargebackSchemaProvider(runtimeClient ctrlruntimeclient.Client, namespace string) *ChargebackSchemaProvider {
+	return &ChargebackSchemaProvider{
+		runtimeClient: runtimeClient,
+		namespace:     namespace,
+	}
+}
+
+func (p *ChargebackSchemaProvider) Get(ctx context.Context) (*apiv2.ChargebackSchema, error) {
+	configMap := &corev1.ConfigMap{}
+	err := p.runtimeClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: p.namespace, Name: ChargebackSchemaConfigMapName}, configMap)
+	if apierrors.IsNotFound(err) {
+		return &apiv2.ChargebackSchema{Attributes: []apiv2.ChargebackAttribute{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	chargebackSchema := &apiv2.ChargebackSchema{}
+	if err := yaml.UnmarshalStrict([]byte(configMap.Data[ChargebackSchemaKey]), chargebackSchema); err != nil {
+		return nil, fmt.Errorf("invalid chargeback schema in config map %s: %w", ChargebackSchemaConfigMapName, err)
+	}
+	if chargebackSchema.Attributes == nil {
+		chargebackSchema.Attributes = []apiv2.ChargebackAttribute{}
+	}
+	return chargebackSchema, nil
+}
+
+func (p *ChargebackSchemaProvider) Update(ctx context.Context, userInfo *provider.UserInfo, chargebackSchema *apiv2.ChargebackSchema) (*apiv2.ChargebackSchema, error) {
+	if !userInfo.IsAdmin {
+		return nil, apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+
+	data, err := yaml.Marshal(chargebackSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = p.runtimeClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: p.namespace, Name: ChargebackSchemaConfigMapName}, configMap)
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ChargebackSchemaConfigMapName,
+				Namespace: p.namespace,
+			},
+			Data: map[string]string{ChargebackSchemaKey: string(data)},
+		}
+		if err := p.runtimeClient.Create(ctx, configMap); err != nil {
+			return nil, err
+		}
+		return chargebackSchema, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[ChargebackSchemaKey] = string(data)
+	if err := p.runtimeClient.Update(ctx, configMap); err != nil {
+		return nil, err
+	}
+	return chargebackSchema, nil
+}
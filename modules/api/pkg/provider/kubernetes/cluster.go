@@ -455,6 +455,14 @@ func (p *ClusterProvider) GetClientForUserCluster(ctx context.Context, userInfo
 	return p.userClusterConnProvider.GetClient(ctx, c, p.withImpersonation(userInfo))
 }
 
+// GetClientConfigForUserCluster returns a client config for the given cluster
+//
+// Note that the config doesn't use admin account instead it authn/authz as userInfo(email, group)
+// This implies that you have to make sure the user has the appropriate permissions inside the user cluster.
+func (p *ClusterProvider) GetClientConfigForUserCluster(ctx context.Context, userInfo *provider.UserInfo, c *kubermaticv1.Cluster) (*restclient.Config, error) {
+	return p.userClusterConnProvider.GetClientConfig(ctx, c, p.withImpersonation(userInfo))
+}
+
 func (p *ClusterProvider) GetTokenForUserCluster(ctx context.Context, userInfo *provider.UserInfo, cluster *kubermaticv1.Cluster) (string, error) {
 	if userInfo.Roles.Has("viewers") && userInfo.Roles.Len() == 1 {
 		s := &corev1.Secret{}
@@ -19,7 +19,10 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 
+	"k8c.io/dashboard/v2/pkg/credentials"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/util/email"
@@ -85,11 +88,16 @@ type PresetProvider struct {
 	creator presetCreator
 	patcher presetUpdater
 	deleter presetDeleter
+
+	// resolver resolves the references to credentials in external stores, nil if no store is configured
+	resolver *credentials.Resolver
 }
 
 var _ provider.PresetProvider = &PresetProvider{}
 
-func NewPresetProvider(client ctrlruntimeclient.Client) (*PresetProvider, error) {
+// NewPresetProvider returns a preset provider. The resolver resolves the references of the presets to credentials
+// in external stores when the credentials are used, it is nil if no store is configured.
+func NewPresetProvider(client ctrlruntimeclient.Client, resolver *credentials.Resolver) (*PresetProvider, error) {
 	getter, err := presetsGetterFactory(client)
 	if err != nil {
 		return nil, err
@@ -110,7 +118,7 @@ func NewPresetProvider(client ctrlruntimeclient.Client) (*PresetProvider, error)
 		return nil, err
 	}
 
-	return &PresetProvider{getter, creator, patcher, deleter}, nil
+	return &PresetProvider{getter, creator, patcher, deleter, resolver}, nil
 }
 
 func (m *PresetProvider) CreatePreset(ctx context.Context, preset *kubermaticv1.Preset) (*kubermaticv1.Preset, error) {
@@ -149,6 +157,36 @@ func (m *PresetProvider) GetPreset(ctx context.Context, userInfo *provider.UserI
 	return nil, apierrors.NewNotFound(kubermaticv1.Resource("preset"), name)
 }
 
+// GetPresetCredentials returns a preset like GetPreset, with the references to credentials in external stores of
+// the provider resolved. The preset must not be updated, as it contains the credentials instead of the references.
+func (m *PresetProvider) GetPresetCredentials(ctx context.Context, userInfo *provider.UserInfo, projectID *string, name string, providerName string) (*kubermaticv1.Preset, error) {
+	preset, err := m.GetPreset(ctx, userInfo, projectID, name)
+	if err != nil {
+		return nil, err
+	}
+	return m.ResolveCredentials(ctx, preset, providerName)
+}
+
+// ResolveCredentials returns a copy of the preset with the references to credentials in external stores of the
+// provider resolved. The provider name is the name of its field in the preset spec, e.g. "aws" or "aks".
+func (m *PresetProvider) ResolveCredentials(ctx context.Context, preset *kubermaticv1.Preset, providerName string) (*kubermaticv1.Preset, error) {
+	if m.resolver == nil || preset == nil {
+		return preset, nil
+	}
+
+	resolved := preset.DeepCopy()
+	providerPreset := reflect.ValueOf(&resolved.Spec).Elem().FieldByNameFunc(func(name string) bool {
+		return strings.EqualFold(name, providerName)
+	})
+	if !providerPreset.IsValid() || providerPreset.Kind() != reflect.Pointer || providerPreset.IsNil() {
+		return resolved, nil
+	}
+	if err := m.resolver.ResolveFields(ctx, providerPreset.Interface()); err != nil {
+		return nil, fmt.Errorf("failed to resolve the %s credentials of the preset %s: %w", providerName, preset.Name, err)
+	}
+	return resolved, nil
+}
+
 // DeletePreset delete Preset.
 func (m *PresetProvider) DeletePreset(ctx context.Context, preset *kubermaticv1.Preset) (*kubermaticv1.Preset, error) {
 	return m.deleter(ctx, preset)
@@ -191,6 +229,8 @@ func filterOutPresets(userInfo *provider.UserInfo, projectID *string, list *kube
 	return result, nil
 }
 
+// SetCloudCredentials sets the credentials of the preset in the cloud spec. References to credentials in external
+// stores are set as they are, the cluster keeps them and they are resolved where the credentials are used.
 func (m *PresetProvider) SetCloudCredentials(ctx context.Context, userInfo *provider.UserInfo, projectID string, presetName string, cloud kubermaticv1.CloudSpec, dc *kubermaticv1.Datacenter) (*kubermaticv1.CloudSpec, error) {
 	preset, err := m.GetPreset(ctx, userInfo, &projectID, presetName)
 	if err != nil {
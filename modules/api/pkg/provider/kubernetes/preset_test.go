@@ -18,8 +18,12 @@ package kubernetes_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"k8c.io/dashboard/v2/pkg/credentials"
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -243,7 +247,7 @@ func TestGetPreset(t *testing.T) {
 				WithObjects(tc.presets...).
 				Build()
 
-			provider, err := kubernetes.NewPresetProvider(fakeClient)
+			provider, err := kubernetes.NewPresetProvider(fakeClient, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -646,7 +650,7 @@ func TestGetPresets(t *testing.T) {
 				WithObjects(tc.presets...).
 				Build()
 
-			provider, err := kubernetes.NewPresetProvider(fakeClient)
+			provider, err := kubernetes.NewPresetProvider(fakeClient, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1033,7 +1037,7 @@ func TestCredentialEndpoint(t *testing.T) {
 				WithObjects(tc.presets...).
 				Build()
 
-			provider, err := kubernetes.NewPresetProvider(fakeClient)
+			provider, err := kubernetes.NewPresetProvider(fakeClient, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1052,3 +1056,69 @@ func TestCredentialEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestCredentialEndpointWithExternalCredentials(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "aws.json"), []byte(`{"accessKeyID":"key","secretAccessKey":"secret"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	resolver := credentials.NewResolver(map[string]credentials.CredentialSource{"file": credentials.NewFileSource(root)}, time.Minute)
+
+	fakeClient := fake.
+		NewClientBuilder().
+		WithObjects(&kubermaticv1.Preset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: kubermaticv1.PresetSpec{
+				AWS: &kubermaticv1.AWS{
+					AccessKeyID:     "ref+file://aws.json#accessKeyID",
+					SecretAccessKey: "ref+file://aws.json#secretAccessKey",
+				},
+				Azure: &kubermaticv1.Azure{
+					ClientSecret: "ref+vault://azure/creds/kkp#client_secret",
+				},
+			},
+		}).
+		Build()
+
+	presetProvider, err := kubernetes.NewPresetProvider(fakeClient, resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userInfo := &provider.UserInfo{Email: "test@example.com"}
+
+	// the cluster keeps the references, they are resolved where the credentials are used
+	cloudResult, err := presetProvider.SetCloudCredentials(context.Background(), userInfo, "", "test", kubermaticv1.CloudSpec{AWS: &kubermaticv1.AWSCloudSpec{}}, nil)
+	if err != nil {
+		t.Fatalf("failed to set the credentials: %v", err)
+	}
+	if expected := (&kubermaticv1.CloudSpec{AWS: &kubermaticv1.AWSCloudSpec{AccessKeyID: "ref+file://aws.json#accessKeyID", SecretAccessKey: "ref+file://aws.json#secretAccessKey"}}); !equality.Semantic.DeepEqual(cloudResult, expected) {
+		t.Fatalf("expected: %v, got %v", expected, cloudResult)
+	}
+
+	// the references of other providers are not resolved and the preset keeps its references
+	preset, err := presetProvider.GetPresetCredentials(context.Background(), userInfo, nil, "test", string(kubermaticv1.AWSCloudProvider))
+	if err != nil {
+		t.Fatalf("failed to get the preset: %v", err)
+	}
+	if preset.Spec.AWS.AccessKeyID != "key" || preset.Spec.AWS.SecretAccessKey != "secret" {
+		t.Errorf("expected the resolved AWS credentials, got %+v", preset.Spec.AWS)
+	}
+	if preset.Spec.Azure.ClientSecret != "ref+vault://azure/creds/kkp#client_secret" {
+		t.Errorf("expected the reference of the Azure credentials, got %q", preset.Spec.Azure.ClientSecret)
+	}
+	preset, err = presetProvider.GetPreset(context.Background(), userInfo, nil, "test")
+	if err != nil {
+		t.Fatalf("failed to get the preset: %v", err)
+	}
+	if preset.Spec.AWS.AccessKeyID != "ref+file://aws.json#accessKeyID" {
+		t.Errorf("expected the reference of the AWS credentials, got %q", preset.Spec.AWS.AccessKeyID)
+	}
+
+	if _, err := presetProvider.GetPresetCredentials(context.Background(), userInfo, nil, "test", string(kubermaticv1.AzureCloudProvider)); err == nil {
+		t.Error("expected an error for the reference to the unconfigured source")
+	}
+}
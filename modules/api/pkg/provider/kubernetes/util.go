@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"k8c.io/dashboard/v2/pkg/audit"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
@@ -84,10 +85,15 @@ type DefaultImpersonationClient struct {
 	restMapper meta.RESTMapper
 }
 
-// CreateImpersonatedClient actually creates impersonated client set for the given user.
+// CreateImpersonatedClient actually creates impersonated client set for the given user. The changes made by the
+// client are recorded in the audit log.
 func (d *DefaultImpersonationClient) CreateImpersonatedClient(impCfg restclient.ImpersonationConfig) (ctrlruntimeclient.Client, error) {
 	config := *d.cfg
 	config.Impersonate = impCfg
 
-	return ctrlruntimeclient.New(&config, ctrlruntimeclient.Options{Mapper: d.restMapper})
+	client, err := ctrlruntimeclient.New(&config, ctrlruntimeclient.Options{Mapper: d.restMapper})
+	if err != nil {
+		return nil, err
+	}
+	return audit.NewRecordingClient(client), nil
 }
@@ -23,6 +23,7 @@ import (
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/credentials"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -143,6 +144,8 @@ type ClusterGetOptions struct {
 
 // SecretKeySelectorValueFunc is used to fetch the value of a config var. Do not build your own
 // implementation, use SecretKeySelectorValueFuncFactory.
+//
+// Values which are references to credentials in external stores are resolved with the resolver of the context.
 type SecretKeySelectorValueFunc func(configVar *providerconfig.GlobalSecretKeySelector, key string) (string, error)
 
 func SecretKeySelectorValueFuncFactory(ctx context.Context, client ctrlruntimeclient.Reader) SecretKeySelectorValueFunc {
@@ -170,7 +173,16 @@ func SecretKeySelectorValueFuncFactory(ctx context.Context, client ctrlruntimecl
 			return "", fmt.Errorf("secret %q has no key %q", namespacedName.String(), key)
 		}
 
-		return string(secret.Data[key]), nil
+		value := string(secret.Data[key])
+		if credentials.IsReference(value) {
+			resolved, err := credentials.FromContext(ctx).Resolve(ctx, value)
+			if err != nil {
+				return "", fmt.Errorf("failed to resolve key %q of secret %q: %w", key, namespacedName.String(), err)
+			}
+			return resolved, nil
+		}
+
+		return value, nil
 	}
 }
 
@@ -252,6 +264,12 @@ type ClusterProvider interface {
 	// Note that the client doesn't use admin account instead it authn/authz as userInfo(email, group)
 	GetClientForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error)
 
+	// GetClientConfigForUserCluster returns a client config for the given cluster, e.g. for streaming
+	// the logs of pods or executing commands in them
+	//
+	// Note that the config doesn't use admin account instead it authn/authz as userInfo(email, group)
+	GetClientConfigForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (*rest.Config, error)
+
 	// GetTokenForUserCluster returns a token for the given cluster with permissions granted to group that
 	// user belongs to.
 	GetTokenForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (string, error)
@@ -691,6 +709,14 @@ type SettingsProvider interface {
 	UpdateGlobalSettings(ctx context.Context, userInfo *UserInfo, settings *kubermaticv1.KubermaticSetting) (*kubermaticv1.KubermaticSetting, error)
 }
 
+// ChargebackSchemaProvider declares the set of methods for interacting with the schema of the chargeback
+// attributes of projects and clusters.
+type ChargebackSchemaProvider interface {
+	// Get returns the schema, it has no attributes if the admins did not define it yet.
+	Get(ctx context.Context) (*apiv2.ChargebackSchema, error)
+	Update(ctx context.Context, userInfo *UserInfo, schema *apiv2.ChargebackSchema) (*apiv2.ChargebackSchema, error)
+}
+
 // AdminProvider declares the set of methods for interacting with admin.
 type AdminProvider interface {
 	SetAdmin(ctx context.Context, userInfo *UserInfo, adminBody apiv1.Admin) (*kubermaticv1.User, error)
@@ -703,6 +729,10 @@ type PresetProvider interface {
 	UpdatePreset(ctx context.Context, preset *kubermaticv1.Preset) (*kubermaticv1.Preset, error)
 	GetPresets(ctx context.Context, userInfo *UserInfo, projectID *string) ([]kubermaticv1.Preset, error)
 	GetPreset(ctx context.Context, userInfo *UserInfo, projectID *string, name string) (*kubermaticv1.Preset, error)
+	// GetPresetCredentials returns a preset with the references to credentials in external stores of the provider resolved.
+	GetPresetCredentials(ctx context.Context, userInfo *UserInfo, projectID *string, name string, providerName string) (*kubermaticv1.Preset, error)
+	// ResolveCredentials returns a copy of the preset with the references to credentials in external stores of the provider resolved.
+	ResolveCredentials(ctx context.Context, preset *kubermaticv1.Preset, providerName string) (*kubermaticv1.Preset, error)
 	DeletePreset(ctx context.Context, preset *kubermaticv1.Preset) (*kubermaticv1.Preset, error)
 	SetCloudCredentials(ctx context.Context, userInfo *UserInfo, projectID string, presetName string, cloud kubermaticv1.CloudSpec, dc *kubermaticv1.Datacenter) (*kubermaticv1.CloudSpec, error)
 }
@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providercache caches the responses of the cloud provider APIs used to discover sizes, networks and
+// other resources, so that the same data is not fetched on every page load of the cluster wizard.
+package providercache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultTTL is the time the responses are cached for providers without a configured TTL.
+	DefaultTTL = 5 * time.Minute
+
+	// fetchTimeout bounds the calls to the provider APIs, which are not canceled with the request.
+	fetchTimeout = 2 * time.Minute
+)
+
+type refreshContextKey struct{}
+
+// WithRefresh returns a context which makes the cache fetch fresh data and replace the cached one.
+func WithRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, refreshContextKey{}, true)
+}
+
+func refresh(ctx context.Context) bool {
+	refresh, _ := ctx.Value(refreshContextKey{}).(bool)
+	return refresh
+}
+
+// Key identifies a cached response.
+type Key struct {
+	Provider string
+	// Datacenter is the KKP datacenter, region or zone the response is scoped to, empty if it applies to all of them.
+	Datacenter string
+	// Resource is the kind of the listed resources, e.g. sizes or networks.
+	Resource string
+	// Credentials are the resolved credentials, either from a preset or passed inline. Only their hash is kept.
+	Credentials []string
+	// Params are the other inputs of the call, e.g. the zone or the network.
+	Params []interface{}
+}
+
+func (k Key) hash() (string, error) {
+	h := sha256.New()
+	for _, credential := range k.Credentials {
+		fmt.Fprintf(h, "%d:%s", len(credential), credential)
+	}
+	params, err := json.Marshal(k.Params)
+	if err != nil {
+		return "", err
+	}
+	h.Write(params)
+
+	return strings.Join([]string{k.Provider, k.Datacenter, k.Resource, hex.EncodeToString(h.Sum(nil))}, "/"), nil
+}
+
+// Config configures the TTLs of the cached responses.
+type Config struct {
+	// DefaultTTL applies to all providers without a TTL, 0 disables the cache for them.
+	DefaultTTL time.Duration
+	// TTLs are the TTLs per provider, 0 disables the cache for the provider.
+	TTLs map[string]time.Duration
+}
+
+func (c Config) ttl(provider string) time.Duration {
+	if ttl, ok := c.TTLs[provider]; ok {
+		return ttl
+	}
+	return c.DefaultTTL
+}
+
+type entry struct {
+	provider   string
+	datacenter string
+	// credentials are the hashes of the credential values the response was fetched with.
+	credentials []string
+	data        []byte
+	expires     time.Time
+}
+
+func hashCredential(credential string) string {
+	sum := sha256.Sum256([]byte(credential))
+	return hex.EncodeToString(sum[:])
+}
+
+// Filter selects the cached responses to invalidate. Empty fields match all responses.
+type Filter struct {
+	Provider string
+	// Datacenters are the names of a datacenter and its regions or zones. Responses which are not scoped to a
+	// datacenter, e.g. the GCP networks, are shared by all datacenters and always match.
+	Datacenters []string
+	// Credentials are the credential values, e.g. of a preset. Responses fetched with any of them match.
+	Credentials []string
+}
+
+func (f Filter) matches(e *entry) bool {
+	if f.Provider != "" && f.Provider != e.provider {
+		return false
+	}
+	if len(f.Datacenters) > 0 && e.datacenter != "" && !slices.Contains(f.Datacenters, e.datacenter) {
+		return false
+	}
+	if len(f.Credentials) > 0 && !slices.ContainsFunc(f.Credentials, func(credential string) bool {
+		return credential != "" && slices.Contains(e.credentials, hashCredential(credential))
+	}) {
+		return false
+	}
+	return true
+}
+
+// Cache keeps the responses of the cloud provider APIs for the configured TTL. Concurrent calls with the same key
+// share a single call to the provider API. Failed calls are not cached.
+type Cache struct {
+	config Config
+	now    func() time.Time
+	group  singleflight.Group
+
+	lock    sync.RWMutex
+	entries map[string]*entry
+}
+
+// New returns a new cache.
+func New(config Config) *Cache {
+	return &Cache{
+		config:  config,
+		now:     time.Now,
+		entries: map[string]*entry{},
+	}
+}
+
+// Invalidate removes the cached responses matching the filter. It returns the number of removed responses.
+func (c *Cache) Invalidate(filter Filter) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	removed := 0
+	for key, e := range c.entries {
+		if filter.matches(e) {
+			delete(c.entries, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+func (c *Cache) get(key string) []byte {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	e, ok := c.entries[key]
+	if !ok || c.now().After(e.expires) {
+		return nil
+	}
+	return e.data
+}
+
+func (c *Cache) set(key string, k Key, data []byte, ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	credentials := make([]string, 0, len(k.Credentials))
+	for _, credential := range k.Credentials {
+		if credential != "" {
+			credentials = append(credentials, hashCredential(credential))
+		}
+	}
+
+	now := c.now()
+	c.entries[key] = &entry{provider: k.Provider, datacenter: k.Datacenter, credentials: credentials, data: data, expires: now.Add(ttl)}
+
+	// drop the expired entries, the number of keys is bounded by the users and their credentials
+	for key, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Fetch returns the cached response for the key or calls fetch and caches its result. The responses are stored
+// serialized, so every caller gets its own copy which it may modify. A nil cache calls fetch directly.
+func Fetch[T any](ctx context.Context, c *Cache, k Key, fetch func(context.Context) (T, error)) (T, error) {
+	var result T
+
+	if c == nil {
+		return fetch(ctx)
+	}
+	ttl := c.config.ttl(k.Provider)
+	if ttl <= 0 {
+		return fetch(ctx)
+	}
+
+	key, err := k.hash()
+	if err != nil {
+		return result, fmt.Errorf("failed to build cache key: %w", err)
+	}
+
+	if !refresh(ctx) {
+		if data := c.get(key); data != nil {
+			if err := json.Unmarshal(data, &result); err == nil {
+				cacheRequests.WithLabelValues(k.Provider, k.Resource, "hit").Inc()
+				return result, nil
+			}
+		}
+	}
+
+	data, err, shared := c.group.Do(key, func() (interface{}, error) {
+		// the call is shared with other callers, so it must not be canceled when the first caller goes away
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+		defer cancel()
+
+		value, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, k, data, ttl)
+		return data, nil
+	})
+	if shared {
+		cacheRequests.WithLabelValues(k.Provider, k.Resource, "shared").Inc()
+	} else {
+		cacheRequests.WithLabelValues(k.Provider, k.Resource, "miss").Inc()
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(data.([]byte), &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// ParseTTLs parses the TTLs per provider in the format <provider>=<duration>, separated by commas,
+// e.g. gcp=10m,openstack=1m.
+func ParseTTLs(value string) (map[string]time.Duration, error) {
+	ttls := map[string]time.Duration{}
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		provider, rawTTL, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid TTL %q, expected <provider>=<duration>", pair)
+		}
+		ttl, err := time.ParseDuration(strings.TrimSpace(rawTTL))
+		if err != nil || ttl < 0 {
+			return nil, fmt.Errorf("invalid TTL %q for provider %s", rawTTL, provider)
+		}
+		ttls[strings.TrimSpace(provider)] = ttl
+	}
+	return ttls, nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providercache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type size struct {
+	Name string `json:"name"`
+}
+
+func TestFetch(t *testing.T) {
+	now := time.Now()
+	cache := New(Config{DefaultTTL: time.Minute, TTLs: map[string]time.Duration{"aws": 0}})
+	cache.now = func() time.Time { return now }
+
+	var calls atomic.Int32
+	fetch := func(context.Context) ([]size, error) {
+		calls.Add(1)
+		return []size{{Name: "n1-standard-1"}}, nil
+	}
+
+	ctx := context.Background()
+	key := Key{Provider: "gcp", Datacenter: "europe-west3-c", Resource: "sizes", Credentials: []string{"sa-1"}}
+
+	sizes, err := Fetch(ctx, cache, key, fetch)
+	if err != nil {
+		t.Fatalf("failed to fetch: %v", err)
+	}
+	// the cached response must not be modified by the caller
+	sizes[0].Name = "modified"
+
+	sizes, err = Fetch(ctx, cache, key, fetch)
+	if err != nil {
+		t.Fatalf("failed to fetch: %v", err)
+	}
+	if calls.Load() != 1 || sizes[0].Name != "n1-standard-1" {
+		t.Fatalf("expected the cached response, got %v after %d calls", sizes, calls.Load())
+	}
+
+	// other credentials must not see the cached response
+	otherKey := key
+	otherKey.Credentials = []string{"sa-2"}
+	if _, err := Fetch(ctx, cache, otherKey, fetch); err != nil || calls.Load() != 2 {
+		t.Fatalf("expected a call for other credentials, got %d calls: %v", calls.Load(), err)
+	}
+
+	if _, err := Fetch(WithRefresh(ctx), cache, key, fetch); err != nil || calls.Load() != 3 {
+		t.Fatalf("expected a call when refreshing, got %d calls: %v", calls.Load(), err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := Fetch(ctx, cache, key, fetch); err != nil || calls.Load() != 4 {
+		t.Fatalf("expected a call after the TTL, got %d calls: %v", calls.Load(), err)
+	}
+
+	if removed := cache.Invalidate(Filter{Provider: "gcp"}); removed != 1 {
+		t.Fatalf("expected 1 response to be invalidated, got %d", removed)
+	}
+	if _, err := Fetch(ctx, cache, key, fetch); err != nil || calls.Load() != 5 {
+		t.Fatalf("expected a call after the invalidation, got %d calls: %v", calls.Load(), err)
+	}
+
+	// the cache is disabled for aws
+	awsKey := Key{Provider: "aws", Resource: "sizes"}
+	for range 2 {
+		if _, err := Fetch(ctx, cache, awsKey, fetch); err != nil {
+			t.Fatalf("failed to fetch: %v", err)
+		}
+	}
+	if calls.Load() != 7 {
+		t.Fatalf("expected no caching for aws, got %d calls", calls.Load())
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	fetch := func(context.Context) ([]size, error) {
+		return []size{{Name: "default"}}, nil
+	}
+	keys := []Key{
+		{Provider: "gcp", Datacenter: "europe-west3-c", Resource: "disktypes", Credentials: []string{"sa-1"}},
+		{Provider: "gcp", Datacenter: "gcp-westeurope", Resource: "zones", Credentials: []string{"sa-1"}},
+		{Provider: "gcp", Resource: "networks", Credentials: []string{"sa-1"}},
+		{Provider: "gcp", Datacenter: "europe-west3-c", Resource: "sizes", Credentials: []string{"sa-2"}},
+		{Provider: "openstack", Datacenter: "os-hamburg", Resource: "networks", Credentials: []string{"user", "password", ""}},
+	}
+
+	testCases := []struct {
+		name     string
+		filter   Filter
+		expected int
+	}{
+		{name: "all", filter: Filter{}, expected: 5},
+		{name: "provider", filter: Filter{Provider: "openstack"}, expected: 1},
+		{name: "datacenter and its zones including responses shared by all datacenters", filter: Filter{Provider: "gcp", Datacenters: []string{"gcp-westeurope", "europe-west3-c"}}, expected: 4},
+		{name: "other datacenter only matches the shared responses", filter: Filter{Datacenters: []string{"aws-eu-central-1a"}}, expected: 1},
+		{name: "credential", filter: Filter{Credentials: []string{"sa-1"}}, expected: 3},
+		{name: "any credential value", filter: Filter{Credentials: []string{"password", "other"}}, expected: 1},
+		{name: "empty credential values never match", filter: Filter{Credentials: []string{""}}, expected: 0},
+		{name: "datacenter and credential", filter: Filter{Datacenters: []string{"europe-west3-c"}, Credentials: []string{"sa-2"}}, expected: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cache := New(Config{DefaultTTL: time.Minute})
+			for _, key := range keys {
+				if _, err := Fetch(context.Background(), cache, key, fetch); err != nil {
+					t.Fatalf("failed to fetch: %v", err)
+				}
+			}
+
+			if removed := cache.Invalidate(tc.filter); removed != tc.expected {
+				t.Fatalf("expected %d responses to be invalidated, got %d", tc.expected, removed)
+			}
+		})
+	}
+}
+
+func TestFetchSharesConcurrentCalls(t *testing.T) {
+	cache := New(Config{DefaultTTL: time.Minute})
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fetch := func(context.Context) ([]size, error) {
+		calls.Add(1)
+		<-release
+		return nil, errors.New("quota exceeded")
+	}
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := Fetch(context.Background(), cache, Key{Provider: "azure"}, fetch); err == nil {
+				t.Error("expected the error to be returned to all callers")
+			}
+		}()
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single call, got %d", calls.Load())
+	}
+	if len(cache.entries) != 0 {
+		t.Fatal("expected errors not to be cached")
+	}
+}
+
+func TestParseTTLs(t *testing.T) {
+	ttls, err := ParseTTLs("gcp=10m, openstack=0s")
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if ttls["gcp"] != 10*time.Minute || ttls["openstack"] != 0 || len(ttls) != 2 {
+		t.Fatalf("unexpected TTLs %v", ttls)
+	}
+
+	for _, invalid := range []string{"gcp", "gcp=ten", "gcp=-1m"} {
+		if _, err := ParseTTLs(invalid); err == nil {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+}
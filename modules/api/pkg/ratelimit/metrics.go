@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var rejectedRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "kubermatic_api_rate_limited_requests_total",
+		Help: "The number of API requests rejected by the rate limiter",
+	},
+	[]string{"class", "key_type", "reason"},
+)
+
+// RegisterMetrics registers the metrics of the rate limiter.
+func RegisterMetrics() {
+	prometheus.MustRegister(rejectedRequests)
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit limits the rate and the concurrency of API calls per user, service account token and project.
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Class groups routes which share a policy.
+type Class string
+
+const (
+	// ClassRead are the calls which do not modify any resource.
+	ClassRead Class = "read"
+	// ClassWrite are the calls creating, updating or deleting resources.
+	ClassWrite Class = "write"
+	// ClassProviderProxy are the calls forwarded to the cloud provider APIs, e.g. listing the sizes of a provider.
+	ClassProviderProxy Class = "provider-proxy"
+)
+
+// Classes are all route classes.
+var Classes = []Class{ClassRead, ClassWrite, ClassProviderProxy}
+
+// Classify returns the class of the call. route is the path template of the matched route.
+func Classify(method, route string) Class {
+	if strings.Contains(route, "/providers/") {
+		return ClassProviderProxy
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ClassRead
+	default:
+		return ClassWrite
+	}
+}
+
+// KeyType is the kind of the subject a bucket belongs to.
+type KeyType string
+
+const (
+	KeyTypeUser    KeyType = "user"
+	KeyTypeToken   KeyType = "token"
+	KeyTypeProject KeyType = "project"
+)
+
+// Key identifies the subject whose calls are limited.
+type Key struct {
+	Type  KeyType
+	Value string
+}
+
+// UserKey returns the key of the calls of a user.
+func UserKey(email string) Key {
+	return Key{Type: KeyTypeUser, Value: email}
+}
+
+// TokenKey returns the key of the calls authenticated with a service account token.
+func TokenKey(tokenID string) Key {
+	return Key{Type: KeyTypeToken, Value: tokenID}
+}
+
+// ProjectKey returns the key of the calls of all members of a project.
+func ProjectKey(projectID string) Key {
+	return Key{Type: KeyTypeProject, Value: projectID}
+}
+
+// Policy is the token bucket and the concurrency quota of a single subject. It is set from flags in the format
+// <qps>/<burst>[/<max in-flight>], e.g. 10/20/5.
+type Policy struct {
+	// QPS is the rate at which the bucket is refilled, 0 disables the rate limit.
+	QPS float64
+	// Burst is the size of the bucket. It defaults to the QPS rounded up.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent calls, 0 disables the concurrency quota.
+	MaxInFlight int
+}
+
+func (p Policy) Enabled() bool {
+	return p.QPS > 0 || p.MaxInFlight > 0
+}
+
+func (p *Policy) String() string {
+	if p == nil || !p.Enabled() {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d/%d", strconv.FormatFloat(p.QPS, 'f', -1, 64), p.Burst, p.MaxInFlight)
+}
+
+func (p *Policy) Set(value string) error {
+	*p = Policy{}
+	if value == "" {
+		return nil
+	}
+
+	parts := strings.Split(value, "/")
+	if len(parts) > 3 {
+		return fmt.Errorf("invalid policy %q, expected <qps>/<burst>[/<max in-flight>]", value)
+	}
+
+	qps, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil || qps < 0 {
+		return fmt.Errorf("invalid qps %q", parts[0])
+	}
+	p.QPS = qps
+
+	if len(parts) > 1 {
+		if p.Burst, err = strconv.Atoi(parts[1]); err != nil || p.Burst < 0 {
+			return fmt.Errorf("invalid burst %q", parts[1])
+		}
+	}
+	if p.Burst == 0 && p.QPS > 0 {
+		p.Burst = int(p.QPS + 0.999)
+	}
+
+	if len(parts) > 2 {
+		if p.MaxInFlight, err = strconv.Atoi(parts[2]); err != nil || p.MaxInFlight < 0 {
+			return fmt.Errorf("invalid max in-flight %q", parts[2])
+		}
+	}
+
+	return nil
+}
+
+// Config holds the policies of the route classes. Users and service account tokens share the principal policies,
+// the project policies limit the calls of all members of a project together.
+type Config struct {
+	Principal map[Class]*Policy
+	Project   map[Class]*Policy
+}
+
+// Enabled returns true if any policy is set.
+func (c Config) Enabled() bool {
+	for _, policies := range []map[Class]*Policy{c.Principal, c.Project} {
+		for _, policy := range policies {
+			if policy != nil && policy.Enabled() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c Config) policy(class Class, keyType KeyType) *Policy {
+	policies := c.Principal
+	if keyType == KeyTypeProject {
+		policies = c.Project
+	}
+	if policy := policies[class]; policy != nil && policy.Enabled() {
+		return policy
+	}
+	return nil
+}
+
+// Reason tells which limit rejected a call.
+type Reason string
+
+const (
+	ReasonRate        Reason = "rate"
+	ReasonConcurrency Reason = "concurrency"
+)
+
+// RejectedError is returned when a call exceeds a limit.
+type RejectedError struct {
+	Class  Class
+	Key    Key
+	Reason Reason
+	// RetryAfter is the time after which the call may succeed.
+	RetryAfter time.Duration
+}
+
+func (e *RejectedError) Error() string {
+	if e.Reason == ReasonConcurrency {
+		return fmt.Sprintf("too many concurrent %s requests for %s %s", e.Class, e.Key.Type, e.Key.Value)
+	}
+	return fmt.Sprintf("%s request rate limit exceeded for %s %s", e.Class, e.Key.Type, e.Key.Value)
+}
+
+// idleTimeout is the time after which the buckets of inactive subjects are removed.
+const idleTimeout = 10 * time.Minute
+
+type bucketKey struct {
+	class Class
+	key   Key
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	inFlight int
+	lastUsed time.Time
+}
+
+// Limiter keeps a token bucket and the number of calls in flight for every subject and class.
+type Limiter struct {
+	config Config
+	now    func() time.Time
+
+	lock      sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+// New returns a new limiter.
+func New(config Config) *Limiter {
+	return &Limiter{
+		config:  config,
+		now:     time.Now,
+		buckets: map[bucketKey]*bucket{},
+	}
+}
+
+// Acquire admits a call of the class on behalf of all keys. If any key exceeds its limits a *RejectedError is
+// returned and no tokens are taken. The returned function must be called when the call finished.
+func (l *Limiter) Acquire(class Class, keys ...Key) (func(), error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	type admitted struct {
+		bucket      *bucket
+		reservation *rate.Reservation
+	}
+	var buckets []admitted
+
+	reject := func(err *RejectedError) (func(), error) {
+		for _, b := range buckets {
+			if b.reservation != nil {
+				b.reservation.CancelAt(now)
+			}
+		}
+		rejectedRequests.WithLabelValues(string(err.Class), string(err.Key.Type), string(err.Reason)).Inc()
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if key.Value == "" {
+			continue
+		}
+		policy := l.config.policy(class, key.Type)
+		if policy == nil {
+			continue
+		}
+
+		b := l.bucket(bucketKey{class: class, key: key}, policy, now)
+
+		if policy.MaxInFlight > 0 && b.inFlight >= policy.MaxInFlight {
+			return reject(&RejectedError{Class: class, Key: key, Reason: ReasonConcurrency, RetryAfter: time.Second})
+		}
+
+		var reservation *rate.Reservation
+		if b.limiter != nil {
+			reservation = b.limiter.ReserveN(now, 1)
+			if delay := reservation.DelayFrom(now); !reservation.OK() || delay > 0 {
+				reservation.CancelAt(now)
+				if delay < time.Second {
+					delay = time.Second
+				}
+				return reject(&RejectedError{Class: class, Key: key, Reason: ReasonRate, RetryAfter: delay})
+			}
+		}
+
+		buckets = append(buckets, admitted{bucket: b, reservation: reservation})
+	}
+
+	for _, b := range buckets {
+		b.bucket.inFlight++
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.lock.Lock()
+			defer l.lock.Unlock()
+
+			for _, b := range buckets {
+				b.bucket.inFlight--
+				b.bucket.lastUsed = l.now()
+			}
+		})
+	}, nil
+}
+
+func (l *Limiter) bucket(key bucketKey, policy *Policy, now time.Time) *bucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{}
+		if policy.QPS > 0 {
+			b.limiter = rate.NewLimiter(rate.Limit(policy.QPS), policy.Burst)
+		}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	return b
+}
+
+// sweep removes the buckets of subjects without calls for the idle timeout.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < idleTimeout {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if b.inFlight == 0 && now.Sub(b.lastUsed) >= idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
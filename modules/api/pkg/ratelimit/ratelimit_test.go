@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	testcases := []struct {
+		method string
+		route  string
+		class  Class
+	}{
+		{method: http.MethodGet, route: "/api/v1/projects/{project_id}", class: ClassRead},
+		{method: http.MethodDelete, route: "/api/v1/projects/{project_id}", class: ClassWrite},
+		{method: http.MethodGet, route: "/api/v1/providers/aws/sizes", class: ClassProviderProxy},
+		{method: http.MethodGet, route: "/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/aws/sizes", class: ClassProviderProxy},
+	}
+
+	for _, tc := range testcases {
+		if class := Classify(tc.method, tc.route); class != tc.class {
+			t.Errorf("expected %s %s to be %s, got %s", tc.method, tc.route, tc.class, class)
+		}
+	}
+}
+
+func TestPolicySet(t *testing.T) {
+	var policy Policy
+	if err := policy.Set("2.5/5/3"); err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+	if policy != (Policy{QPS: 2.5, Burst: 5, MaxInFlight: 3}) {
+		t.Fatalf("unexpected policy %+v", policy)
+	}
+
+	if err := policy.Set("2.5"); err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+	if policy != (Policy{QPS: 2.5, Burst: 3}) {
+		t.Fatalf("expected the burst to default to the rounded up qps, got %+v", policy)
+	}
+
+	for _, invalid := range []string{"a", "1/b", "1/2/c", "1/2/3/4", "-1"} {
+		if err := policy.Set(invalid); err == nil {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	now := time.Now()
+	limiter := New(Config{
+		Principal: map[Class]*Policy{ClassProviderProxy: {QPS: 1, Burst: 2}},
+		Project:   map[Class]*Policy{ClassProviderProxy: {QPS: 1, Burst: 3}},
+	})
+	limiter.now = func() time.Time { return now }
+
+	bob, john, project := UserKey("bob@acme.com"), UserKey("john@acme.com"), ProjectKey("my-project")
+
+	for range 2 {
+		if _, err := limiter.Acquire(ClassProviderProxy, bob, project); err != nil {
+			t.Fatalf("expected the call to be admitted: %v", err)
+		}
+	}
+
+	_, err := limiter.Acquire(ClassProviderProxy, bob, project)
+	var rejected *RejectedError
+	if !errors.As(err, &rejected) || rejected.Key != bob || rejected.Reason != ReasonRate {
+		t.Fatalf("expected bob to be rate limited, got %v", err)
+	}
+	if rejected.RetryAfter != time.Second {
+		t.Fatalf("expected to retry after a second, got %v", rejected.RetryAfter)
+	}
+
+	// the rejected call must not take a token of the project
+	if _, err := limiter.Acquire(ClassProviderProxy, john, project); err != nil {
+		t.Fatalf("expected the call to be admitted: %v", err)
+	}
+	if _, err := limiter.Acquire(ClassProviderProxy, john, project); !errors.As(err, &rejected) || rejected.Key != project {
+		t.Fatalf("expected the project to be rate limited, got %v", err)
+	}
+
+	// other classes are not limited
+	if _, err := limiter.Acquire(ClassRead, bob, project); err != nil {
+		t.Fatalf("expected the call to be admitted: %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if _, err := limiter.Acquire(ClassProviderProxy, bob, project); err != nil {
+		t.Fatalf("expected the call to be admitted after the bucket was refilled: %v", err)
+	}
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	limiter := New(Config{
+		Principal: map[Class]*Policy{ClassWrite: {MaxInFlight: 1}},
+	})
+	token := TokenKey("sa-token-1")
+
+	release, err := limiter.Acquire(ClassWrite, token)
+	if err != nil {
+		t.Fatalf("expected the call to be admitted: %v", err)
+	}
+
+	var rejected *RejectedError
+	if _, err := limiter.Acquire(ClassWrite, token); !errors.As(err, &rejected) || rejected.Reason != ReasonConcurrency {
+		t.Fatalf("expected the concurrency quota to be exceeded, got %v", err)
+	}
+
+	release()
+	release()
+	if _, err := limiter.Acquire(ClassWrite, token); err != nil {
+		t.Fatalf("expected the call to be admitted after the first one finished: %v", err)
+	}
+	if _, err := limiter.Acquire(ClassWrite, token); err == nil {
+		t.Fatal("expected releasing twice to free a single slot")
+	}
+}
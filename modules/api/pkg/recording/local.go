@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LocalStore keeps the recordings in a directory, e.g. on a mounted volume.
+type LocalStore struct {
+	dir string
+}
+
+var _ Store = &LocalStore{}
+
+// NewLocalStore returns a store writing to the directory, which is created if it does not exist.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory: %w", err)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+func (s *LocalStore) Create(_ context.Context, name string) (io.WriteCloser, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	file := filepath.Join(s.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(file, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+}
+
+func (s *LocalStore) List(_ context.Context, clusterID string) ([]Info, error) {
+	root := s.dir
+	if clusterID != "" {
+		if clusterID == "." || clusterID == ".." || strings.ContainsAny(clusterID, `/\`) {
+			return nil, fmt.Errorf("invalid cluster ID %q", clusterID)
+		}
+		root = filepath.Join(s.dir, clusterID)
+	}
+
+	var recordings []Info
+	err := filepath.WalkDir(root, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(file, Extension) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.dir, file)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if ValidateName(name) != nil {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		recordings = append(recordings, Info{
+			Name:         name,
+			ClusterID:    strings.SplitN(name, "/", 2)[0],
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].Name < recordings[j].Name
+	})
+	return recordings, nil
+}
+
+func (s *LocalStore) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filepath.Join(s.dir, filepath.FromSlash(name)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
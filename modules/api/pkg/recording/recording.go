@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package recording records web terminal sessions in the asciinema v2 format and writes them to pluggable stores.
+package recording
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ContentType is the media type of asciinema v2 recordings.
+	ContentType = "application/x-asciicast"
+	// Extension is the file extension of the recordings.
+	Extension = ".cast"
+
+	// DefaultWidth and DefaultHeight are the terminal size until the browser sends the first resize event.
+	DefaultWidth  = 80
+	DefaultHeight = 24
+)
+
+const (
+	// StoreLocal keeps the recordings in a directory.
+	StoreLocal = "local"
+	// StoreS3 keeps the recordings in the bucket of an etcd backup destination.
+	StoreS3 = "s3"
+)
+
+// Config configures the recording of web terminal sessions, which is disabled if no store is set.
+type Config struct {
+	// Store is the kind of store, StoreLocal or StoreS3.
+	Store string
+	// Dir is the directory of the local store.
+	Dir string
+	// Seed and Destination select the etcd backup destination whose bucket and credentials the S3 store uses.
+	Seed        string
+	Destination string
+}
+
+// Validate checks that the settings of the selected store are set.
+func (c Config) Validate() error {
+	switch c.Store {
+	case "":
+		return nil
+	case StoreLocal:
+		if c.Dir == "" {
+			return errors.New("the directory of the local store is required")
+		}
+	case StoreS3:
+		if c.Seed == "" || c.Destination == "" {
+			return errors.New("the seed and the backup destination of the S3 store are required")
+		}
+	default:
+		return fmt.Errorf("unknown store %q, expected %q or %q", c.Store, StoreLocal, StoreS3)
+	}
+	return nil
+}
+
+// ErrNotFound is returned by the stores for unknown recordings.
+var ErrNotFound = errors.New("recording not found")
+
+// Identity is the user and the cluster of a terminal session.
+type Identity struct {
+	User      string `json:"user"`
+	ProjectID string `json:"projectID"`
+	ClusterID string `json:"clusterID"`
+}
+
+// Info describes a stored recording.
+type Info struct {
+	// Name is the path of the recording in the store, <cluster id>/<start time>-<session id>.cast.
+	Name         string
+	ClusterID    string
+	Size         int64
+	LastModified time.Time
+}
+
+// Store persists the recordings.
+type Store interface {
+	// Create returns a writer for a new recording, the recording is complete once the writer is closed.
+	Create(ctx context.Context, name string) (io.WriteCloser, error)
+	// List returns the recordings of the cluster, or of all clusters if clusterID is empty.
+	List(ctx context.Context, clusterID string) ([]Info, error)
+	// Open returns the content of the recording.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// Name returns the name of a recording of the cluster started at the given time.
+func Name(clusterID string, started time.Time, sessionID string) string {
+	return path.Join(clusterID, fmt.Sprintf("%s-%s%s", started.UTC().Format("20060102T150405Z"), sessionID, Extension))
+}
+
+// ValidateName checks that the name is a recording name and does not escape the store.
+func ValidateName(name string) error {
+	parts := strings.Split(name, "/")
+	if len(parts) != 2 || !strings.HasSuffix(name, Extension) {
+		return fmt.Errorf("invalid recording name %q", name)
+	}
+	for _, part := range parts {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, `\`) {
+			return fmt.Errorf("invalid recording name %q", name)
+		}
+	}
+	return nil
+}
+
+// header is the first line of an asciinema v2 recording. The identity fields are not part of the format,
+// players ignore them.
+type header struct {
+	Version   int               `json:"version"`
+	Width     uint16            `json:"width"`
+	Height    uint16            `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	Identity
+}
+
+// Recorder writes the events of a terminal session. All methods are safe for concurrent use and
+// a nil Recorder does nothing, so callers do not need to check if recording is enabled.
+type Recorder struct {
+	lock    sync.Mutex
+	w       io.WriteCloser
+	started time.Time
+	now     func() time.Time
+	err     error
+	closed  bool
+}
+
+// Start creates a new recording of the session in the store.
+func Start(ctx context.Context, store Store, identity Identity) (*Recorder, error) {
+	sessionID := make([]byte, 4)
+	if _, err := rand.Read(sessionID); err != nil {
+		return nil, err
+	}
+
+	started := time.Now()
+	w, err := store.Create(ctx, Name(identity.ClusterID, started, hex.EncodeToString(sessionID)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+
+	recorder, err := NewRecorder(w, identity, started)
+	if err != nil {
+		_ = w.Close()
+		return nil, err
+	}
+	return recorder, nil
+}
+
+// NewRecorder writes the header of the recording and returns a recorder for its events.
+func NewRecorder(w io.WriteCloser, identity Identity, started time.Time) (*Recorder, error) {
+	return newRecorder(w, identity, started, time.Now)
+}
+
+func newRecorder(w io.WriteCloser, identity Identity, started time.Time, now func() time.Time) (*Recorder, error) {
+	line, err := json.Marshal(header{
+		Version:   2,
+		Width:     DefaultWidth,
+		Height:    DefaultHeight,
+		Timestamp: started.Unix(),
+		Title:     fmt.Sprintf("%s@%s", identity.User, identity.ClusterID),
+		Env:       map[string]string{"SHELL": "/bin/bash", "TERM": "xterm-256color"},
+		Identity:  identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append(line, '\n')); err != nil {
+		return nil, err
+	}
+
+	return &Recorder{w: w, started: started, now: now}, nil
+}
+
+// Output records the output of the process.
+func (r *Recorder) Output(data []byte) {
+	r.event("o", string(data))
+}
+
+// Input records the keystrokes of the user.
+func (r *Recorder) Input(data []byte) {
+	r.event("i", string(data))
+}
+
+// Resize records a new terminal size.
+func (r *Recorder) Resize(cols, rows uint16) {
+	r.event("r", fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Marker records a marker with the label, like the join of a guest to a shared session.
+func (r *Recorder) Marker(label string) {
+	r.event("m", label)
+}
+
+// Close finishes the recording and returns the first error which occurred while recording.
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return r.err
+	}
+	r.closed = true
+
+	if err := r.w.Close(); err != nil && r.err == nil {
+		r.err = err
+	}
+	return r.err
+}
+
+func (r *Recorder) event(code, data string) {
+	if r == nil || data == "" {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// a failed write stops the recording, the session itself goes on
+	if r.err != nil || r.closed {
+		return
+	}
+
+	line, err := json.Marshal([]interface{}{r.now().Sub(r.started).Seconds(), code, data})
+	if err != nil {
+		r.err = err
+		return
+	}
+	_, r.err = r.w.Write(append(line, '\n'))
+}
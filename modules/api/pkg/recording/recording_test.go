@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recording
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+type nopCloser struct {
+	bytes.Buffer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+func TestRecorder(t *testing.T) {
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := started
+	out := &nopCloser{}
+
+	recorder, err := newRecorder(out, Identity{User: "bob@acme.com", ProjectID: "my-project", ClusterID: "abc123"}, started, func() time.Time { return now })
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+
+	now = started.Add(500 * time.Millisecond)
+	recorder.Input([]byte("ls\r"))
+	now = started.Add(time.Second)
+	recorder.Output([]byte("file\r\n"))
+	recorder.Resize(120, 40)
+	recorder.Marker("join alice@acme.com (read-only)")
+	recorder.Output(nil)
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("failed to close recorder: %v", err)
+	}
+	// events after the end of the session are dropped
+	recorder.Output([]byte("late"))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected the header and 4 events, got:\n%s", out.String())
+	}
+
+	var h map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &h); err != nil {
+		t.Fatalf("invalid header: %v", err)
+	}
+	if h["version"] != float64(2) || h["width"] != float64(DefaultWidth) || h["timestamp"] != float64(started.Unix()) {
+		t.Errorf("unexpected asciinema header %v", h)
+	}
+	if h["user"] != "bob@acme.com" || h["projectID"] != "my-project" || h["clusterID"] != "abc123" {
+		t.Errorf("expected the identity in the header, got %v", h)
+	}
+
+	expected := []string{`[0.5,"i","ls\r"]`, `[1,"o","file\r\n"]`, `[1,"r","120x40"]`, `[1,"m","join alice@acme.com (read-only)"]`}
+	for i, event := range expected {
+		if lines[i+1] != event {
+			t.Errorf("expected event %s, got %s", event, lines[i+1])
+		}
+	}
+}
+
+type failingWriter struct {
+	writes int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.writes > 1 {
+		return 0, errors.New("disk full")
+	}
+	return len(p), nil
+}
+
+func (w *failingWriter) Close() error {
+	return nil
+}
+
+func TestRecorderStopsOnError(t *testing.T) {
+	w := &failingWriter{}
+	recorder, err := NewRecorder(w, Identity{}, time.Now())
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+
+	recorder.Output([]byte("a"))
+	recorder.Output([]byte("b"))
+	if err := recorder.Close(); err == nil {
+		t.Error("expected the write error to be returned on close")
+	}
+	if w.writes != 2 {
+		t.Errorf("expected the recording to stop after the failed write, got %d writes", w.writes)
+	}
+
+	// a nil recorder is a disabled recording
+	var disabled *Recorder
+	disabled.Output([]byte("a"))
+	if err := disabled.Close(); err != nil {
+		t.Errorf("expected no error for a disabled recording, got %v", err)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	testCases := map[string]bool{
+		"abc123/20260102T030405Z-0a1b2c3d.cast": true,
+		"abc123/../secret.cast":                 false,
+		"../abc123/session.cast":                false,
+		"abc123/session.txt":                    false,
+		"session.cast":                          false,
+		"abc123/nested/session.cast":            false,
+		`abc123/..\session.cast`:                false,
+	}
+
+	for name, valid := range testCases {
+		if err := ValidateName(name); (err == nil) != valid {
+			t.Errorf("expected %q to be valid=%v, got %v", name, valid, err)
+		}
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	for _, name := range []string{"abc123/b.cast", "abc123/a.cast", "def456/c.cast"} {
+		w, err := store.Create(ctx, name)
+		if err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+		if _, err := io.WriteString(w, name); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.Create(ctx, "abc123/a.cast"); err == nil {
+		t.Error("expected existing recordings not to be overwritten")
+	}
+
+	recordings, err := store.List(ctx, "abc123")
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(recordings) != 2 || recordings[0].Name != "abc123/a.cast" || recordings[0].ClusterID != "abc123" || recordings[0].Size != int64(len("abc123/a.cast")) {
+		t.Errorf("unexpected recordings of the cluster: %+v", recordings)
+	}
+
+	if recordings, err = store.List(ctx, ""); err != nil || len(recordings) != 3 {
+		t.Errorf("expected the recordings of all clusters, got %+v, %v", recordings, err)
+	}
+	if recordings, err = store.List(ctx, "unknown"); err != nil || len(recordings) != 0 {
+		t.Errorf("expected no recordings of an unknown cluster, got %+v, %v", recordings, err)
+	}
+	if _, err = store.List(ctx, ".."); err == nil {
+		t.Error("expected an error for an invalid cluster ID")
+	}
+
+	r, err := store.Open(ctx, "def456/c.cast")
+	if err != nil {
+		t.Fatalf("failed to open: %v", err)
+	}
+	defer r.Close()
+	if content, _ := io.ReadAll(r); string(content) != "def456/c.cast" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	if _, err := store.Open(ctx, "def456/missing.cast"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestS3WriterUploadsOnClose(t *testing.T) {
+	testCases := []struct {
+		name      string
+		uploadErr error
+	}{
+		{
+			name: "upload succeeds",
+		},
+		{
+			name:      "upload fails",
+			uploadErr: errors.New("bucket unavailable"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := os.CreateTemp(t.TempDir(), "recording")
+			if err != nil {
+				t.Fatalf("failed to create spool file: %v", err)
+			}
+
+			var uploaded []byte
+			var uploadedSize int64
+			w := &s3Writer{
+				file: file,
+				upload: func(ctx context.Context, reader io.Reader, size int64) error {
+					if _, ok := ctx.Deadline(); !ok {
+						t.Error("expected the upload to have a deadline")
+					}
+					uploadedSize = size
+					uploaded, err = io.ReadAll(reader)
+					if err != nil {
+						return err
+					}
+					return tc.uploadErr
+				},
+			}
+
+			for _, line := range []string{"header\n", "event\n"} {
+				if _, err := w.Write([]byte(line)); err != nil {
+					t.Fatalf("failed to write: %v", err)
+				}
+			}
+			if uploaded != nil {
+				t.Fatal("expected nothing to be uploaded before the writer is closed")
+			}
+
+			err = w.Close()
+			if !errors.Is(err, tc.uploadErr) || (tc.uploadErr == nil) != (err == nil) {
+				t.Errorf("expected error %v, got %v", tc.uploadErr, err)
+			}
+			if string(uploaded) != "header\nevent\n" || uploadedSize != int64(len(uploaded)) {
+				t.Errorf("unexpected upload of %d bytes: %q", uploadedSize, uploaded)
+			}
+			if _, err := os.Stat(file.Name()); !os.IsNotExist(err) {
+				t.Errorf("expected the spool file to be removed, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/s3"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// s3Prefix separates the recordings from the backups in a shared bucket.
+	s3Prefix = "terminal-recordings/"
+	// s3UploadTimeout bounds the upload of a recording after the session ended.
+	s3UploadTimeout = 5 * time.Minute
+)
+
+// S3Store keeps the recordings in the bucket of an etcd backup destination of a seed and uses its credentials.
+// The credentials are read for every operation, so rotated credentials are picked up. Recordings are spooled to a
+// temporary file and uploaded once the session ended, so a slow or unavailable bucket does not stall the session.
+type S3Store struct {
+	seedsGetter      provider.SeedsGetter
+	seedClientGetter provider.SeedClientGetter
+	seedName         string
+	destination      string
+	caBundle         string
+}
+
+var _ Store = &S3Store{}
+
+// NewS3Store returns a store writing to the bucket of the backup destination of the seed.
+func NewS3Store(seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter, seedName, destination, caBundle string) *S3Store {
+	return &S3Store{
+		seedsGetter:      seedsGetter,
+		seedClientGetter: seedClientGetter,
+		seedName:         seedName,
+		destination:      destination,
+		caBundle:         caBundle,
+	}
+}
+
+func (s *S3Store) client(ctx context.Context) (*minio.Client, string, error) {
+	seeds, err := s.seedsGetter()
+	if err != nil {
+		return nil, "", err
+	}
+	seed, ok := seeds[s.seedName]
+	if !ok {
+		return nil, "", fmt.Errorf("seed %q not found", s.seedName)
+	}
+	if seed.Spec.EtcdBackupRestore == nil {
+		return nil, "", fmt.Errorf("seed %q has no backup destinations", s.seedName)
+	}
+	destination, ok := seed.Spec.EtcdBackupRestore.Destinations[s.destination]
+	if !ok || destination == nil {
+		return nil, "", fmt.Errorf("backup destination %q in seed %q not found", s.destination, s.seedName)
+	}
+	if destination.Credentials == nil {
+		return nil, "", fmt.Errorf("backup destination %q in seed %q has no credentials", s.destination, s.seedName)
+	}
+
+	seedClient, err := s.seedClientGetter(seed)
+	if err != nil {
+		return nil, "", err
+	}
+	credentials := &corev1.Secret{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Namespace: destination.Credentials.Namespace, Name: destination.Credentials.Name}, credentials); err != nil {
+		return nil, "", fmt.Errorf("failed to get the backup credentials: %w", err)
+	}
+
+	mc, err := s3.NewClient(
+		destination.Endpoint,
+		string(credentials.Data[resources.EtcdBackupAndRestoreS3AccessKeyIDKey]),
+		string(credentials.Data[resources.EtcdBackupAndRestoreS3SecretKeyAccessKeyKey]),
+		s.caBundle,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return mc, destination.BucketName, nil
+}
+
+func (s *S3Store) Create(ctx context.Context, name string) (io.WriteCloser, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	mc, bucket, err := s.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.CreateTemp("", "terminal-recording-*"+Extension)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the recording spool file: %w", err)
+	}
+
+	return &s3Writer{
+		file: file,
+		upload: func(ctx context.Context, reader io.Reader, size int64) error {
+			_, err := mc.PutObject(ctx, bucket, s3Prefix+name, reader, size, minio.PutObjectOptions{ContentType: ContentType})
+			return err
+		},
+	}, nil
+}
+
+func (s *S3Store) List(ctx context.Context, clusterID string) ([]Info, error) {
+	mc, bucket, err := s.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := s3Prefix
+	if clusterID != "" {
+		prefix += clusterID + "/"
+	}
+
+	listCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var recordings []Info
+	for object := range mc.ListObjects(listCtx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+
+		name := strings.TrimPrefix(object.Key, s3Prefix)
+		if ValidateName(name) != nil {
+			continue
+		}
+		recordings = append(recordings, Info{
+			Name:         name,
+			ClusterID:    strings.SplitN(name, "/", 2)[0],
+			Size:         object.Size,
+			LastModified: object.LastModified,
+		})
+	}
+
+	return recordings, nil
+}
+
+func (s *S3Store) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	mc, bucket, err := s.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := mc.GetObject(ctx, bucket, s3Prefix+name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject is lazy, the object is requested with the first call
+	if _, err := object.Stat(); err != nil {
+		_ = object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// s3Writer spools the recording to a temporary file and uploads it when it is closed.
+type s3Writer struct {
+	file   *os.File
+	upload func(ctx context.Context, reader io.Reader, size int64) error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.file.Write(p)
+}
+
+// Close uploads the recording and removes the temporary file.
+func (w *s3Writer) Close() error {
+	defer os.Remove(w.file.Name())
+
+	err := w.send()
+	return errors.Join(err, w.file.Close())
+}
+
+func (w *s3Writer) send() error {
+	size, err := w.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// the session, which cancels the context of the request, already ended
+	ctx, cancel := context.WithTimeout(context.Background(), s3UploadTimeout)
+	defer cancel()
+
+	if err := w.upload(ctx, w.file, size); err != nil {
+		return fmt.Errorf("failed to upload the recording: %w", err)
+	}
+	return nil
+}
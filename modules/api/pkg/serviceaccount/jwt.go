@@ -17,14 +17,20 @@ limitations under the License.
 package serviceaccount
 
 import (
+	"crypto"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
 	"github.com/go-jose/go-jose/v4/jwt"
 )
 
+// MaxTokenTTL is the longest lifetime of a token, tokens created without a TTL expire after it.
+const MaxTokenTTL = 3 * 365 * 24 * time.Hour
+
 // Now stubbed out to allow testing.
 var Now = time.Now
 
@@ -59,25 +65,79 @@ type TokenGenerator interface {
 type TokenAuthenticator interface {
 	// Authenticate checks given token and transform it to custom claim object
 	Authenticate(tokenData string) (*jwt.Claims, *CustomTokenClaim, error)
+	// KeySet returns the public keys accepted for asymmetrically signed tokens. The shared HMAC key is never part of it.
+	KeySet() jose.JSONWebKeySet
 }
 
 // CustomTokenClaim represents authenticated user.
 type CustomTokenClaim struct {
-	Email     string `json:"email,omitempty"`
-	ProjectID string `json:"project_id,omitempty"`
-	TokenID   string `json:"token_id,omitempty"`
+	Email     string      `json:"email,omitempty"`
+	ProjectID string      `json:"project_id,omitempty"`
+	TokenID   string      `json:"token_id,omitempty"`
+	Scope     *TokenScope `json:"scope,omitempty"`
+}
+
+// TokenScope limits what a token can be used for. Tokens without a scope have the full permissions of the service account.
+type TokenScope struct {
+	// ReadOnly limits the token to GET, HEAD and OPTIONS requests which do not grant write or admin access,
+	// e.g. the web terminal, pod exec and the admin kubeconfig are rejected.
+	ReadOnly bool `json:"read_only,omitempty"`
+	// ClusterIDs limits the token to requests for the given clusters, requests which do not target a cluster are rejected.
+	ClusterIDs []string `json:"cluster_ids,omitempty"`
 }
 
+// Allows returns an error if a request with the given method for the given cluster is not covered by the scope.
+// The operation is set for requests which grant write or admin access regardless of their method.
+func (s *TokenScope) Allows(method, clusterID, operation string) error {
+	if s == nil {
+		return nil
+	}
+
+	if s.ReadOnly {
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			return fmt.Errorf("the token is read-only and does not allow %s requests", method)
+		}
+		if operation != "" {
+			return fmt.Errorf("the token is read-only and does not allow the %s operation", operation)
+		}
+	}
+
+	if len(s.ClusterIDs) > 0 && !slices.Contains(s.ClusterIDs, clusterID) {
+		if clusterID == "" {
+			return errors.New("the token is limited to clusters and does not allow requests outside of them")
+		}
+		return fmt.Errorf("the token does not allow access to cluster %s", clusterID)
+	}
+
+	return nil
+}
+
+// Claims returns the claims of a token which expires after MaxTokenTTL and has no scope.
 func Claims(email, projectID, tokenID string) (*jwt.Claims, *CustomTokenClaim) {
+	return ScopedClaims(email, projectID, tokenID, 0, nil)
+}
+
+// ScopedClaims returns the claims of a token which expires after the given TTL and is limited to the scope.
+// A zero TTL falls back to MaxTokenTTL.
+func ScopedClaims(email, projectID, tokenID string, ttl time.Duration, scope *TokenScope) (*jwt.Claims, *CustomTokenClaim) {
+	now := Now()
+	expiry := now.AddDate(3, 0, 0)
+	if ttl > 0 {
+		expiry = now.Add(ttl)
+	}
+
 	sc := &jwt.Claims{
-		IssuedAt:  jwt.NewNumericDate(Now()),
-		NotBefore: jwt.NewNumericDate(Now()),
-		Expiry:    jwt.NewNumericDate(Now().AddDate(3, 0, 0)),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		Expiry:    jwt.NewNumericDate(expiry),
 	}
 	pc := &CustomTokenClaim{
 		Email:     email,
 		ProjectID: projectID,
 		TokenID:   tokenID,
+		Scope:     scope,
 	}
 
 	return sc, pc
@@ -97,12 +157,40 @@ func JWTTokenGenerator(privateKey []byte) (TokenGenerator, error) {
 	}, nil
 }
 
+// AsymmetricJWTTokenGenerator returns a TokenGenerator that signs JWT tokens with the given RSA (RS256) or
+// Ed25519 (EdDSA) key. The key ID is set in the token header, so the tokens can be verified against the JWKS.
+func AsymmetricJWTTokenGenerator(privateKey crypto.Signer) (TokenGenerator, error) {
+	algorithm, err := signatureAlgorithm(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+	keyID, err := KeyID(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	signingKey := jose.SigningKey{
+		Algorithm: algorithm,
+		Key:       jose.JSONWebKey{Key: privateKey, KeyID: keyID, Algorithm: string(algorithm)},
+	}
+	signer, err := jose.NewSigner(signingKey, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return nil, err
+	}
+	return &jwtTokenGenerator{
+		signer: signer,
+	}, nil
+}
+
 type jwtTokenGenerator struct {
 	signer jose.Signer
 }
 
 type jwtTokenAuthenticator struct {
-	key interface{}
+	// key is the shared HMAC key used to verify tokens without a key ID.
+	key []byte
+	// keySet holds the public keys used to verify tokens with a key ID.
+	keySet jose.JSONWebKeySet
 }
 
 // Generate generates new token from claims.
@@ -121,6 +209,43 @@ func JWTTokenAuthenticator(privateKey []byte) TokenAuthenticator {
 	}
 }
 
+// JWTTokenAuthenticatorWithKeys authenticates tokens produced by JWTTokenGenerator with the given HMAC key and tokens
+// produced by AsymmetricJWTTokenGenerator with any of the verification keys. Passing the keys of the previous and
+// the current signing key allows to rotate the signing key without invalidating the issued tokens.
+func JWTTokenAuthenticatorWithKeys(privateKey []byte, verificationKeys []crypto.PublicKey) (TokenAuthenticator, error) {
+	authenticator := &jwtTokenAuthenticator{
+		key: privateKey,
+	}
+
+	for _, key := range verificationKeys {
+		algorithm, err := signatureAlgorithm(key)
+		if err != nil {
+			return nil, err
+		}
+		keyID, err := KeyID(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(authenticator.keySet.Key(keyID)) > 0 {
+			continue
+		}
+
+		authenticator.keySet.Keys = append(authenticator.keySet.Keys, jose.JSONWebKey{
+			Key:       key,
+			KeyID:     keyID,
+			Algorithm: string(algorithm),
+			Use:       "sig",
+		})
+	}
+
+	return authenticator, nil
+}
+
+// KeySet returns the public keys accepted by the authenticator.
+func (a *jwtTokenAuthenticator) KeySet() jose.JSONWebKeySet {
+	return a.keySet
+}
+
 // Authenticate decrypts signed token data to CustomTokenClaim object and checks if token expired.
 func (a *jwtTokenAuthenticator) Authenticate(tokenData string) (*jwt.Claims, *CustomTokenClaim, error) {
 	tok, err := jwt.ParseSigned(tokenData, AllowedSignatureAlgorithms)
@@ -128,10 +253,15 @@ func (a *jwtTokenAuthenticator) Authenticate(tokenData string) (*jwt.Claims, *Cu
 		return nil, nil, err
 	}
 
+	key, err := a.verificationKey(tok.Headers)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	public := &jwt.Claims{}
 	customClaims := &CustomTokenClaim{}
 
-	if err := tok.Claims(a.key, customClaims, public); err != nil {
+	if err := tok.Claims(key, customClaims, public); err != nil {
 		return nil, nil, err
 	}
 
@@ -148,6 +278,49 @@ func (a *jwtTokenAuthenticator) Authenticate(tokenData string) (*jwt.Claims, *Cu
 	return public, customClaims, nil
 }
 
+// verificationKey picks the key for the token based on the key ID in the header. Tokens without a key ID
+// were signed with the shared HMAC key.
+func (a *jwtTokenAuthenticator) verificationKey(headers []jose.Header) (interface{}, error) {
+	if len(headers) != 1 {
+		return nil, errors.New("the token must have exactly one signature")
+	}
+	header := headers[0]
+
+	if header.KeyID == "" {
+		if len(a.key) == 0 {
+			return nil, errors.New("tokens without a key ID are not accepted")
+		}
+		return a.key, nil
+	}
+
+	keys := a.keySet.Key(header.KeyID)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("the token was signed with the unknown key %q", header.KeyID)
+	}
+	if keys[0].Algorithm != header.Algorithm {
+		return nil, fmt.Errorf("unexpected signature algorithm %s for key %q", header.Algorithm, header.KeyID)
+	}
+	return keys[0].Key, nil
+}
+
+// ParseUnverified returns the claims of the token without verifying the signature or expiry. It must only be used
+// for tokens which are known to be issued by the API, e.g. to carry over the settings of a token which is regenerated.
+func ParseUnverified(tokenData string) (*jwt.Claims, *CustomTokenClaim, error) {
+	tok, err := jwt.ParseSigned(tokenData, AllowedSignatureAlgorithms)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	public := &jwt.Claims{}
+	customClaims := &CustomTokenClaim{}
+
+	if err := tok.UnsafeClaimsWithoutVerification(customClaims, public); err != nil {
+		return nil, nil, err
+	}
+
+	return public, customClaims, nil
+}
+
 func ValidateKey(privateKey []byte) error {
 	if len(privateKey) == 0 {
 		return errors.New("the signing key can not be empty")
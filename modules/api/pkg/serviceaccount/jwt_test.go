@@ -17,7 +17,12 @@ limitations under the License.
 package serviceaccount_test
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -83,3 +88,174 @@ func formatTime(t time.Time) string {
 	return fmt.Sprintf("%d-%02d-%02d",
 		t.Year(), t.Month(), t.Day())
 }
+
+func TestScopedClaims(t *testing.T) {
+	tokenGenerator, err := serviceaccount.JWTTokenGenerator([]byte(test.TestServiceAccountHashKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scope := &serviceaccount.TokenScope{ReadOnly: true, ClusterIDs: []string{"cluster-1"}}
+	token, err := tokenGenerator.Generate(serviceaccount.ScopedClaims("test@example.com", "testProject", "testToken", time.Hour, scope))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenAuthenticator := serviceaccount.JWTTokenAuthenticator([]byte(test.TestServiceAccountHashKey))
+	public, custom, err := tokenAuthenticator.Authenticate(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ttl := public.Expiry.Time().Sub(public.IssuedAt.Time()); ttl != time.Hour {
+		t.Fatalf("expected a TTL of 1h, got %v", ttl)
+	}
+	if custom.Scope == nil || !custom.Scope.ReadOnly || len(custom.Scope.ClusterIDs) != 1 || custom.Scope.ClusterIDs[0] != "cluster-1" {
+		t.Fatalf("expected the scope to be preserved, got %+v", custom.Scope)
+	}
+}
+
+func TestTokenScopeAllows(t *testing.T) {
+	testcases := []struct {
+		name          string
+		scope         *serviceaccount.TokenScope
+		method        string
+		clusterID     string
+		operation     string
+		expectedError bool
+	}{
+		{
+			name:   "scenario 1: no scope allows everything",
+			method: http.MethodDelete,
+		},
+		{
+			name:   "scenario 2: read-only scope allows GET",
+			scope:  &serviceaccount.TokenScope{ReadOnly: true},
+			method: http.MethodGet,
+		},
+		{
+			name:          "scenario 3: read-only scope rejects POST",
+			scope:         &serviceaccount.TokenScope{ReadOnly: true},
+			method:        http.MethodPost,
+			expectedError: true,
+		},
+		{
+			name:      "scenario 4: cluster scope allows the listed cluster",
+			scope:     &serviceaccount.TokenScope{ClusterIDs: []string{"a", "b"}},
+			method:    http.MethodPatch,
+			clusterID: "b",
+		},
+		{
+			name:          "scenario 5: cluster scope rejects other clusters",
+			scope:         &serviceaccount.TokenScope{ClusterIDs: []string{"a"}},
+			method:        http.MethodGet,
+			clusterID:     "b",
+			expectedError: true,
+		},
+		{
+			name:          "scenario 6: cluster scope rejects requests without a cluster",
+			scope:         &serviceaccount.TokenScope{ClusterIDs: []string{"a"}},
+			method:        http.MethodGet,
+			expectedError: true,
+		},
+		{
+			name:          "scenario 7: read-only scope rejects the web terminal",
+			scope:         &serviceaccount.TokenScope{ReadOnly: true},
+			method:        http.MethodGet,
+			operation:     "terminal",
+			expectedError: true,
+		},
+		{
+			name:          "scenario 8: read-only scope rejects pod exec",
+			scope:         &serviceaccount.TokenScope{ReadOnly: true},
+			method:        http.MethodGet,
+			operation:     "podExec",
+			expectedError: true,
+		},
+		{
+			name:          "scenario 9: read-only scope rejects the admin kubeconfig",
+			scope:         &serviceaccount.TokenScope{ReadOnly: true, ClusterIDs: []string{"a"}},
+			method:        http.MethodGet,
+			clusterID:     "a",
+			operation:     "adminKubeconfig",
+			expectedError: true,
+		},
+		{
+			name:      "scenario 10: cluster scope allows the web terminal of the listed cluster",
+			scope:     &serviceaccount.TokenScope{ClusterIDs: []string{"a"}},
+			method:    http.MethodGet,
+			clusterID: "a",
+			operation: "terminal",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scope.Allows(tc.method, tc.clusterID, tc.operation)
+			if tc.expectedError != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestAsymmetricTokenRotation(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generate := func(key crypto.Signer) string {
+		generator, err := serviceaccount.AsymmetricJWTTokenGenerator(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		token, err := generator.Generate(serviceaccount.Claims("test@example.com", "testProject", "testToken"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	oldToken := generate(rsaKey)
+	newToken := generate(edKey)
+
+	hmacGenerator, err := serviceaccount.JWTTokenGenerator([]byte(test.TestServiceAccountHashKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hmacToken, err := hmacGenerator.Generate(serviceaccount.Claims("test@example.com", "testProject", "testToken"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// during the rotation both keys are accepted
+	authenticator, err := serviceaccount.JWTTokenAuthenticatorWithKeys([]byte(test.TestServiceAccountHashKey), []crypto.PublicKey{rsaKey.Public(), edKey.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range []string{oldToken, newToken, hmacToken} {
+		if _, _, err := authenticator.Authenticate(token); err != nil {
+			t.Fatalf("expected the token to be valid: %v", err)
+		}
+	}
+	if keys := authenticator.KeySet().Keys; len(keys) != 2 {
+		t.Fatalf("expected 2 keys in the key set, got %d", len(keys))
+	}
+
+	// after the rotation only the new key is accepted
+	authenticator, err = serviceaccount.JWTTokenAuthenticatorWithKeys(nil, []crypto.PublicKey{edKey.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := authenticator.Authenticate(newToken); err != nil {
+		t.Fatalf("expected the token to be valid: %v", err)
+	}
+	for _, token := range []string{oldToken, hmacToken} {
+		if _, _, err := authenticator.Authenticate(token); err == nil {
+			t.Fatal("expected the token to be rejected")
+		}
+	}
+}
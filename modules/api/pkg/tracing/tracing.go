@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing sets up OpenTelemetry tracing for the API and instruments its HTTP servers and clients.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+)
+
+// InstrumentationName is the name of the tracer used by the API.
+const InstrumentationName = "k8c.io/dashboard/v2"
+
+// Options configures the exporter of the spans.
+type Options struct {
+	// OTLPEndpoint is the URL of the OTLP/HTTP collector, e.g. http://otel-collector:4318. Tracing is disabled
+	// when it is empty.
+	OTLPEndpoint string
+	// SampleRatio is the fraction of new traces which are sampled. Traces started by the caller keep their
+	// sampling decision.
+	SampleRatio float64
+	// ServiceName is recorded as service.name on all spans.
+	ServiceName string
+}
+
+// Enabled returns true if spans are exported.
+func (o Options) Enabled() bool {
+	return o.OTLPEndpoint != ""
+}
+
+// Setup registers the global tracer provider and the W3C trace context propagator. The returned function flushes
+// the pending spans and must be called on shutdown. Without an endpoint only the propagator is registered, so the
+// trace context of incoming requests is still passed on to the clients.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !opts.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	if opts.SampleRatio < 0 || opts.SampleRatio > 1 {
+		return nil, errors.New("the sample ratio must be between 0 and 1")
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(opts.OTLPEndpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attribute.String("service.name", opts.ServiceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the API.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// NewHandler starts a server span for every request. The span is named after the method and the route returned by
+// spanName, falling back to the method only for unknown routes to keep the cardinality of the names low.
+func NewHandler(next http.Handler, spanName func(*http.Request) string) http.Handler {
+	return otelhttp.NewHandler(next, "kubermatic-api", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		if route := spanName(r); route != "" {
+			return r.Method + " " + route
+		}
+		return r.Method
+	}))
+}
+
+// WrapTransport returns a round tripper starting a client span for every request and propagating the trace context.
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(rt)
+}
+
+// WrapConfig returns a copy of the config whose clients trace all requests to the API server.
+func WrapConfig(cfg *rest.Config) *rest.Config {
+	cfg = rest.CopyConfig(cfg)
+	cfg.Wrap(WrapTransport)
+	return cfg
+}
+
+// NewTransport returns a copy of the default transport, with its proxy, TLS and dial settings, which traces all
+// requests. The clients of the API use it, the default transport itself is left alone, as other packages expect
+// it to be an *http.Transport.
+func NewTransport() http.RoundTripper {
+	return WrapTransport(CloneDefaultTransport())
+}
+
+// CloneDefaultTransport returns a copy of the default transport, e.g. to change its TLS settings.
+func CloneDefaultTransport() *http.Transport {
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		return transport.Clone()
+	}
+	return &http.Transport{Proxy: http.ProxyFromEnvironment}
+}
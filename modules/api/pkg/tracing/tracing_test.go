@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"k8c.io/dashboard/v2/pkg/tracing"
+)
+
+func TestHandlerPropagatesTraceContext(t *testing.T) {
+	if _, err := tracing.Setup(context.Background(), tracing.Options{}); err != nil {
+		t.Fatalf("failed to set up tracing: %v", err)
+	}
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	var traceParent string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("traceparent")
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: tracing.WrapTransport(nil)}
+	handler := tracing.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, upstream.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("failed to call upstream: %v", err)
+		}
+		resp.Body.Close()
+	}), func(*http.Request) string {
+		return "/api/v1/projects/{project_id}"
+	})
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/projects/my-project", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected a server and a client span, got %d", len(spans))
+	}
+
+	server := spans[1]
+	if server.Name() != "GET /api/v1/projects/{project_id}" {
+		t.Fatalf("expected the span to be named after the route, got %q", server.Name())
+	}
+	if spans[0].Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Fatal("expected the client span to be a child of the server span")
+	}
+	if traceParent == "" || traceParent[3:35] != server.SpanContext().TraceID().String() {
+		t.Fatalf("expected the trace context to be propagated, got traceparent %q", traceParent)
+	}
+}
+
+func TestNewTransportKeepsDefaultTransport(t *testing.T) {
+	defaultTransport := http.DefaultTransport
+	_ = tracing.NewTransport()
+	if http.DefaultTransport != defaultTransport {
+		t.Fatal("expected the default transport not to be replaced")
+	}
+
+	transport := tracing.CloneDefaultTransport()
+	if transport == defaultTransport {
+		t.Fatal("expected a copy of the default transport")
+	}
+	if transport.Proxy == nil || transport.DialContext == nil {
+		t.Error("expected the proxy and dial settings of the default transport to be kept")
+	}
+}
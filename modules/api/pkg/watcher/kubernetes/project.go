@@ -0,0 +1,401 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/go-pubsub"
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/watcher"
+)
+
+const (
+	// DefaultProjectSyncInterval is the interval in which the resources of a watched project are listed.
+	DefaultProjectSyncInterval = 5 * time.Second
+	// DefaultProjectEventBufferSize is the number of events kept per project to resume interrupted watches.
+	DefaultProjectEventBufferSize = 1000
+	// DefaultProjectRetention is the time the state of a project is kept after its last subscriber left,
+	// so that clients can reconnect and resume from their last resource version.
+	DefaultProjectRetention = 5 * time.Minute
+)
+
+// ProjectWatcher watches the clusters, machine deployments, nodes and events of projects and notifies
+// its subscribers about any changes. The resources are spread over the seeds and user clusters, so a
+// project is synchronized periodically by a single worker, no matter how many clients are subscribed.
+type ProjectWatcher struct {
+	ctx        context.Context
+	log        *zap.SugaredLogger
+	lister     watcher.ProjectResourceLister
+	publisher  *pubsub.PubSub
+	interval   time.Duration
+	bufferSize int
+	retention  time.Duration
+
+	lock     sync.Mutex
+	projects map[string]*projectState
+}
+
+var _ watcher.ProjectWatcher = &ProjectWatcher{}
+
+type projectState struct {
+	hash        uint64
+	epoch       string
+	subscribers int
+	idleSince   time.Time
+	synced      bool
+	sequence    uint64
+	resources   map[string]projectResourceState
+	// events holds the last published events ordered by their sequence.
+	events []projectEventState
+}
+
+type projectResourceState struct {
+	resource watcher.ProjectResource
+	checksum uint64
+}
+
+type projectEventState struct {
+	sequence uint64
+	event    watcher.ProjectEvent
+}
+
+// NewProjectWatcher returns a new project watcher.
+func NewProjectWatcher(ctx context.Context, log *zap.SugaredLogger, lister watcher.ProjectResourceLister, interval time.Duration, bufferSize int, retention time.Duration) (*ProjectWatcher, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("sync interval must be positive, got %v", interval)
+	}
+	if bufferSize <= 0 {
+		return nil, fmt.Errorf("event buffer size must be positive, got %d", bufferSize)
+	}
+
+	w := &ProjectWatcher{
+		ctx:        ctx,
+		log:        log,
+		lister:     lister,
+		publisher:  pubsub.New(),
+		interval:   interval,
+		bufferSize: bufferSize,
+		retention:  retention,
+		projects:   make(map[string]*projectState),
+	}
+
+	return w, nil
+}
+
+func (watcher *ProjectWatcher) CalculateHash(id string) (uint64, error) {
+	h := fnv.New64()
+	_, err := h.Write([]byte(id))
+	if err != nil {
+		return 0, err
+	}
+	return h.Sum64(), err
+}
+
+// Subscribe allows registering subscription handler which will be invoked on each change of the project resources.
+// The events missed since resourceVersion are passed to the subscription before the published ones, so that
+// the subscription sees every change exactly once and in order. The subscription is never called under the lock
+// of the watcher.
+func (watcher *ProjectWatcher) Subscribe(projectID, resourceVersion string, subscription pubsub.Subscription) pubsub.Unsubscriber {
+	ordered := &orderedSubscription{subscription: subscription}
+	// published events wait until the replayed ones were passed
+	ordered.lock.Lock()
+
+	watcher.lock.Lock()
+	state, exists := watcher.projects[projectID]
+	if !exists {
+		hash, err := watcher.CalculateHash(projectID)
+		if err != nil {
+			watcher.log.Warnf("Error calculating project hash for project watch pubsub: %v", err)
+		}
+
+		state = &projectState{
+			hash:      hash,
+			epoch:     strconv.FormatInt(time.Now().UnixNano(), 36),
+			resources: make(map[string]projectResourceState),
+		}
+		watcher.projects[projectID] = state
+
+		go watcher.run(projectID, state)
+	}
+
+	events := state.replay(resourceVersion)
+	ordered.replayed = state.sequence
+	state.subscribers++
+	unsubscribe := watcher.publisher.Subscribe(ordered.publish, pubsub.WithPath([]uint64{state.hash}))
+	watcher.lock.Unlock()
+
+	for _, event := range events {
+		subscription(event)
+	}
+	ordered.lock.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			unsubscribe()
+
+			watcher.lock.Lock()
+			defer watcher.lock.Unlock()
+
+			state.subscribers--
+			if state.subscribers == 0 {
+				state.idleSince = time.Now()
+			}
+		})
+	}
+}
+
+func (watcher *ProjectWatcher) run(projectID string, state *projectState) {
+	ticker := time.NewTicker(watcher.interval)
+	defer ticker.Stop()
+
+	for {
+		if !watcher.sync(projectID, state) {
+			return
+		}
+
+		select {
+		case <-watcher.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync lists the project resources and publishes the changes. It returns false once the project
+// had no subscribers for longer than the retention period and the worker should stop.
+func (watcher *ProjectWatcher) sync(projectID string, state *projectState) bool {
+	watcher.lock.Lock()
+	idle := state.subscribers == 0 && time.Since(state.idleSince) > watcher.retention
+	if idle {
+		delete(watcher.projects, projectID)
+	}
+	watcher.lock.Unlock()
+
+	if idle {
+		return false
+	}
+
+	snapshot, err := watcher.lister(watcher.ctx, projectID)
+	if err != nil {
+		watcher.log.Debugw("Failed to list resources for project watch", "project", projectID, zap.Error(err))
+		return true
+	}
+
+	watcher.lock.Lock()
+	events := state.update(snapshot, watcher.bufferSize)
+	// the events of an update have consecutive sequences
+	sequence := state.sequence - uint64(len(events))
+	watcher.lock.Unlock()
+
+	// Publishing happens outside of the lock, so slow subscribers do not block new subscriptions and other
+	// projects. A subscription registered meanwhile drops the events it already got replayed.
+	for _, event := range events {
+		sequence++
+		watcher.publisher.Publish(publishedEvent{sequence: sequence, event: event}, pubsub.LinearTreeTraverser([]uint64{state.hash}))
+	}
+
+	return true
+}
+
+// publishedEvent is an event of a project with its sequence.
+type publishedEvent struct {
+	sequence uint64
+	event    watcher.ProjectEvent
+}
+
+// orderedSubscription passes the published events to a subscription after the events replayed to it and drops
+// the published events which were already replayed.
+type orderedSubscription struct {
+	lock         sync.Mutex
+	subscription pubsub.Subscription
+	// replayed is the sequence of the last replayed event
+	replayed uint64
+}
+
+func (s *orderedSubscription) publish(data interface{}) {
+	published, ok := data.(publishedEvent)
+	if !ok {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if published.sequence <= s.replayed {
+		return
+	}
+	s.subscription(published.event)
+}
+
+// update compares the snapshot with the known resources and records the resulting events.
+func (state *projectState) update(snapshot *watcher.ProjectSnapshot, bufferSize int) []watcher.ProjectEvent {
+	unreachable := make(map[string]bool, len(snapshot.UnreachableClusters))
+	for _, clusterID := range snapshot.UnreachableClusters {
+		unreachable[clusterID] = true
+	}
+
+	current := make(map[string]projectResourceState, len(snapshot.Resources))
+	for _, resource := range snapshot.Resources {
+		current[projectResourceKey(resource)] = projectResourceState{
+			resource: resource,
+			checksum: checksum(resource.Object),
+		}
+	}
+
+	var events []watcher.ProjectEvent
+	for _, key := range sortedKeys(state.resources) {
+		known := state.resources[key]
+		if _, exists := current[key]; exists {
+			continue
+		}
+		if known.resource.Kind != watcher.ProjectResourceCluster && unreachable[known.resource.ClusterID] {
+			current[key] = known
+			continue
+		}
+		events = append(events, state.record(watcher.ProjectEventDeleted, known.resource, bufferSize))
+	}
+
+	for _, key := range sortedKeys(current) {
+		resource := current[key]
+		known, exists := state.resources[key]
+		switch {
+		case !exists:
+			events = append(events, state.record(watcher.ProjectEventAdded, resource.resource, bufferSize))
+		case known.checksum != resource.checksum:
+			events = append(events, state.record(watcher.ProjectEventModified, resource.resource, bufferSize))
+		}
+	}
+
+	state.resources = current
+	state.synced = true
+
+	return events
+}
+
+func (state *projectState) record(eventType watcher.ProjectEventType, resource watcher.ProjectResource, bufferSize int) watcher.ProjectEvent {
+	state.sequence++
+	event := newProjectEvent(eventType, resource, state.resourceVersion())
+
+	state.events = append(state.events, projectEventState{sequence: state.sequence, event: event})
+	if len(state.events) > bufferSize {
+		state.events = state.events[len(state.events)-bufferSize:]
+	}
+
+	return event
+}
+
+// replay returns the events a subscription needs to catch up with the current state.
+func (state *projectState) replay(resourceVersion string) []watcher.ProjectEvent {
+	if resourceVersion == "" {
+		return state.snapshotEvents()
+	}
+
+	sequence, ok := state.parseResourceVersion(resourceVersion)
+	if !ok {
+		return append([]watcher.ProjectEvent{{Type: watcher.ProjectEventReset, ResourceVersion: state.resourceVersion()}}, state.snapshotEvents()...)
+	}
+
+	var events []watcher.ProjectEvent
+	for _, e := range state.events {
+		if e.sequence > sequence {
+			events = append(events, e.event)
+		}
+	}
+	return events
+}
+
+// parseResourceVersion returns the sequence of the given resource version if the events
+// since then are still buffered.
+func (state *projectState) parseResourceVersion(resourceVersion string) (uint64, bool) {
+	epoch, rawSequence, found := strings.Cut(resourceVersion, ".")
+	if !found || epoch != state.epoch {
+		return 0, false
+	}
+
+	sequence, err := strconv.ParseUint(rawSequence, 10, 64)
+	if err != nil || sequence > state.sequence {
+		return 0, false
+	}
+
+	oldest := state.sequence
+	if len(state.events) > 0 {
+		oldest = state.events[0].sequence - 1
+	}
+
+	return sequence, sequence >= oldest
+}
+
+func (state *projectState) snapshotEvents() []watcher.ProjectEvent {
+	if !state.synced {
+		return nil
+	}
+
+	events := make([]watcher.ProjectEvent, 0, len(state.resources))
+	for _, key := range sortedKeys(state.resources) {
+		events = append(events, newProjectEvent(watcher.ProjectEventAdded, state.resources[key].resource, state.resourceVersion()))
+	}
+	return events
+}
+
+func (state *projectState) resourceVersion() string {
+	return state.epoch + "." + strconv.FormatUint(state.sequence, 10)
+}
+
+func newProjectEvent(eventType watcher.ProjectEventType, resource watcher.ProjectResource, resourceVersion string) watcher.ProjectEvent {
+	return watcher.ProjectEvent{
+		Type:            eventType,
+		Kind:            resource.Kind,
+		ClusterID:       resource.ClusterID,
+		Name:            resource.Name,
+		ResourceVersion: resourceVersion,
+		Object:          resource.Object,
+	}
+}
+
+func projectResourceKey(resource watcher.ProjectResource) string {
+	return resource.Kind + "/" + resource.ClusterID + "/" + resource.Name
+}
+
+// checksum is computed from the API representation, so that changes which are not visible
+// to the clients, e.g. node heartbeats, do not produce events.
+func checksum(object interface{}) uint64 {
+	h := fnv.New64()
+	if err := json.NewEncoder(h).Encode(object); err != nil {
+		return 0
+	}
+	return h.Sum64()
+}
+
+func sortedKeys(resources map[string]projectResourceState) []string {
+	keys := make([]string, 0, len(resources))
+	for key := range resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/watcher"
+)
+
+func genProjectResource(kind, clusterID, name string, object interface{}) watcher.ProjectResource {
+	return watcher.ProjectResource{Kind: kind, ClusterID: clusterID, Name: name, Object: object}
+}
+
+func eventTypes(events []watcher.ProjectEvent) []watcher.ProjectEventType {
+	types := []watcher.ProjectEventType{}
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestProjectStateUpdate(t *testing.T) {
+	state := &projectState{epoch: "test", resources: map[string]projectResourceState{}}
+
+	events := state.update(&watcher.ProjectSnapshot{Resources: []watcher.ProjectResource{
+		genProjectResource(watcher.ProjectResourceCluster, "c1", "c1", map[string]string{"phase": "Creating"}),
+		genProjectResource(watcher.ProjectResourceNode, "c1", "node-1", map[string]string{"ready": "false"}),
+	}}, 10)
+	if len(events) != 2 || events[0].Type != watcher.ProjectEventAdded || events[1].Type != watcher.ProjectEventAdded {
+		t.Fatalf("expected two ADDED events, got %v", eventTypes(events))
+	}
+
+	// unchanged API representation must not produce an event
+	events = state.update(&watcher.ProjectSnapshot{Resources: []watcher.ProjectResource{
+		genProjectResource(watcher.ProjectResourceCluster, "c1", "c1", map[string]string{"phase": "Running"}),
+		genProjectResource(watcher.ProjectResourceNode, "c1", "node-1", map[string]string{"ready": "false"}),
+	}}, 10)
+	if len(events) != 1 || events[0].Type != watcher.ProjectEventModified || events[0].Kind != watcher.ProjectResourceCluster {
+		t.Fatalf("expected a single MODIFIED cluster event, got %v", eventTypes(events))
+	}
+
+	// resources of unreachable clusters are kept
+	events = state.update(&watcher.ProjectSnapshot{
+		Resources: []watcher.ProjectResource{
+			genProjectResource(watcher.ProjectResourceCluster, "c1", "c1", map[string]string{"phase": "Running"}),
+		},
+		UnreachableClusters: []string{"c1"},
+	}, 10)
+	if len(events) != 0 {
+		t.Fatalf("expected no events for an unreachable cluster, got %v", eventTypes(events))
+	}
+
+	events = state.update(&watcher.ProjectSnapshot{}, 10)
+	if len(events) != 2 || events[0].Type != watcher.ProjectEventDeleted || events[1].Type != watcher.ProjectEventDeleted {
+		t.Fatalf("expected two DELETED events, got %v", eventTypes(events))
+	}
+}
+
+func TestProjectStateReplay(t *testing.T) {
+	state := &projectState{epoch: "test", resources: map[string]projectResourceState{}}
+
+	state.update(&watcher.ProjectSnapshot{Resources: []watcher.ProjectResource{
+		genProjectResource(watcher.ProjectResourceCluster, "c1", "c1", "a"),
+	}}, 2)
+	resourceVersion := state.resourceVersion()
+
+	state.update(&watcher.ProjectSnapshot{Resources: []watcher.ProjectResource{
+		genProjectResource(watcher.ProjectResourceCluster, "c1", "c1", "b"),
+		genProjectResource(watcher.ProjectResourceCluster, "c2", "c2", "a"),
+	}}, 2)
+
+	testcases := []struct {
+		name            string
+		resourceVersion string
+		expected        []watcher.ProjectEventType
+	}{
+		{
+			name:     "scenario 1: no resource version returns the current state",
+			expected: []watcher.ProjectEventType{watcher.ProjectEventAdded, watcher.ProjectEventAdded},
+		},
+		{
+			name:            "scenario 2: buffered resource version returns the missed events",
+			resourceVersion: resourceVersion,
+			expected:        []watcher.ProjectEventType{watcher.ProjectEventModified, watcher.ProjectEventAdded},
+		},
+		{
+			name:            "scenario 3: current resource version returns nothing",
+			resourceVersion: state.resourceVersion(),
+			expected:        []watcher.ProjectEventType{},
+		},
+		{
+			name:            "scenario 4: resource version of a different epoch resets the client",
+			resourceVersion: "other.1",
+			expected:        []watcher.ProjectEventType{watcher.ProjectEventReset, watcher.ProjectEventAdded, watcher.ProjectEventAdded},
+		},
+		{
+			name:            "scenario 5: resource version older than the buffer resets the client",
+			resourceVersion: "test.0",
+			expected:        []watcher.ProjectEventType{watcher.ProjectEventReset, watcher.ProjectEventAdded, watcher.ProjectEventAdded},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := eventTypes(state.replay(tc.resourceVersion))
+			if len(got) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+			for i := range got {
+				if got[i] != tc.expected[i] {
+					t.Fatalf("expected %v, got %v", tc.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectWatcherSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	phases := make(chan string, 1)
+	phases <- "Creating"
+	phase := ""
+	lister := func(ctx context.Context, projectID string) (*watcher.ProjectSnapshot, error) {
+		select {
+		case phase = <-phases:
+		default:
+		}
+		return &watcher.ProjectSnapshot{Resources: []watcher.ProjectResource{
+			genProjectResource(watcher.ProjectResourceCluster, "c1", "c1", phase),
+		}}, nil
+	}
+
+	projectWatcher, err := NewProjectWatcher(ctx, zap.NewNop().Sugar(), lister, 10*time.Millisecond, 10, time.Minute)
+	if err != nil {
+		t.Fatalf("cannot create project watcher: %v", err)
+	}
+
+	received := make(chan watcher.ProjectEvent, 10)
+	unsubscribe := projectWatcher.Subscribe("project", "", func(data interface{}) {
+		received <- data.(watcher.ProjectEvent)
+	})
+	defer unsubscribe()
+
+	expectEvent := func(expected watcher.ProjectEventType) watcher.ProjectEvent {
+		select {
+		case event := <-received:
+			if event.Type != expected {
+				t.Fatalf("expected %s event, got %s", expected, event.Type)
+			}
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %s event", expected)
+		}
+		return watcher.ProjectEvent{}
+	}
+
+	added := expectEvent(watcher.ProjectEventAdded)
+	phases <- "Running"
+	expectEvent(watcher.ProjectEventModified)
+
+	// a reconnecting client resumes after the last seen event
+	resumed := make(chan watcher.ProjectEvent, 10)
+	unsubscribeResumed := projectWatcher.Subscribe("project", added.ResourceVersion, func(data interface{}) {
+		resumed <- data.(watcher.ProjectEvent)
+	})
+	defer unsubscribeResumed()
+
+	select {
+	case event := <-resumed:
+		if event.Type != watcher.ProjectEventModified || event.Object != "Running" {
+			t.Fatalf("expected the missed MODIFIED event, got %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the replayed event")
+	}
+}
+
+func TestOrderedSubscription(t *testing.T) {
+	var received []string
+	ordered := &orderedSubscription{subscription: func(data interface{}) {
+		received = append(received, data.(watcher.ProjectEvent).Name)
+	}}
+
+	// published events wait for the replay
+	ordered.lock.Lock()
+	published := make(chan struct{})
+	go func() {
+		defer close(published)
+		ordered.publish(publishedEvent{sequence: 2, event: watcher.ProjectEvent{Name: "replayed"}})
+		ordered.publish(publishedEvent{sequence: 3, event: watcher.ProjectEvent{Name: "published"}})
+	}()
+	received = append(received, "replay")
+	ordered.replayed = 2
+	ordered.lock.Unlock()
+	<-published
+
+	if expected := []string{"replay", "published"}; !reflect.DeepEqual(received, expected) {
+		t.Errorf("expected the events %v, got %v", expected, received)
+	}
+}
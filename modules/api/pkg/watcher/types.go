@@ -17,6 +17,8 @@ limitations under the License.
 package watcher
 
 import (
+	"context"
+
 	"code.cloudfoundry.org/go-pubsub"
 
 	"k8c.io/dashboard/v2/pkg/provider"
@@ -25,6 +27,7 @@ import (
 type Providers struct {
 	SettingsProvider          provider.SettingsProvider
 	SettingsWatcher           SettingsWatcher
+	ProjectWatcher            ProjectWatcher
 	UserProvider              provider.UserProvider
 	UserWatcher               UserWatcher
 	MemberMapper              provider.ProjectMemberMapper
@@ -45,3 +48,57 @@ type UserWatcher interface {
 	Subscribe(subscription pubsub.Subscription, opts ...pubsub.SubscribeOption) pubsub.Unsubscriber
 	CalculateHash(id string) (uint64, error)
 }
+
+type ProjectWatcher interface {
+	// Subscribe registers the subscription for the events of the given project. When resourceVersion is set,
+	// the events missed since then are replayed first, otherwise the current state is sent as ADDED events.
+	Subscribe(projectID, resourceVersion string, subscription pubsub.Subscription) pubsub.Unsubscriber
+}
+
+const (
+	ProjectResourceCluster           = "Cluster"
+	ProjectResourceMachineDeployment = "MachineDeployment"
+	ProjectResourceNode              = "Node"
+	ProjectResourceEvent             = "Event"
+)
+
+// ProjectEventType is the type of change of a project resource.
+type ProjectEventType string
+
+const (
+	ProjectEventAdded    ProjectEventType = "ADDED"
+	ProjectEventModified ProjectEventType = "MODIFIED"
+	ProjectEventDeleted  ProjectEventType = "DELETED"
+	// ProjectEventReset tells the client to drop its state because the requested resource version
+	// cannot be resumed. It is followed by ADDED events for the current state.
+	ProjectEventReset ProjectEventType = "RESET"
+)
+
+// ProjectResource is a single resource that belongs to a project, e.g. a cluster or a node of one of its clusters.
+type ProjectResource struct {
+	Kind      string
+	ClusterID string
+	Name      string
+	Object    interface{}
+}
+
+// ProjectEvent is the change of a project resource sent to the subscribers.
+type ProjectEvent struct {
+	Type            ProjectEventType `json:"type"`
+	Kind            string           `json:"kind,omitempty"`
+	ClusterID       string           `json:"clusterID,omitempty"`
+	Name            string           `json:"name,omitempty"`
+	ResourceVersion string           `json:"resourceVersion"`
+	Object          interface{}      `json:"object,omitempty"`
+}
+
+// ProjectSnapshot is the state of all watched resources of a project.
+type ProjectSnapshot struct {
+	Resources []ProjectResource
+	// UnreachableClusters are the IDs of the clusters whose resources could not be listed.
+	// The previously known resources of these clusters are kept instead of being reported as deleted.
+	UnreachableClusters []string
+}
+
+// ProjectResourceLister lists the watched resources of the given project.
+type ProjectResourceLister func(ctx context.Context, projectID string) (*ProjectSnapshot, error)